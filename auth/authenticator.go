@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/traPtitech/anke-to/config"
+)
+
+// Authenticator リクエストを送ったユーザーのtraQ IDを取得する
+type Authenticator interface {
+	Authenticate(req *http.Request) (string, error)
+}
+
+var (
+	// ErrNoCredentials リクエストに認証情報が含まれていない
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrUntrustedProxy 信頼していないプロキシからのリクエスト
+	ErrUntrustedProxy = errors.New("untrusted proxy")
+	// ErrInvalidToken トークンが無効
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+const (
+	// ModeProxy リバースプロキシが設定したヘッダーのユーザーIDを信頼する
+	ModeProxy = config.AuthModeProxy
+	// ModeJWT 署名されたJWTを検証する
+	ModeJWT = config.AuthModeJWT
+	// ModeOIDC OAuth 2.0 Token Introspectionでトークンを検証する
+	ModeOIDC = config.AuthModeOIDC
+)
+
+var (
+	defaultTrustedProxies = []string{"127.0.0.1/32", "::1/128"}
+	// devTrustedProxies 開発環境ではプロキシを挟まずにアクセスするため、すべての送信元を信頼する
+	devTrustedProxies = []string{"0.0.0.0/0", "::/0"}
+)
+
+const (
+	defaultUserHeader    = "X-Forwarded-User"
+	devFallbackUserID    = "mds_boy"
+	defaultJWTUserClaim  = "preferred_username"
+	defaultOIDCUserClaim = "username"
+	defaultOIDCCacheTTL  = 30 * time.Second
+)
+
+// NewAuthenticator 設定に応じたAuthenticatorを作成する
+// ユーザーIDのヘッダーがない時のフォールバックはenvがdevの時のみ有効
+func NewAuthenticator(cfg config.Auth, env string) (Authenticator, error) {
+	mode := cfg.Mode
+	if mode == "" {
+		mode = ModeProxy
+	}
+
+	switch mode {
+	case ModeProxy:
+		userHeader := cfg.UserHeader
+		if userHeader == "" {
+			userHeader = defaultUserHeader
+		}
+		// 空のリストが設定されている場合は、どの送信元も信頼しない
+		trustedProxies := cfg.TrustedProxies
+		if trustedProxies == nil {
+			trustedProxies = defaultTrustedProxies
+			if env == "dev" {
+				trustedProxies = devTrustedProxies
+			}
+		}
+		fallbackUserID := ""
+		if env == "dev" {
+			fallbackUserID = devFallbackUserID
+		}
+
+		authenticator, err := NewTrustedProxyAuthenticator(userHeader, trustedProxies, fallbackUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		return authenticator, nil
+	case ModeJWT:
+		if cfg.JWKSFile == "" {
+			return nil, errors.New("auth.jwks_file is required in jwt mode")
+		}
+		userClaim := cfg.UserClaim
+		if userClaim == "" {
+			userClaim = defaultJWTUserClaim
+		}
+
+		authenticator, err := NewJWTAuthenticator(cfg.JWKSFile, cfg.JWTIssuer, cfg.JWTAudience, userClaim)
+		if err != nil {
+			return nil, err
+		}
+
+		return authenticator, nil
+	case ModeOIDC:
+		if cfg.IntrospectionURL == "" {
+			return nil, errors.New("auth.introspection_url is required in oidc mode")
+		}
+		userClaim := cfg.UserClaim
+		if userClaim == "" {
+			userClaim = defaultOIDCUserClaim
+		}
+
+		return NewIntrospectionAuthenticator(
+			cfg.IntrospectionURL,
+			cfg.ClientID,
+			cfg.ClientSecret,
+			userClaim,
+			http.DefaultClient,
+			defaultOIDCCacheTTL,
+		), nil
+	}
+
+	return nil, fmt.Errorf("invalid auth.mode: %s", mode)
+}
+
+// bearerToken AuthorizationヘッダーからBearerトークンを取り出す
+func bearerToken(req *http.Request) (string, error) {
+	authorization := req.Header.Get("Authorization")
+	if authorization == "" {
+		return "", ErrNoCredentials
+	}
+
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", fmt.Errorf("invalid authorization header: %w", ErrInvalidToken)
+	}
+
+	return strings.TrimSpace(token), nil
+}
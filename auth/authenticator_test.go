@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/config"
+)
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type args struct {
+		cfg        config.Auth
+		env        string
+		remoteAddr string
+		header     string
+	}
+	type expect struct {
+		isErr    bool
+		isAuthed bool
+	}
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	testCases := []test{
+		{
+			description: "既定ではループバックアドレスのプロキシを信頼する",
+			args: args{
+				cfg:        config.Auth{Mode: config.AuthModeProxy},
+				env:        "production",
+				remoteAddr: "127.0.0.1:1234",
+				header:     "X-Forwarded-User",
+			},
+			expect: expect{
+				isAuthed: true,
+			},
+		},
+		{
+			description: "既定ではループバックアドレス以外のプロキシを信頼しない",
+			args: args{
+				cfg:        config.Auth{Mode: config.AuthModeProxy},
+				env:        "production",
+				remoteAddr: "192.0.2.1:1234",
+				header:     "X-Forwarded-User",
+			},
+		},
+		{
+			description: "devではすべての送信元を信頼する",
+			args: args{
+				cfg:        config.Auth{Mode: config.AuthModeProxy},
+				env:        "dev",
+				remoteAddr: "192.0.2.1:1234",
+				header:     "X-Forwarded-User",
+			},
+			expect: expect{
+				isAuthed: true,
+			},
+		},
+		{
+			description: "信頼するプロキシが空ならdevでも信頼しない",
+			args: args{
+				cfg:        config.Auth{Mode: config.AuthModeProxy, TrustedProxies: []string{}},
+				env:        "dev",
+				remoteAddr: "127.0.0.1:1234",
+				header:     "X-Forwarded-User",
+			},
+		},
+		{
+			description: "設定したヘッダーのユーザーIDを使う",
+			args: args{
+				cfg: config.Auth{
+					Mode:           config.AuthModeProxy,
+					UserHeader:     "X-Auth-User",
+					TrustedProxies: []string{"192.0.2.0/24"},
+				},
+				env:        "production",
+				remoteAddr: "192.0.2.1:1234",
+				header:     "X-Auth-User",
+			},
+			expect: expect{
+				isAuthed: true,
+			},
+		},
+		{
+			description: "jwtで公開鍵のファイルがないのでエラー",
+			args: args{
+				cfg: config.Auth{Mode: config.AuthModeJWT},
+			},
+			expect: expect{
+				isErr: true,
+			},
+		},
+		{
+			description: "oidcでToken IntrospectionのURLがないのでエラー",
+			args: args{
+				cfg: config.Auth{Mode: config.AuthModeOIDC},
+			},
+			expect: expect{
+				isErr: true,
+			},
+		},
+		{
+			description: "認証方式が不正なのでエラー",
+			args: args{
+				cfg: config.Auth{Mode: "basic"},
+			},
+			expect: expect{
+				isErr: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		authenticator, err := NewAuthenticator(testCase.args.cfg, testCase.args.env)
+		if testCase.expect.isErr {
+			assertion.Error(err, testCase.description)
+			continue
+		}
+		if !assertion.NoError(err, testCase.description) {
+			continue
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = testCase.args.remoteAddr
+		req.Header.Set(testCase.args.header, "mazrean")
+
+		userID, err := authenticator.Authenticate(req)
+		if testCase.expect.isAuthed {
+			assertion.NoError(err, testCase.description)
+			assertion.Equal("mazrean", userID, testCase.description)
+		} else {
+			assertion.Error(err, testCase.description)
+		}
+	}
+}
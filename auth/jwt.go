@@ -0,0 +1,245 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTAuthenticator AuthorizationヘッダーのJWTをJWKSファイルの公開鍵で検証し、ユーザーIDを取得する
+type JWTAuthenticator struct {
+	jwksFile  string
+	userClaim string
+	parser    *jwt.Parser
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	keysModTime time.Time
+}
+
+// NewJWTAuthenticator JWTAuthenticatorのコンストラクタ
+// issuer, audienceが空の場合は検証しない
+func NewJWTAuthenticator(jwksFile string, issuer string, audience string, userClaim string) (*JWTAuthenticator, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		options = append(options, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		options = append(options, jwt.WithAudience(audience))
+	}
+
+	a := &JWTAuthenticator{
+		jwksFile:  jwksFile,
+		userClaim: userClaim,
+		parser:    jwt.NewParser(options...),
+	}
+	err := a.loadKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Authenticate リクエストを送ったユーザーのtraQ IDを取得する
+func (a *JWTAuthenticator) Authenticate(req *http.Request) (string, error) {
+	tokenString, err := bearerToken(req)
+	if err != nil {
+		return "", err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = a.parser.ParseWithClaims(tokenString, claims, a.keyFunc)
+	if err != nil {
+		return "", fmt.Errorf("failed to verify token: %w: %w", ErrInvalidToken, err)
+	}
+
+	userID, ok := claims[a.userClaim].(string)
+	if !ok || userID == "" {
+		return "", fmt.Errorf("no %s claim: %w", a.userClaim, ErrInvalidToken)
+	}
+
+	return userID, nil
+}
+
+func (a *JWTAuthenticator) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := a.lookupKey(kid)
+	if ok {
+		return key, nil
+	}
+
+	// 鍵がローテーションされた可能性があるので、ファイルが更新されていれば読み直す
+	err := a.loadKeys()
+	if err != nil {
+		return nil, err
+	}
+	key, ok = a.lookupKey(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+
+	return key, nil
+}
+
+func (a *JWTAuthenticator) lookupKey(kid string) (crypto.PublicKey, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if kid == "" && len(a.keys) == 1 {
+		for _, key := range a.keys {
+			return key, true
+		}
+	}
+	key, ok := a.keys[kid]
+
+	return key, ok
+}
+
+// loadKeys JWKSファイルが前回の読み込みから更新されていれば読み込む
+func (a *JWTAuthenticator) loadKeys() error {
+	info, err := os.Stat(a.jwksFile)
+	if err != nil {
+		return fmt.Errorf("failed to stat JWKS file: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.keys != nil && !info.ModTime().After(a.keysModTime) {
+		return nil
+	}
+
+	b, err := os.ReadFile(a.jwksFile)
+	if err != nil {
+		return fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+	keys, err := parseJWKS(b)
+	if err != nil {
+		return err
+	}
+
+	a.keys = keys
+	a.keysModTime = info.ModTime()
+
+	return nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS JWK Set(RFC 7517)から署名検証用の公開鍵を取り出す
+func parseJWKS(b []byte) (map[string]crypto.PublicKey, error) {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	err := json.Unmarshal(b, &jwks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		var key crypto.PublicKey
+		switch jwk.Kty {
+		case "RSA":
+			key, err = parseRSAKey(jwk)
+		case "EC":
+			key, err = parseECKey(jwk)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWK(kid: %s): %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signing keys in JWKS")
+	}
+
+	return keys, nil
+}
+
+func parseRSAKey(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid n: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid e: %w", err)
+	}
+
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("too large e")
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
+
+func parseECKey(jwk jsonWebKey) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x: %w", err)
+	}
+	y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid y: %w", err)
+	}
+
+	key := &ecdsa.PublicKey{
+		Curve: curve,
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}
+	if !curve.IsOnCurve(key.X, key.Y) {
+		return nil, errors.New("point is not on curve")
+	}
+
+	return key, nil
+}
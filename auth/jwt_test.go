@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	t.Helper()
+
+	b, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": kid,
+				"use": "sig",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal JWKS: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	err = os.WriteFile(path, b, 0o600)
+	if err != nil {
+		t.Fatalf("failed to write JWKS: %v", err)
+	}
+
+	return path
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	jwksFile := writeJWKS(t, "key1", &key.PublicKey)
+	authenticator, err := NewJWTAuthenticator(jwksFile, "https://issuer.example.com", "anke-to", defaultJWTUserClaim)
+	if err != nil {
+		t.Fatalf("failed to create authenticator: %v", err)
+	}
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":                "https://issuer.example.com",
+			"aud":                "anke-to",
+			"exp":                time.Now().Add(time.Hour).Unix(),
+			"preferred_username": "mazrean",
+		}
+	}
+	sign := func(method jwt.SigningMethod, kid string, claims jwt.MapClaims, signKey interface{}) string {
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = kid
+		tokenString, err := token.SignedString(signKey)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return tokenString
+	}
+
+	type args struct {
+		authorization string
+	}
+	type expect struct {
+		isErr  bool
+		err    error
+		userID string
+	}
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	expiredClaims := validClaims()
+	expiredClaims["exp"] = time.Now().Add(-time.Hour).Unix()
+	otherIssuerClaims := validClaims()
+	otherIssuerClaims["iss"] = "https://evil.example.com"
+	otherAudienceClaims := validClaims()
+	otherAudienceClaims["aud"] = "other"
+	noUserClaims := validClaims()
+	delete(noUserClaims, "preferred_username")
+
+	testCases := []test{
+		{
+			description: "正しく署名されたトークンなのでユーザーID取得",
+			args: args{
+				authorization: "Bearer " + sign(jwt.SigningMethodRS256, "key1", validClaims(), key),
+			},
+			expect: expect{
+				userID: "mazrean",
+			},
+		},
+		{
+			description: "Authorizationヘッダーがないのでエラー",
+			args:        args{},
+			expect: expect{
+				isErr: true,
+				err:   ErrNoCredentials,
+			},
+		},
+		{
+			description: "Bearerでないのでエラー",
+			args: args{
+				authorization: "Basic bWF6cmVhbjpwYXNz",
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+		{
+			description: "別の鍵で署名されているのでエラー",
+			args: args{
+				authorization: "Bearer " + sign(jwt.SigningMethodRS256, "key1", validClaims(), otherKey),
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+		{
+			description: "知らないkidなのでエラー",
+			args: args{
+				authorization: "Bearer " + sign(jwt.SigningMethodRS256, "key2", validClaims(), key),
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+		{
+			description: "HS256は許可しないのでエラー",
+			args: args{
+				authorization: "Bearer " + sign(jwt.SigningMethodHS256, "key1", validClaims(), []byte("secret")),
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+		{
+			description: "期限切れなのでエラー",
+			args: args{
+				authorization: "Bearer " + sign(jwt.SigningMethodRS256, "key1", expiredClaims, key),
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+		{
+			description: "issuerが違うのでエラー",
+			args: args{
+				authorization: "Bearer " + sign(jwt.SigningMethodRS256, "key1", otherIssuerClaims, key),
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+		{
+			description: "audienceが違うのでエラー",
+			args: args{
+				authorization: "Bearer " + sign(jwt.SigningMethodRS256, "key1", otherAudienceClaims, key),
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+		{
+			description: "ユーザーIDのクレームがないのでエラー",
+			args: args{
+				authorization: "Bearer " + sign(jwt.SigningMethodRS256, "key1", noUserClaims, key),
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if testCase.args.authorization != "" {
+			req.Header.Set("Authorization", testCase.args.authorization)
+		}
+
+		userID, err := authenticator.Authenticate(req)
+
+		if !testCase.expect.isErr {
+			assertion.NoError(err, testCase.description, "no error")
+		} else if testCase.expect.err != nil {
+			if !errors.Is(err, testCase.expect.err) {
+				t.Errorf("invalid error(%s): expected: %+v, actual: %+v", testCase.description, testCase.expect.err, err)
+			}
+		} else {
+			assertion.Error(err, testCase.description, "any error")
+		}
+		if err != nil {
+			continue
+		}
+
+		assertion.Equal(testCase.expect.userID, userID, testCase.description, "userID")
+	}
+}
+
+func TestNewJWTAuthenticatorNoKeys(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	err := os.WriteFile(path, []byte(`{"keys":[]}`), 0o600)
+	if err != nil {
+		t.Fatalf("failed to write JWKS: %v", err)
+	}
+
+	_, err = NewJWTAuthenticator(path, "", "", defaultJWTUserClaim)
+	assert.Error(t, err)
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// IntrospectionAuthenticator OAuth 2.0 Token Introspection(RFC 7662)でBearerトークンを検証し、ユーザーIDを取得する
+type IntrospectionAuthenticator struct {
+	introspectionURL string
+	clientID         string
+	clientSecret     string
+	userClaim        string
+	client           *http.Client
+	cacheTTL         time.Duration
+
+	mu    sync.Mutex
+	cache map[string]introspectionCache
+}
+
+type introspectionCache struct {
+	userID    string
+	expiresAt time.Time
+}
+
+// NewIntrospectionAuthenticator IntrospectionAuthenticatorのコンストラクタ
+// 有効なトークンの検証結果はcacheTTLの間キャッシュする
+func NewIntrospectionAuthenticator(introspectionURL string, clientID string, clientSecret string, userClaim string, client *http.Client, cacheTTL time.Duration) *IntrospectionAuthenticator {
+	return &IntrospectionAuthenticator{
+		introspectionURL: introspectionURL,
+		clientID:         clientID,
+		clientSecret:     clientSecret,
+		userClaim:        userClaim,
+		client:           client,
+		cacheTTL:         cacheTTL,
+		cache:            map[string]introspectionCache{},
+	}
+}
+
+// Authenticate リクエストを送ったユーザーのtraQ IDを取得する
+func (a *IntrospectionAuthenticator) Authenticate(req *http.Request) (string, error) {
+	token, err := bearerToken(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+
+	now := time.Now()
+	userID, ok := a.getCache(key, now)
+	if ok {
+		return userID, nil
+	}
+
+	userID, expiresAt, err := a.introspect(req, token)
+	if err != nil {
+		return "", err
+	}
+
+	cacheExpiresAt := now.Add(a.cacheTTL)
+	if !expiresAt.IsZero() && expiresAt.Before(cacheExpiresAt) {
+		cacheExpiresAt = expiresAt
+	}
+	a.setCache(key, introspectionCache{
+		userID:    userID,
+		expiresAt: cacheExpiresAt,
+	}, now)
+
+	return userID, nil
+}
+
+func (a *IntrospectionAuthenticator) introspect(req *http.Request, token string) (string, time.Time, error) {
+	form := url.Values{}
+	form.Set("token", token)
+	form.Set("token_type_hint", "access_token")
+
+	introspectionReq, err := http.NewRequestWithContext(req.Context(), http.MethodPost, a.introspectionURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create introspection request: %w", err)
+	}
+	introspectionReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	introspectionReq.Header.Set("Accept", "application/json")
+	if a.clientID != "" {
+		introspectionReq.SetBasicAuth(url.QueryEscape(a.clientID), url.QueryEscape(a.clientSecret))
+	}
+
+	res, err := a.client.Do(introspectionReq)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to introspect token: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", time.Time{}, fmt.Errorf("failed to introspect token: unexpected status code %d", res.StatusCode)
+	}
+
+	var body map[string]interface{}
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to decode introspection response: %w", err)
+	}
+
+	active, _ := body["active"].(bool)
+	if !active {
+		return "", time.Time{}, fmt.Errorf("inactive token: %w", ErrInvalidToken)
+	}
+
+	userID, ok := body[a.userClaim].(string)
+	if !ok || userID == "" {
+		return "", time.Time{}, fmt.Errorf("no %s in introspection response: %w", a.userClaim, ErrInvalidToken)
+	}
+
+	var expiresAt time.Time
+	if exp, ok := body["exp"].(float64); ok {
+		expiresAt = time.Unix(int64(exp), 0)
+		if !expiresAt.After(time.Now()) {
+			return "", time.Time{}, fmt.Errorf("expired token: %w", ErrInvalidToken)
+		}
+	}
+
+	return userID, expiresAt, nil
+}
+
+func (a *IntrospectionAuthenticator) getCache(key string, now time.Time) (string, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cache, ok := a.cache[key]
+	if !ok {
+		return "", false
+	}
+	if !now.Before(cache.expiresAt) {
+		delete(a.cache, key)
+		return "", false
+	}
+
+	return cache.userID, true
+}
+
+func (a *IntrospectionAuthenticator) setCache(key string, cache introspectionCache, now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	// 期限切れのエントリが溜まり続けないように、書き込み時に掃除する
+	for k, v := range a.cache {
+		if !now.Before(v.expiresAt) {
+			delete(a.cache, k)
+		}
+	}
+	a.cache[key] = cache
+}
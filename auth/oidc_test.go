@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newIntrospectionStub トークンごとに決まったレスポンスを返すIntrospection Endpointのスタブ
+func newIntrospectionStub(t *testing.T, responses map[string]map[string]interface{}, calls *int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != "anke-to" || clientSecret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		err := r.ParseForm()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		res, ok := responses[r.PostForm.Get("token")]
+		if !ok {
+			res = map[string]interface{}{"active": false}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestIntrospectionAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	var calls int32
+	server := newIntrospectionStub(t, map[string]map[string]interface{}{
+		"valid": {
+			"active":   true,
+			"username": "mazrean",
+			"exp":      time.Now().Add(time.Hour).Unix(),
+		},
+		"expired": {
+			"active":   true,
+			"username": "mazrean",
+			"exp":      time.Now().Add(-time.Hour).Unix(),
+		},
+		"nouser": {
+			"active": true,
+		},
+	}, &calls)
+
+	authenticator := NewIntrospectionAuthenticator(server.URL, "anke-to", "secret", defaultOIDCUserClaim, server.Client(), time.Minute)
+	wrongClientAuthenticator := NewIntrospectionAuthenticator(server.URL, "anke-to", "wrong", defaultOIDCUserClaim, server.Client(), time.Minute)
+
+	type args struct {
+		authenticator *IntrospectionAuthenticator
+		authorization string
+	}
+	type expect struct {
+		isErr  bool
+		err    error
+		userID string
+	}
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	testCases := []test{
+		{
+			description: "有効なトークンなのでユーザーID取得",
+			args: args{
+				authenticator: authenticator,
+				authorization: "Bearer valid",
+			},
+			expect: expect{
+				userID: "mazrean",
+			},
+		},
+		{
+			description: "Authorizationヘッダーがないのでエラー",
+			args: args{
+				authenticator: authenticator,
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrNoCredentials,
+			},
+		},
+		{
+			description: "無効なトークンなのでエラー",
+			args: args{
+				authenticator: authenticator,
+				authorization: "Bearer invalid",
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+		{
+			description: "期限切れのトークンなのでエラー",
+			args: args{
+				authenticator: authenticator,
+				authorization: "Bearer expired",
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+		{
+			description: "ユーザーIDがないのでエラー",
+			args: args{
+				authenticator: authenticator,
+				authorization: "Bearer nouser",
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrInvalidToken,
+			},
+		},
+		{
+			description: "クライアント認証に失敗したのでエラー",
+			args: args{
+				authenticator: wrongClientAuthenticator,
+				authorization: "Bearer valid",
+			},
+			expect: expect{
+				isErr: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if testCase.args.authorization != "" {
+			req.Header.Set("Authorization", testCase.args.authorization)
+		}
+
+		userID, err := testCase.args.authenticator.Authenticate(req)
+
+		if !testCase.expect.isErr {
+			assertion.NoError(err, testCase.description, "no error")
+		} else if testCase.expect.err != nil {
+			if !errors.Is(err, testCase.expect.err) {
+				t.Errorf("invalid error(%s): expected: %+v, actual: %+v", testCase.description, testCase.expect.err, err)
+			}
+		} else {
+			assertion.Error(err, testCase.description, "any error")
+		}
+		if err != nil {
+			continue
+		}
+
+		assertion.Equal(testCase.expect.userID, userID, testCase.description, "userID")
+	}
+}
+
+func TestIntrospectionAuthenticatorCache(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	var calls int32
+	server := newIntrospectionStub(t, map[string]map[string]interface{}{
+		"valid": {
+			"active":   true,
+			"username": "mazrean",
+		},
+	}, &calls)
+
+	authenticator := NewIntrospectionAuthenticator(server.URL, "anke-to", "secret", defaultOIDCUserClaim, server.Client(), time.Minute)
+
+	for i := 0; i < 3; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer valid")
+
+		userID, err := authenticator.Authenticate(req)
+		assertion.NoError(err)
+		assertion.Equal("mazrean", userID)
+	}
+	assertion.Equal(int32(1), atomic.LoadInt32(&calls), "introspection should be cached")
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer invalid")
+
+		_, err := authenticator.Authenticate(req)
+		assertion.ErrorIs(err, ErrInvalidToken)
+	}
+	assertion.Equal(int32(3), atomic.LoadInt32(&calls), "inactive token should not be cached")
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+)
+
+// TrustedProxyAuthenticator 信頼するリバースプロキシが設定したヘッダーからユーザーIDを取得する
+type TrustedProxyAuthenticator struct {
+	userHeader     string
+	trustedProxies []netip.Prefix
+	fallbackUserID string
+}
+
+// NewTrustedProxyAuthenticator TrustedProxyAuthenticatorのコンストラクタ
+// fallbackUserIDが空でない場合、ヘッダーがないリクエストをfallbackUserIDのユーザーとして扱う
+func NewTrustedProxyAuthenticator(userHeader string, trustedProxies []string, fallbackUserID string) (*TrustedProxyAuthenticator, error) {
+	prefixes := make([]netip.Prefix, 0, len(trustedProxies))
+	for _, trustedProxy := range trustedProxies {
+		prefix, err := netip.ParsePrefix(trustedProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy CIDR(%s): %w", trustedProxy, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return &TrustedProxyAuthenticator{
+		userHeader:     userHeader,
+		trustedProxies: prefixes,
+		fallbackUserID: fallbackUserID,
+	}, nil
+}
+
+// Authenticate リクエストを送ったユーザーのtraQ IDを取得する
+func (a *TrustedProxyAuthenticator) Authenticate(req *http.Request) (string, error) {
+	if !a.isTrustedProxy(req.RemoteAddr) {
+		return "", fmt.Errorf("request from %s: %w", req.RemoteAddr, ErrUntrustedProxy)
+	}
+
+	userID := req.Header.Get(a.userHeader)
+	if userID == "" {
+		if a.fallbackUserID != "" {
+			return a.fallbackUserID, nil
+		}
+		return "", ErrNoCredentials
+	}
+
+	return userID, nil
+}
+
+func (a *TrustedProxyAuthenticator) isTrustedProxy(remoteAddr string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, prefix := range a.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrustedProxyAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type args struct {
+		trustedProxies []string
+		fallbackUserID string
+		remoteAddr     string
+		userID         string
+	}
+	type expect struct {
+		isErr  bool
+		err    error
+		userID string
+	}
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	testCases := []test{
+		{
+			description: "信頼するプロキシからのリクエストなのでユーザーID取得",
+			args: args{
+				trustedProxies: []string{"192.0.2.0/24"},
+				remoteAddr:     "192.0.2.1:1234",
+				userID:         "mazrean",
+			},
+			expect: expect{
+				userID: "mazrean",
+			},
+		},
+		{
+			description: "IPv4射影IPv6アドレスでも信頼するプロキシと判定",
+			args: args{
+				trustedProxies: []string{"192.0.2.0/24"},
+				remoteAddr:     "[::ffff:192.0.2.1]:1234",
+				userID:         "mazrean",
+			},
+			expect: expect{
+				userID: "mazrean",
+			},
+		},
+		{
+			description: "信頼しないプロキシからのリクエストなのでエラー",
+			args: args{
+				trustedProxies: []string{"192.0.2.0/24"},
+				remoteAddr:     "198.51.100.1:1234",
+				userID:         "mazrean",
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrUntrustedProxy,
+			},
+		},
+		{
+			description: "信頼しないプロキシからのリクエストはフォールバックがあってもエラー",
+			args: args{
+				trustedProxies: []string{"192.0.2.0/24"},
+				fallbackUserID: "mds_boy",
+				remoteAddr:     "198.51.100.1:1234",
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrUntrustedProxy,
+			},
+		},
+		{
+			description: "ヘッダーがなくフォールバックもないのでエラー",
+			args: args{
+				trustedProxies: []string{"192.0.2.0/24"},
+				remoteAddr:     "192.0.2.1:1234",
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrNoCredentials,
+			},
+		},
+		{
+			description: "ヘッダーがないのでフォールバックのユーザーID",
+			args: args{
+				trustedProxies: []string{"192.0.2.0/24"},
+				fallbackUserID: "mds_boy",
+				remoteAddr:     "192.0.2.1:1234",
+			},
+			expect: expect{
+				userID: "mds_boy",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		authenticator, err := NewTrustedProxyAuthenticator(defaultUserHeader, testCase.args.trustedProxies, testCase.args.fallbackUserID)
+		if err != nil {
+			t.Fatalf("failed to create authenticator: %v", err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = testCase.args.remoteAddr
+		if testCase.args.userID != "" {
+			req.Header.Set(defaultUserHeader, testCase.args.userID)
+		}
+
+		userID, err := authenticator.Authenticate(req)
+
+		if !testCase.expect.isErr {
+			assertion.NoError(err, testCase.description, "no error")
+		} else if testCase.expect.err != nil {
+			if !errors.Is(err, testCase.expect.err) {
+				t.Errorf("invalid error(%s): expected: %+v, actual: %+v", testCase.description, testCase.expect.err, err)
+			}
+		} else {
+			assertion.Error(err, testCase.description, "any error")
+		}
+		if err != nil {
+			continue
+		}
+
+		assertion.Equal(testCase.expect.userID, userID, testCase.description, "userID")
+	}
+}
+
+func TestNewTrustedProxyAuthenticatorInvalidCIDR(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTrustedProxyAuthenticator(defaultUserHeader, []string{"192.0.2.1"}, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,556 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 各設定の既定値
+const (
+	DefaultPort                    = ":1323"
+	DefaultMetricsPort             = ":1324"
+	DefaultQuestionnairesRateLimit = 60
+	DefaultTracingSampleRatio      = 1.0
+)
+
+// DefaultReminderTimingMinutes 既定のリマインダーを送る、回答期限までの残り時間(分)
+var DefaultReminderTimingMinutes = []int{10080, 7200, 4320, 1440, 720, 360, 60}
+
+// configFileEnvKey 設定ファイルのパスを指定する環境変数
+const configFileEnvKey = "CONFIG_FILE"
+
+// Config anke-toの設定
+type Config struct {
+	// Env 実行環境 (dev, test, production, neoshowcase など)
+	Env string `yaml:"env"`
+	// Port APIサーバーがlistenするアドレス (例: :1323)
+	Port string `yaml:"port"`
+	// PublicBaseURL traQに投稿するメッセージのリンクに使う、anke-toのURL。dev, test以外では必須
+	PublicBaseURL string `yaml:"public_base_url"`
+	// InitialSystemAdmins システム管理者が1人もいない時に追加するユーザーのtraQ ID
+	InitialSystemAdmins []string `yaml:"initial_system_admins"`
+	// AnonymousResponseSecret 匿名回答の回答者を識別するハッシュの鍵
+	AnonymousResponseSecret string `yaml:"anonymous_response_secret"`
+
+	DB        DB        `yaml:"db"`
+	TraQ      TraQ      `yaml:"traq"`
+	Reminder  Reminder  `yaml:"reminder"`
+	RateLimit RateLimit `yaml:"rate_limit"`
+	Log       Log       `yaml:"log"`
+	Tracing   Tracing   `yaml:"tracing"`
+	Auth      Auth      `yaml:"auth"`
+	PubSub    PubSub    `yaml:"pubsub"`
+	Metrics   Metrics   `yaml:"metrics"`
+}
+
+// DBのドライバー
+const (
+	DBDriverMySQL    = "mysql"
+	DBDriverPostgres = "postgres"
+	DBDriverSQLite   = "sqlite"
+)
+
+// 各ドライバーのポートの既定値
+const (
+	defaultMySQLPort    = "3306"
+	defaultPostgresPort = "5432"
+)
+
+// DB データベースの設定
+type DB struct {
+	// Driver 使うデータベース (mysql, postgres, sqlite)。sqliteは開発・テスト用
+	Driver   string `yaml:"driver"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	Name     string `yaml:"name"`
+	// Path SQLiteのデータベースのファイルのパス
+	Path string `yaml:"path"`
+}
+
+// TraQ traQの設定
+type TraQ struct {
+	// BaseURL traQのURL。APIとWebhookはこのURLの/api/v3以下を使う。dev, test以外では必須
+	BaseURL              string `yaml:"base_url"`
+	BotToken             string `yaml:"bot_token"`
+	WebhookID            string `yaml:"webhook_id"`
+	WebhookSecret        string `yaml:"webhook_secret"`
+	BotVerificationToken string `yaml:"bot_verification_token"`
+	// BotUserID BOTのtraQのユーザーのUUID。チャンネルではBOTへのメンションを含むメッセージにだけ応答する。bot_verification_tokenを設定する場合は必須
+	BotUserID string `yaml:"bot_user_id"`
+}
+
+// Reminder リマインダーの設定
+type Reminder struct {
+	// TimingMinutes リマインダーを送る、回答期限までの残り時間(分)。大きい順に並べる
+	TimingMinutes []int `yaml:"timing_minutes"`
+}
+
+// RateLimit リクエスト制限の設定
+type RateLimit struct {
+	// Questionnaires ユーザーごとのアンケート一覧の取得の制限(回/秒)
+	Questionnaires float64 `yaml:"questionnaires"`
+}
+
+// LogLevel ログレベル
+type LogLevel string
+
+const (
+	LogLevelDebug  LogLevel = "debug"
+	LogLevelInfo   LogLevel = "info"
+	LogLevelWarn   LogLevel = "warn"
+	LogLevelError  LogLevel = "error"
+	LogLevelSilent LogLevel = "silent"
+)
+
+var logLevels = []LogLevel{LogLevelDebug, LogLevelInfo, LogLevelWarn, LogLevelError, LogLevelSilent}
+
+// Log ログの設定
+type Log struct {
+	// Level APIサーバーのログレベル
+	Level LogLevel `yaml:"level"`
+	// DBLevel DBのクエリのログレベル。指定しない場合はproductionではsilent、それ以外ではinfo
+	DBLevel LogLevel `yaml:"db_level"`
+}
+
+// Tracing OpenTelemetryのトレースの設定
+type Tracing struct {
+	// Endpoint トレースを送るOTLP/HTTPのURL (例: http://localhost:4318/v1/traces)。空の場合はトレースを送らない
+	Endpoint string `yaml:"endpoint"`
+	// SampleRatio トレースを記録するリクエストの割合 (0以上1以下)
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// 認証方式
+const (
+	// AuthModeProxy リバースプロキシが設定したヘッダーのユーザーIDを信頼する
+	AuthModeProxy = "proxy"
+	// AuthModeJWT 署名されたJWTを検証する
+	AuthModeJWT = "jwt"
+	// AuthModeOIDC OAuth 2.0 Token Introspectionでトークンを検証する
+	AuthModeOIDC = "oidc"
+)
+
+var authModes = []string{AuthModeProxy, AuthModeJWT, AuthModeOIDC}
+
+// Auth ログインしたユーザーの認証の設定
+type Auth struct {
+	// Mode 認証方式 (proxy, jwt, oidc)
+	Mode string `yaml:"mode"`
+	// UserHeader proxyのとき、ユーザーIDを読むヘッダー。空の場合はX-Forwarded-User
+	UserHeader string `yaml:"user_header"`
+	// TrustedProxies proxyのとき、信頼する送信元のCIDR
+	// 指定しない場合はループバックアドレスのみ、devではすべての送信元を信頼する
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	// UserClaim jwt, oidcのとき、ユーザーIDを読むクレーム。空の場合は認証方式ごとの既定値
+	UserClaim string `yaml:"user_claim"`
+	// JWKSFile jwtのとき、JWTの署名を検証する公開鍵のJWKSのファイルのパス
+	JWKSFile string `yaml:"jwks_file"`
+	// JWTIssuer jwtのとき、JWTのissに求める値。空の場合は検証しない
+	JWTIssuer string `yaml:"jwt_issuer"`
+	// JWTAudience jwtのとき、JWTのaudに求める値。空の場合は検証しない
+	JWTAudience string `yaml:"jwt_audience"`
+	// IntrospectionURL oidcのとき、トークンを検証するToken IntrospectionのURL
+	IntrospectionURL string `yaml:"introspection_url"`
+	// ClientID oidcのとき、Token Introspectionに使うクライアントID
+	ClientID string `yaml:"client_id"`
+	// ClientSecret oidcのとき、Token Introspectionに使うクライアントシークレット
+	ClientSecret string `yaml:"client_secret"`
+}
+
+// 回答の変更を配信する方式
+const (
+	// PubSubBackendMemory プロセス内でのみメッセージを配信する
+	PubSubBackendMemory = "memory"
+	// PubSubBackendDatabase データベースを経由して、複数のインスタンス間でメッセージを配信する
+	PubSubBackendDatabase = "database"
+)
+
+// PubSub 回答の変更の配信の設定
+type PubSub struct {
+	// Backend 配信する方式 (memory, database)。複数のインスタンスで動かす場合はdatabaseにする
+	Backend string `yaml:"backend"`
+}
+
+// Metrics Prometheusのメトリクスの設定
+type Metrics struct {
+	// Port メトリクスを返すアドレス (例: :1324)。認証しないため、APIサーバーとは別の外部に公開しないポートにする。空の場合は返さない
+	Port string `yaml:"port"`
+}
+
+// Load 設定を読み込む
+// 既定値、CONFIG_FILEで指定された設定ファイル、環境変数の順に上書きし、最後に値を検証する
+func Load() (*Config, error) {
+	return load(defaultConfig())
+}
+
+// LoadTest テスト用の設定を読み込む
+// ENVの既定値をtestにし、DB_DRIVERとMARIADB_HOSTNAMEが設定されていない場合はdirに置いたSQLiteを使う
+// これにより、MariaDBを用意しなくてもgo testだけでテストを実行できる
+func LoadTest(dir string) (*Config, error) {
+	cfg := defaultConfig()
+	cfg.Env = "test"
+
+	_, hasDriver := os.LookupEnv("DB_DRIVER")
+	_, hasMariaDB := os.LookupEnv("MARIADB_HOSTNAME")
+	if !hasDriver && !hasMariaDB {
+		cfg.DB.Driver = DBDriverSQLite
+		cfg.DB.Path = filepath.Join(dir, "anke-to.db")
+	}
+
+	return load(cfg)
+}
+
+func load(cfg *Config) (*Config, error) {
+	if path := os.Getenv(configFileEnvKey); path != "" {
+		err := cfg.loadFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := cfg.loadEnv(os.LookupEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Log.DBLevel == "" {
+		cfg.Log.DBLevel = LogLevelInfo
+		if cfg.Env == "production" {
+			cfg.Log.DBLevel = LogLevelSilent
+		}
+	}
+
+	err = cfg.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return cfg, nil
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Port: DefaultPort,
+		DB: DB{
+			Driver: DBDriverMySQL,
+			Port:   defaultMySQLPort,
+		},
+		Reminder: Reminder{
+			TimingMinutes: slices.Clone(DefaultReminderTimingMinutes),
+		},
+		RateLimit: RateLimit{
+			Questionnaires: DefaultQuestionnairesRateLimit,
+		},
+		Log: Log{
+			Level: LogLevelInfo,
+		},
+		Tracing: Tracing{
+			SampleRatio: DefaultTracingSampleRatio,
+		},
+		Auth: Auth{
+			Mode: AuthModeProxy,
+		},
+		PubSub: PubSub{
+			Backend: PubSubBackendMemory,
+		},
+		Metrics: Metrics{
+			Port: DefaultMetricsPort,
+		},
+	}
+}
+
+func (cfg *Config) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer f.Close()
+
+	decoder := yaml.NewDecoder(f)
+	decoder.KnownFields(true)
+	err = decoder.Decode(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	cfg.DB.setDefaultPort()
+
+	return nil
+}
+
+// loadEnv 環境変数で設定を上書きする
+// 設定されている環境変数は空文字列でも上書きする
+func (cfg *Config) loadEnv(lookupEnv func(string) (string, bool)) error {
+	setString := func(key string, dst *string) {
+		if v, ok := lookupEnv(key); ok {
+			*dst = v
+		}
+	}
+
+	setString("ENV", &cfg.Env)
+	setString("PORT", &cfg.Port)
+	setString("PUBLIC_BASE_URL", &cfg.PublicBaseURL)
+	setString("ANONYMOUS_RESPONSE_SECRET", &cfg.AnonymousResponseSecret)
+	if v, ok := lookupEnv("INITIAL_SYSTEM_ADMINS"); ok {
+		cfg.InitialSystemAdmins = splitList(v)
+	}
+
+	setString("DB_DRIVER", &cfg.DB.Driver)
+	setString("SQLITE_PATH", &cfg.DB.Path)
+	cfg.DB.setDefaultPort()
+	// PostgreSQLを使う場合とNeoShowcaseでデプロイする場合は、DBの環境変数の名前が異なる
+	switch {
+	case cfg.DB.Driver == DBDriverPostgres:
+		setString("POSTGRES_USERNAME", &cfg.DB.User)
+		setString("POSTGRES_PASSWORD", &cfg.DB.Password)
+		setString("POSTGRES_HOSTNAME", &cfg.DB.Host)
+		setString("POSTGRES_PORT", &cfg.DB.Port)
+		setString("POSTGRES_DATABASE", &cfg.DB.Name)
+	case cfg.Env == "neoshowcase":
+		setString("NS_MARIADB_USER", &cfg.DB.User)
+		setString("NS_MARIADB_PASSWORD", &cfg.DB.Password)
+		setString("NS_MARIADB_HOSTNAME", &cfg.DB.Host)
+		setString("NS_MARIADB_PORT", &cfg.DB.Port)
+		setString("NS_MARIADB_DATABASE", &cfg.DB.Name)
+	default:
+		setString("MARIADB_USERNAME", &cfg.DB.User)
+		setString("MARIADB_PASSWORD", &cfg.DB.Password)
+		setString("MARIADB_HOSTNAME", &cfg.DB.Host)
+		setString("MARIADB_PORT", &cfg.DB.Port)
+		setString("MARIADB_DATABASE", &cfg.DB.Name)
+	}
+
+	setString("TRAQ_BASE_URL", &cfg.TraQ.BaseURL)
+	setString("TRAQ_BOT_TOKEN", &cfg.TraQ.BotToken)
+	setString("TRAQ_WEBHOOK_ID", &cfg.TraQ.WebhookID)
+	setString("TRAQ_WEBHOOK_SECRET", &cfg.TraQ.WebhookSecret)
+	setString("TRAQ_BOT_VERIFICATION_TOKEN", &cfg.TraQ.BotVerificationToken)
+	setString("TRAQ_BOT_USER_ID", &cfg.TraQ.BotUserID)
+
+	if v, ok := lookupEnv("REMINDER_TIMING_MINUTES"); ok {
+		timings := []int{}
+		for _, s := range splitList(v) {
+			timing, err := strconv.Atoi(s)
+			if err != nil {
+				return fmt.Errorf("invalid REMINDER_TIMING_MINUTES: %w", err)
+			}
+			timings = append(timings, timing)
+		}
+		cfg.Reminder.TimingMinutes = timings
+	}
+
+	if v, ok := lookupEnv("RATE_LIMIT_QUESTIONNAIRES"); ok {
+		limit, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("invalid RATE_LIMIT_QUESTIONNAIRES: %w", err)
+		}
+		cfg.RateLimit.Questionnaires = limit
+	}
+
+	if v, ok := lookupEnv("LOG_LEVEL"); ok {
+		cfg.Log.Level = LogLevel(v)
+	}
+	if v, ok := lookupEnv("DB_LOG_LEVEL"); ok {
+		cfg.Log.DBLevel = LogLevel(v)
+	}
+
+	setString("TRACING_ENDPOINT", &cfg.Tracing.Endpoint)
+	if v, ok := lookupEnv("TRACING_SAMPLE_RATIO"); ok {
+		ratio, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("invalid TRACING_SAMPLE_RATIO: %w", err)
+		}
+		cfg.Tracing.SampleRatio = ratio
+	}
+
+	setString("AUTH_MODE", &cfg.Auth.Mode)
+	setString("AUTH_USER_HEADER", &cfg.Auth.UserHeader)
+	if v, ok := lookupEnv("AUTH_TRUSTED_PROXIES"); ok {
+		cfg.Auth.TrustedProxies = splitList(v)
+	}
+	setString("AUTH_USER_CLAIM", &cfg.Auth.UserClaim)
+	setString("AUTH_JWKS_FILE", &cfg.Auth.JWKSFile)
+	setString("AUTH_JWT_ISSUER", &cfg.Auth.JWTIssuer)
+	setString("AUTH_JWT_AUDIENCE", &cfg.Auth.JWTAudience)
+	setString("AUTH_INTROSPECTION_URL", &cfg.Auth.IntrospectionURL)
+	setString("AUTH_CLIENT_ID", &cfg.Auth.ClientID)
+	setString("AUTH_CLIENT_SECRET", &cfg.Auth.ClientSecret)
+
+	setString("PUBSUB_BACKEND", &cfg.PubSub.Backend)
+
+	setString("METRICS_PORT", &cfg.Metrics.Port)
+
+	return nil
+}
+
+// setDefaultPort PostgreSQLを使う場合は、ポートの既定値をPostgreSQLのものにする
+func (db *DB) setDefaultPort() {
+	if db.Driver == DBDriverPostgres && db.Port == defaultMySQLPort {
+		db.Port = defaultPostgresPort
+	}
+}
+
+// IsDevelopment 開発・テスト環境か
+// 開発・テスト環境では、秘密の値を設定しなくても起動できる
+func (cfg *Config) IsDevelopment() bool {
+	return cfg.Env == "dev" || cfg.Env == "test"
+}
+
+// Validate 設定を検証する
+// 不正な設定をすべてまとめたエラーを返す
+func (cfg *Config) Validate() error {
+	var errs []error
+
+	if cfg.Env == "" {
+		errs = append(errs, errors.New("env (ENV) is required"))
+	}
+	if cfg.Port == "" {
+		errs = append(errs, errors.New("port (PORT) is required"))
+	}
+	errs = append(errs, cfg.validateRequiredBaseURL("public_base_url (PUBLIC_BASE_URL)", cfg.PublicBaseURL))
+	if cfg.AnonymousResponseSecret == "" && !cfg.IsDevelopment() {
+		errs = append(errs, errors.New("anonymous_response_secret (ANONYMOUS_RESPONSE_SECRET) is required unless env is dev or test"))
+	}
+
+	switch cfg.DB.Driver {
+	case DBDriverMySQL, DBDriverPostgres:
+		envPrefix := "MARIADB"
+		if cfg.DB.Driver == DBDriverPostgres {
+			envPrefix = "POSTGRES"
+		}
+		if cfg.DB.User == "" {
+			errs = append(errs, fmt.Errorf("db.user (%s_USERNAME) is required", envPrefix))
+		}
+		if cfg.DB.Host == "" {
+			errs = append(errs, fmt.Errorf("db.host (%s_HOSTNAME) is required", envPrefix))
+		}
+		if port, err := strconv.Atoi(cfg.DB.Port); err != nil || port <= 0 || port > 65535 {
+			errs = append(errs, fmt.Errorf("db.port (%s_PORT) must be a port number: %q", envPrefix, cfg.DB.Port))
+		}
+		if cfg.DB.Name == "" {
+			errs = append(errs, fmt.Errorf("db.name (%s_DATABASE) is required", envPrefix))
+		}
+	case DBDriverSQLite:
+		if cfg.DB.Path == "" {
+			errs = append(errs, errors.New("db.path (SQLITE_PATH) is required when db.driver is sqlite"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("db.driver (DB_DRIVER) must be %s, %s or %s: %q", DBDriverMySQL, DBDriverPostgres, DBDriverSQLite, cfg.DB.Driver))
+	}
+
+	errs = append(errs, cfg.validateRequiredBaseURL("traq.base_url (TRAQ_BASE_URL)", cfg.TraQ.BaseURL))
+	if strings.TrimSpace(cfg.TraQ.BotToken) == "" && cfg.Env != "test" {
+		errs = append(errs, errors.New("traq.bot_token (TRAQ_BOT_TOKEN) is required unless env is test"))
+	}
+	if cfg.TraQ.BotVerificationToken != "" && strings.TrimSpace(cfg.TraQ.BotUserID) == "" {
+		errs = append(errs, errors.New("traq.bot_user_id (TRAQ_BOT_USER_ID) is required when traq.bot_verification_token is set"))
+	}
+
+	if len(cfg.Reminder.TimingMinutes) == 0 {
+		errs = append(errs, errors.New("reminder.timing_minutes (REMINDER_TIMING_MINUTES) must not be empty"))
+	}
+	for i, timing := range cfg.Reminder.TimingMinutes {
+		if timing <= 0 {
+			errs = append(errs, fmt.Errorf("reminder.timing_minutes (REMINDER_TIMING_MINUTES) must be positive: %d", timing))
+			break
+		}
+		if i > 0 && timing >= cfg.Reminder.TimingMinutes[i-1] {
+			errs = append(errs, errors.New("reminder.timing_minutes (REMINDER_TIMING_MINUTES) must be in descending order"))
+			break
+		}
+	}
+
+	if cfg.RateLimit.Questionnaires <= 0 {
+		errs = append(errs, fmt.Errorf("rate_limit.questionnaires (RATE_LIMIT_QUESTIONNAIRES) must be positive: %v", cfg.RateLimit.Questionnaires))
+	}
+
+	if !slices.Contains(logLevels, cfg.Log.Level) {
+		errs = append(errs, fmt.Errorf("log.level (LOG_LEVEL) must be one of %v: %q", logLevels, cfg.Log.Level))
+	}
+	if !slices.Contains(logLevels, cfg.Log.DBLevel) {
+		errs = append(errs, fmt.Errorf("log.db_level (DB_LOG_LEVEL) must be one of %v: %q", logLevels, cfg.Log.DBLevel))
+	}
+
+	if cfg.Tracing.Endpoint != "" {
+		errs = append(errs, validateBaseURL("tracing.endpoint (TRACING_ENDPOINT)", cfg.Tracing.Endpoint))
+	}
+	if cfg.Tracing.SampleRatio < 0 || cfg.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("tracing.sample_ratio (TRACING_SAMPLE_RATIO) must be between 0 and 1: %v", cfg.Tracing.SampleRatio))
+	}
+
+	switch cfg.Auth.Mode {
+	case AuthModeProxy:
+	case AuthModeJWT:
+		if cfg.Auth.JWKSFile == "" {
+			errs = append(errs, errors.New("auth.jwks_file (AUTH_JWKS_FILE) is required when auth.mode is jwt"))
+		}
+	case AuthModeOIDC:
+		if cfg.Auth.IntrospectionURL == "" {
+			errs = append(errs, errors.New("auth.introspection_url (AUTH_INTROSPECTION_URL) is required when auth.mode is oidc"))
+		} else {
+			errs = append(errs, validateBaseURL("auth.introspection_url (AUTH_INTROSPECTION_URL)", cfg.Auth.IntrospectionURL))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("auth.mode (AUTH_MODE) must be one of %v: %q", authModes, cfg.Auth.Mode))
+	}
+
+	if cfg.PubSub.Backend != PubSubBackendMemory && cfg.PubSub.Backend != PubSubBackendDatabase {
+		errs = append(errs, fmt.Errorf("pubsub.backend (PUBSUB_BACKEND) must be %s or %s: %q", PubSubBackendMemory, PubSubBackendDatabase, cfg.PubSub.Backend))
+	}
+
+	if cfg.Metrics.Port != "" && cfg.Metrics.Port == cfg.Port {
+		errs = append(errs, fmt.Errorf("metrics.port (METRICS_PORT) must differ from port (PORT): %q", cfg.Metrics.Port))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateRequiredBaseURL validateBaseURLに加えて、dev, test以外ではURLが空でないかを検証する
+// 本番環境のURLを既定値にすると、ステージング環境などから本番環境にリンクやリクエストを送ってしまうため、既定値は持たない
+func (cfg *Config) validateRequiredBaseURL(name string, baseURL string) error {
+	if baseURL == "" {
+		if cfg.IsDevelopment() {
+			return nil
+		}
+		return fmt.Errorf("%s is required unless env is dev or test", name)
+	}
+
+	return validateBaseURL(name, baseURL)
+}
+
+// validateBaseURL URLがhttpかhttpsの絶対URLで、末尾に/がないかを検証する
+func validateBaseURL(name string, baseURL string) error {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return fmt.Errorf("%s is not a valid URL: %w", name, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s must be an absolute http(s) URL: %q", name, baseURL)
+	}
+	if strings.HasSuffix(baseURL, "/") {
+		return fmt.Errorf("%s must not end with /: %q", name, baseURL)
+	}
+
+	return nil
+}
+
+func splitList(s string) []string {
+	list := []string{}
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
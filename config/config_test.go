@@ -0,0 +1,575 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validConfig() *Config {
+	cfg := defaultConfig()
+	cfg.Env = "production"
+	cfg.PublicBaseURL = "https://anke-to.trap.jp"
+	cfg.AnonymousResponseSecret = "secret"
+	cfg.DB = DB{
+		Driver:   DBDriverMySQL,
+		User:     "root",
+		Password: "password",
+		Host:     "mysql",
+		Port:     "3306",
+		Name:     "anke-to",
+	}
+	cfg.TraQ.BaseURL = "https://q.trap.jp"
+	cfg.TraQ.BotToken = "token"
+	cfg.Log.DBLevel = LogLevelSilent
+
+	return cfg
+}
+
+func TestLoadEnv(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type test struct {
+		description string
+		env         map[string]string
+		isErr       bool
+		expect      func(cfg *Config)
+	}
+
+	testCases := []test{
+		{
+			description: "環境変数で上書きできる",
+			env: map[string]string{
+				"ENV":                     "production",
+				"PORT":                    ":8080",
+				"PUBLIC_BASE_URL":         "https://anke-to-staging.trap.jp",
+				"MARIADB_USERNAME":        "user",
+				"MARIADB_HOSTNAME":        "db",
+				"TRAQ_BASE_URL":           "https://q-staging.trap.jp",
+				"INITIAL_SYSTEM_ADMINS":   "mazrean, ryoha,",
+				"REMINDER_TIMING_MINUTES": "1440, 60",
+				"LOG_LEVEL":               "debug",
+				"SQLITE_PATH":             "/tmp/anke-to.db",
+			},
+			expect: func(cfg *Config) {
+				assertion.Equal("production", cfg.Env)
+				assertion.Equal(":8080", cfg.Port)
+				assertion.Equal("https://anke-to-staging.trap.jp", cfg.PublicBaseURL)
+				assertion.Equal("user", cfg.DB.User)
+				assertion.Equal("db", cfg.DB.Host)
+				assertion.Equal("3306", cfg.DB.Port, "default db port")
+				assertion.Equal("https://q-staging.trap.jp", cfg.TraQ.BaseURL)
+				assertion.Equal([]string{"mazrean", "ryoha"}, cfg.InitialSystemAdmins)
+				assertion.Equal([]int{1440, 60}, cfg.Reminder.TimingMinutes)
+				assertion.Equal(LogLevelDebug, cfg.Log.Level)
+				assertion.Equal(DBDriverMySQL, cfg.DB.Driver, "default db driver")
+				assertion.Equal("/tmp/anke-to.db", cfg.DB.Path)
+			},
+		},
+		{
+			description: "neoshowcaseではNS_MARIADB_*を使う",
+			env: map[string]string{
+				"ENV":                 "neoshowcase",
+				"MARIADB_USERNAME":    "user",
+				"NS_MARIADB_USER":     "ns_user",
+				"NS_MARIADB_DATABASE": "ns_db",
+			},
+			expect: func(cfg *Config) {
+				assertion.Equal("ns_user", cfg.DB.User)
+				assertion.Equal("ns_db", cfg.DB.Name)
+			},
+		},
+		{
+			description: "postgresではPOSTGRES_*を使い、ポートの既定値が変わる",
+			env: map[string]string{
+				"DB_DRIVER":         "postgres",
+				"MARIADB_USERNAME":  "user",
+				"POSTGRES_USERNAME": "pg_user",
+				"POSTGRES_HOSTNAME": "postgres",
+			},
+			expect: func(cfg *Config) {
+				assertion.Equal(DBDriverPostgres, cfg.DB.Driver)
+				assertion.Equal("pg_user", cfg.DB.User)
+				assertion.Equal("postgres", cfg.DB.Host)
+				assertion.Equal("5432", cfg.DB.Port, "default postgres port")
+			},
+		},
+		{
+			description: "設定されていない環境変数は既定値のまま",
+			env:         map[string]string{},
+			expect: func(cfg *Config) {
+				assertion.Empty(cfg.PublicBaseURL, "no default public base url")
+				assertion.Empty(cfg.TraQ.BaseURL, "no default traq base url")
+				assertion.Equal(DefaultReminderTimingMinutes, cfg.Reminder.TimingMinutes)
+				assertion.Empty(cfg.Tracing.Endpoint)
+				assertion.Equal(DefaultTracingSampleRatio, cfg.Tracing.SampleRatio)
+				assertion.Equal(AuthModeProxy, cfg.Auth.Mode)
+				assertion.Nil(cfg.Auth.TrustedProxies, "default trusted proxies")
+				assertion.Equal(PubSubBackendMemory, cfg.PubSub.Backend)
+				assertion.Equal(DefaultMetricsPort, cfg.Metrics.Port)
+			},
+		},
+		{
+			description: "メトリクスを返さないようにできる",
+			env: map[string]string{
+				"METRICS_PORT": "",
+			},
+			expect: func(cfg *Config) {
+				assertion.Empty(cfg.Metrics.Port)
+			},
+		},
+		{
+			description: "認証と配信の設定を上書きできる",
+			env: map[string]string{
+				"AUTH_MODE":              "oidc",
+				"AUTH_USER_CLAIM":        "sub",
+				"AUTH_INTROSPECTION_URL": "https://q.trap.jp/api/v3/oauth2/introspect",
+				"AUTH_CLIENT_ID":         "client",
+				"AUTH_CLIENT_SECRET":     "secret",
+				"AUTH_TRUSTED_PROXIES":   "10.0.0.0/8, 192.168.0.0/16",
+				"PUBSUB_BACKEND":         "database",
+			},
+			expect: func(cfg *Config) {
+				assertion.Equal(AuthModeOIDC, cfg.Auth.Mode)
+				assertion.Equal("sub", cfg.Auth.UserClaim)
+				assertion.Equal("https://q.trap.jp/api/v3/oauth2/introspect", cfg.Auth.IntrospectionURL)
+				assertion.Equal("client", cfg.Auth.ClientID)
+				assertion.Equal("secret", cfg.Auth.ClientSecret)
+				assertion.Equal([]string{"10.0.0.0/8", "192.168.0.0/16"}, cfg.Auth.TrustedProxies)
+				assertion.Equal(PubSubBackendDatabase, cfg.PubSub.Backend)
+			},
+		},
+		{
+			description: "信頼するプロキシを空にできる",
+			env: map[string]string{
+				"AUTH_TRUSTED_PROXIES": "",
+			},
+			expect: func(cfg *Config) {
+				assertion.NotNil(cfg.Auth.TrustedProxies)
+				assertion.Empty(cfg.Auth.TrustedProxies)
+			},
+		},
+		{
+			description: "リマインダーのタイミングが数値でないのでエラー",
+			env: map[string]string{
+				"REMINDER_TIMING_MINUTES": "1day",
+			},
+			isErr: true,
+		},
+		{
+			description: "レート制限が数値でないのでエラー",
+			env: map[string]string{
+				"RATE_LIMIT_QUESTIONNAIRES": "many",
+			},
+			isErr: true,
+		},
+		{
+			description: "トレースの設定を上書きできる",
+			env: map[string]string{
+				"TRACING_ENDPOINT":     "http://otel-collector:4318/v1/traces",
+				"TRACING_SAMPLE_RATIO": "0.1",
+			},
+			expect: func(cfg *Config) {
+				assertion.Equal("http://otel-collector:4318/v1/traces", cfg.Tracing.Endpoint)
+				assertion.Equal(0.1, cfg.Tracing.SampleRatio)
+			},
+		},
+		{
+			description: "トレースの割合が数値でないのでエラー",
+			env: map[string]string{
+				"TRACING_SAMPLE_RATIO": "half",
+			},
+			isErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		cfg := defaultConfig()
+		err := cfg.loadEnv(func(key string) (string, bool) {
+			v, ok := testCase.env[key]
+			return v, ok
+		})
+		if testCase.isErr {
+			assertion.Error(err, testCase.description)
+			continue
+		}
+		if !assertion.NoError(err, testCase.description) {
+			continue
+		}
+		testCase.expect(cfg)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "config.yaml")
+	err := os.WriteFile(path, []byte(`env: staging
+public_base_url: https://anke-to-staging.trap.jp
+db:
+  host: mysql
+reminder:
+  timing_minutes: [1440, 60]
+rate_limit:
+  questionnaires: 10
+log:
+  level: warn
+`), 0o600)
+	require.NoError(t, err)
+
+	cfg := defaultConfig()
+	err = cfg.loadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "staging", cfg.Env)
+	assert.Equal(t, "https://anke-to-staging.trap.jp", cfg.PublicBaseURL)
+	assert.Equal(t, "mysql", cfg.DB.Host)
+	assert.Equal(t, "3306", cfg.DB.Port, "default db port")
+	assert.Equal(t, []int{1440, 60}, cfg.Reminder.TimingMinutes)
+	assert.Equal(t, 10.0, cfg.RateLimit.Questionnaires)
+	assert.Equal(t, LogLevelWarn, cfg.Log.Level)
+
+	unknownPath := filepath.Join(dir, "unknown.yaml")
+	err = os.WriteFile(unknownPath, []byte("public_url: https://anke-to.trap.jp\n"), 0o600)
+	require.NoError(t, err)
+	assert.Error(t, defaultConfig().loadFile(unknownPath), "unknown field")
+
+	assert.Error(t, defaultConfig().loadFile(filepath.Join(dir, "missing.yaml")), "missing file")
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type test struct {
+		description string
+		modify      func(cfg *Config)
+		isErr       bool
+	}
+
+	testCases := []test{
+		{
+			description: "正しい設定なので問題なし",
+			modify:      func(*Config) {},
+		},
+		{
+			description: "ENVがないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Env = ""
+			},
+			isErr: true,
+		},
+		{
+			description: "productionで匿名回答の鍵がないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.AnonymousResponseSecret = ""
+			},
+			isErr: true,
+		},
+		{
+			description: "devでは匿名回答の鍵がなくても問題なし",
+			modify: func(cfg *Config) {
+				cfg.Env = "dev"
+				cfg.AnonymousResponseSecret = ""
+			},
+		},
+		{
+			description: "testではtraQのトークンがなくても問題なし",
+			modify: func(cfg *Config) {
+				cfg.Env = "test"
+				cfg.TraQ.BotToken = ""
+			},
+		},
+		{
+			description: "traQのトークンがないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.TraQ.BotToken = " "
+			},
+			isErr: true,
+		},
+		{
+			description: "BOTのVerification TokenとユーザーIDがあるので問題なし",
+			modify: func(cfg *Config) {
+				cfg.TraQ.BotVerificationToken = "token"
+				cfg.TraQ.BotUserID = "7c3d6b5e-0a8e-4f1e-9d3c-4c5e6f7a8b9c"
+			},
+		},
+		{
+			description: "BOTのVerification TokenがあるのにユーザーIDがないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.TraQ.BotVerificationToken = "token"
+			},
+			isErr: true,
+		},
+		{
+			description: "DBのホストがないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.DB.Host = ""
+			},
+			isErr: true,
+		},
+		{
+			description: "DBのポートが数値でないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.DB.Port = "mysql"
+			},
+			isErr: true,
+		},
+		{
+			description: "sqliteではMariaDBの設定がなくても問題なし",
+			modify: func(cfg *Config) {
+				cfg.DB = DB{Driver: DBDriverSQLite, Path: "anke-to.db"}
+			},
+		},
+		{
+			description: "postgresでも同じ接続先の設定で問題なし",
+			modify: func(cfg *Config) {
+				cfg.DB.Driver = DBDriverPostgres
+				cfg.DB.Port = "5432"
+			},
+		},
+		{
+			description: "postgresでDBのユーザーがないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.DB.Driver = DBDriverPostgres
+				cfg.DB.User = ""
+			},
+			isErr: true,
+		},
+		{
+			description: "sqliteでファイルのパスがないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.DB = DB{Driver: DBDriverSQLite}
+			},
+			isErr: true,
+		},
+		{
+			description: "DBのドライバーが不正なのでエラー",
+			modify: func(cfg *Config) {
+				cfg.DB.Driver = "oracle"
+			},
+			isErr: true,
+		},
+		{
+			description: "公開URLが相対URLなのでエラー",
+			modify: func(cfg *Config) {
+				cfg.PublicBaseURL = "anke-to.trap.jp"
+			},
+			isErr: true,
+		},
+		{
+			description: "公開URLの末尾が/なのでエラー",
+			modify: func(cfg *Config) {
+				cfg.PublicBaseURL = "https://anke-to.trap.jp/"
+			},
+			isErr: true,
+		},
+		{
+			description: "productionで公開URLがないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.PublicBaseURL = ""
+			},
+			isErr: true,
+		},
+		{
+			description: "productionでtraQのURLがないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.TraQ.BaseURL = ""
+			},
+			isErr: true,
+		},
+		{
+			description: "devでは公開URLとtraQのURLがなくても問題なし",
+			modify: func(cfg *Config) {
+				cfg.Env = "dev"
+				cfg.PublicBaseURL = ""
+				cfg.TraQ.BaseURL = ""
+			},
+		},
+		{
+			description: "traQのURLがhttp(s)でないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.TraQ.BaseURL = "ftp://q.trap.jp"
+			},
+			isErr: true,
+		},
+		{
+			description: "リマインダーのタイミングが空なのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Reminder.TimingMinutes = []int{}
+			},
+			isErr: true,
+		},
+		{
+			description: "リマインダーのタイミングが大きい順でないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Reminder.TimingMinutes = []int{60, 1440}
+			},
+			isErr: true,
+		},
+		{
+			description: "リマインダーのタイミングが負なのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Reminder.TimingMinutes = []int{-60}
+			},
+			isErr: true,
+		},
+		{
+			description: "レート制限が0なのでエラー",
+			modify: func(cfg *Config) {
+				cfg.RateLimit.Questionnaires = 0
+			},
+			isErr: true,
+		},
+		{
+			description: "ログレベルが不正なのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Log.Level = "verbose"
+			},
+			isErr: true,
+		},
+		{
+			description: "トレースの送信先があっても問題なし",
+			modify: func(cfg *Config) {
+				cfg.Tracing.Endpoint = "http://otel-collector:4318/v1/traces"
+			},
+		},
+		{
+			description: "トレースの送信先が相対URLなのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Tracing.Endpoint = "otel-collector:4318"
+			},
+			isErr: true,
+		},
+		{
+			description: "トレースの割合が1より大きいのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Tracing.SampleRatio = 1.5
+			},
+			isErr: true,
+		},
+		{
+			description: "認証方式が不正なのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Auth.Mode = "basic"
+			},
+			isErr: true,
+		},
+		{
+			description: "jwtで公開鍵のファイルがあるので問題なし",
+			modify: func(cfg *Config) {
+				cfg.Auth.Mode = AuthModeJWT
+				cfg.Auth.JWKSFile = "jwks.json"
+			},
+		},
+		{
+			description: "jwtで公開鍵のファイルがないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Auth.Mode = AuthModeJWT
+			},
+			isErr: true,
+		},
+		{
+			description: "oidcでToken IntrospectionのURLがないのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Auth.Mode = AuthModeOIDC
+			},
+			isErr: true,
+		},
+		{
+			description: "oidcでToken IntrospectionのURLが相対URLなのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Auth.Mode = AuthModeOIDC
+				cfg.Auth.IntrospectionURL = "q.trap.jp/api/v3/oauth2/introspect"
+			},
+			isErr: true,
+		},
+		{
+			description: "メトリクスを返さなくても問題なし",
+			modify: func(cfg *Config) {
+				cfg.Metrics.Port = ""
+			},
+		},
+		{
+			description: "メトリクスのポートがAPIサーバーと同じなのでエラー",
+			modify: func(cfg *Config) {
+				cfg.Metrics.Port = cfg.Port
+			},
+			isErr: true,
+		},
+		{
+			description: "配信の方式が不正なのでエラー",
+			modify: func(cfg *Config) {
+				cfg.PubSub.Backend = "redis"
+			},
+			isErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		cfg := validConfig()
+		testCase.modify(cfg)
+
+		err := cfg.Validate()
+		if testCase.isErr {
+			assertion.Error(err, testCase.description)
+		} else {
+			assertion.NoError(err, testCase.description)
+		}
+	}
+}
+
+func TestValidateReportsAllErrors(t *testing.T) {
+	t.Parallel()
+
+	cfg := validConfig()
+	cfg.Env = ""
+	cfg.DB.Host = ""
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "env (ENV) is required")
+	assert.Contains(t, err.Error(), "db.host (MARIADB_HOSTNAME) is required")
+}
+
+func TestLoadExampleFile(t *testing.T) {
+	t.Parallel()
+
+	cfg := defaultConfig()
+	err := cfg.loadFile("../config.example.yaml")
+	require.NoError(t, err)
+
+	cfg.AnonymousResponseSecret = "secret"
+	cfg.TraQ.BotToken = "token"
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestLoadTest(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Setenv("DB_DRIVER", "")
+	os.Unsetenv("DB_DRIVER")
+	t.Setenv("MARIADB_HOSTNAME", "")
+	os.Unsetenv("MARIADB_HOSTNAME")
+
+	cfg, err := LoadTest(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "test", cfg.Env)
+	assert.Equal(t, DBDriverSQLite, cfg.DB.Driver)
+	assert.Equal(t, filepath.Join(dir, "anke-to.db"), cfg.DB.Path)
+
+	t.Setenv("MARIADB_HOSTNAME", "127.0.0.1")
+	t.Setenv("MARIADB_USERNAME", "root")
+	t.Setenv("MARIADB_DATABASE", "anke-to")
+
+	cfg, err = LoadTest(dir)
+	require.NoError(t, err)
+	assert.Equal(t, DBDriverMySQL, cfg.DB.Driver, "MariaDBが設定されている場合はMariaDBを使う")
+}
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/openapi"
+	"gopkg.in/guregu/null.v4"
+)
+
+// AccessToken AccessTokenの構造体
+type AccessToken struct {
+	model.IAccessToken
+}
+
+func NewAccessToken(accessToken model.IAccessToken) *AccessToken {
+	return &AccessToken{
+		IAccessToken: accessToken,
+	}
+}
+
+const (
+	// AccessTokenPrefix 個人用アクセストークンの接頭辞
+	// 他の認証方式のBearerトークンと区別するために使う
+	AccessTokenPrefix           = "anketo_"
+	MaxAccessTokenNameLength    = 50
+	DefaultAccessTokenRateLimit = 60
+	MaxAccessTokenRateLimit     = 600
+)
+
+// generateAccessToken アクセストークンとそのハッシュを生成する
+func generateAccessToken() (string, string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	token := AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	return token, hashAccessToken(token), nil
+}
+
+// hashAccessToken アクセストークンのハッシュを計算する
+// トークンは十分なエントロピーを持つため、ソルトは使わない
+func hashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (a *AccessToken) GetAccessTokens(c echo.Context, userID string) ([]openapi.AccessToken, error) {
+	accessTokens, err := a.IAccessToken.GetAccessTokens(c.Request().Context(), userID)
+	if err != nil {
+		c.Logger().Errorf("failed to get access tokens: %+v", err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get access tokens")
+	}
+
+	res := make([]openapi.AccessToken, 0, len(accessTokens))
+	for _, accessToken := range accessTokens {
+		res = append(res, convertAccessToken(accessToken))
+	}
+
+	return res, nil
+}
+
+func (a *AccessToken) PostAccessToken(c echo.Context, userID string, params openapi.PostAccessTokenJSONRequestBody) (openapi.AccessTokenWithSecret, error) {
+	name := strings.TrimSpace(params.Name)
+	if len(name) == 0 || utf8.RuneCountInString(name) > MaxAccessTokenNameLength {
+		c.Logger().Infof("invalid access token name: %+v", params.Name)
+		return openapi.AccessTokenWithSecret{}, echo.NewHTTPError(http.StatusBadRequest, "invalid access token name")
+	}
+
+	if len(params.Scopes) == 0 {
+		c.Logger().Info("no access token scopes")
+		return openapi.AccessTokenWithSecret{}, echo.NewHTTPError(http.StatusBadRequest, "no access token scopes")
+	}
+	scopes := make([]model.AccessTokenScope, 0, len(params.Scopes))
+	for _, scope := range params.Scopes {
+		scopes = append(scopes, model.AccessTokenScope(scope))
+	}
+
+	rateLimit := DefaultAccessTokenRateLimit
+	if params.RateLimit != nil {
+		rateLimit = *params.RateLimit
+	}
+	if rateLimit < 1 || rateLimit > MaxAccessTokenRateLimit {
+		c.Logger().Infof("invalid access token rate limit: %d", rateLimit)
+		return openapi.AccessTokenWithSecret{}, echo.NewHTTPError(http.StatusBadRequest, "invalid rate limit")
+	}
+
+	expiresAt := null.Time{}
+	if params.ExpiresAt != nil {
+		if !params.ExpiresAt.After(time.Now()) {
+			c.Logger().Infof("access token already expired: %+v", params.ExpiresAt)
+			return openapi.AccessTokenWithSecret{}, echo.NewHTTPError(http.StatusBadRequest, "expires_at must be in the future")
+		}
+		expiresAt = null.TimeFrom(*params.ExpiresAt)
+	}
+
+	token, tokenHash, err := generateAccessToken()
+	if err != nil {
+		c.Logger().Errorf("failed to generate access token: %+v", err)
+		return openapi.AccessTokenWithSecret{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to generate access token")
+	}
+
+	accessTokenID, err := a.InsertAccessToken(c.Request().Context(), userID, name, tokenHash, scopes, rateLimit, expiresAt)
+	if errors.Is(err, model.ErrInvalidAccessTokenScope) {
+		c.Logger().Infof("invalid access token scopes: %+v", params.Scopes)
+		return openapi.AccessTokenWithSecret{}, echo.NewHTTPError(http.StatusBadRequest, "invalid access token scopes")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to insert access token: %+v", err)
+		return openapi.AccessTokenWithSecret{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to insert access token")
+	}
+	c.Logger().Infof("access token issued: user=%s access_token_id=%d", userID, accessTokenID)
+
+	accessToken, err := a.GetAccessTokenByHash(c.Request().Context(), tokenHash)
+	if err != nil {
+		c.Logger().Errorf("failed to get access token: %+v", err)
+		return openapi.AccessTokenWithSecret{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get access token")
+	}
+
+	res := convertAccessToken(*accessToken)
+
+	return openapi.AccessTokenWithSecret{
+		AccessTokenId: res.AccessTokenId,
+		Name:          res.Name,
+		Scopes:        res.Scopes,
+		RateLimit:     res.RateLimit,
+		ExpiresAt:     res.ExpiresAt,
+		LastUsedAt:    res.LastUsedAt,
+		CreatedAt:     res.CreatedAt,
+		Token:         token,
+	}, nil
+}
+
+func (a *AccessToken) DeleteAccessToken(c echo.Context, userID string, accessTokenID int) error {
+	err := a.IAccessToken.DeleteAccessToken(c.Request().Context(), userID, accessTokenID)
+	if errors.Is(err, model.ErrNoRecordDeleted) {
+		return echo.NewHTTPError(http.StatusNotFound, "access token not found")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to delete access token: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete access token")
+	}
+	c.Logger().Infof("access token revoked: user=%s access_token_id=%d", userID, accessTokenID)
+
+	return nil
+}
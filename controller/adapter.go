@@ -9,12 +9,13 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/i18n"
 	"github.com/traPtitech/anke-to/model"
 	"github.com/traPtitech/anke-to/openapi"
 	"gopkg.in/guregu/null.v4"
 )
 
-func questionnaireInfo2questionnaireSummary(questionnaireInfo model.QuestionnaireInfo, allResponded bool, hasMyDraft bool, hasMyResponse bool, respondedDateTimeByMe null.Time) *openapi.QuestionnaireSummary {
+func questionnaireInfo2questionnaireSummary(questionnaireInfo model.QuestionnaireInfo, allResponded bool, hasMyDraft bool, hasMyResponse bool, respondedDateTimeByMe null.Time, tags []model.Tags) *openapi.QuestionnaireSummary {
 	res := openapi.QuestionnaireSummary{
 		AllResponded:             allResponded,
 		CreatedAt:                questionnaireInfo.CreatedAt,
@@ -29,6 +30,7 @@ func questionnaireInfo2questionnaireSummary(questionnaireInfo model.Questionnair
 		ModifiedAt:               questionnaireInfo.ModifiedAt,
 		QuestionnaireId:          questionnaireInfo.ID,
 		ResponseViewableBy:       convertResSharedTo(questionnaireInfo.ResSharedTo),
+		Tags:                     convertTags(tags),
 		Title:                    questionnaireInfo.Title,
 	}
 	if respondedDateTimeByMe.Valid {
@@ -44,12 +46,39 @@ func questionnaireInfo2questionnaireSummary(questionnaireInfo model.Questionnair
 	return &res
 }
 
+func convertTags(tags []model.Tags) []openapi.Tag {
+	res := make([]openapi.Tag, 0, len(tags))
+	for _, tag := range tags {
+		res = append(res, openapi.Tag{
+			TagId: tag.ID,
+			Name:  tag.Name,
+		})
+	}
+	return res
+}
+
+func convertTagCounts(tagCounts []model.TagCount) []openapi.TagWithCount {
+	res := make([]openapi.TagWithCount, 0, len(tagCounts))
+	for _, tagCount := range tagCounts {
+		res = append(res, openapi.TagWithCount{
+			TagId:              tagCount.ID,
+			Name:               tagCount.Name,
+			QuestionnaireCount: tagCount.Count,
+		})
+	}
+	return res
+}
+
 func convertResponseViewableBy(resShareType openapi.ResShareType) string {
 	switch resShareType {
 	case "admins":
 		return "administrators"
 	case "respondents":
 		return "respondents"
+	case "targets":
+		return "targets"
+	case "viewers":
+		return "viewers"
 	case "anyone":
 		return "public"
 	default:
@@ -63,6 +92,10 @@ func convertResSharedTo(resSharedTo string) openapi.ResShareType {
 		return "admins"
 	case "respondents":
 		return "respondents"
+	case "targets":
+		return "targets"
+	case "viewers":
+		return "viewers"
 	case "public":
 		return "anyone"
 	default:
@@ -79,6 +112,30 @@ func createUsersAndGroups(users []string, groups uuid.UUIDs) openapi.UsersAndGro
 	return res
 }
 
+func createUsersAndGroupsByRole(adminUsers []model.AdministratorUsers, adminGroups []model.AdministratorGroups) map[model.AdministratorRole]openapi.UsersAndGroups {
+	res := make(map[model.AdministratorRole]openapi.UsersAndGroups, len(model.AdministratorRoles))
+	for _, role := range model.AdministratorRoles {
+		res[role] = createUsersAndGroups([]string{}, uuid.UUIDs{})
+	}
+	for _, adminUser := range adminUsers {
+		usersAndGroups, ok := res[adminUser.Role]
+		if !ok {
+			continue
+		}
+		usersAndGroups.Users = append(usersAndGroups.Users, adminUser.UserTraqid)
+		res[adminUser.Role] = usersAndGroups
+	}
+	for _, adminGroup := range adminGroups {
+		usersAndGroups, ok := res[adminGroup.Role]
+		if !ok {
+			continue
+		}
+		usersAndGroups.Groups = append(usersAndGroups.Groups, adminGroup.GroupID)
+		res[adminGroup.Role] = usersAndGroups
+	}
+	return res
+}
+
 func convertOptions(options []model.Options) openapi.QuestionSettingsSingleChoice {
 	res := openapi.QuestionSettingsSingleChoice{}
 	for _, option := range options {
@@ -87,7 +144,42 @@ func convertOptions(options []model.Options) openapi.QuestionSettingsSingleChoic
 	return res
 }
 
-func convertQuestions(questions []model.Questions) ([]openapi.Question, error) {
+func convertCorrectOptions(options []model.Options) *[]string {
+	correctOptions := []string{}
+	for _, option := range options {
+		if option.IsCorrect {
+			correctOptions = append(correctOptions, option.Body)
+		}
+	}
+	return &correctOptions
+}
+
+// getQuizAnswerValidation クイズの正解の条件を取得する
+func getQuizAnswerValidation(questionID int) (model.Validations, error) {
+	validations, err := model.NewValidation().GetValidations(context.Background(), []int{questionID})
+	if err != nil {
+		return model.Validations{}, err
+	}
+	if len(validations) == 0 {
+		return model.Validations{}, nil
+	}
+	return validations[0], nil
+}
+
+func parseNumberBound(bound string) (*float64, error) {
+	if bound == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseFloat(bound, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// convertQuestions 質問を変換する
+// showsQuizAnswersがfalseの場合は、クイズの正解を含めない
+func convertQuestions(questions []model.Questions, showsQuizAnswers bool) ([]openapi.Question, error) {
 	res := []openapi.Question{}
 	for _, question := range questions {
 		q := openapi.Question{
@@ -96,30 +188,55 @@ func convertQuestions(questions []model.Questions) ([]openapi.Question, error) {
 			Description: question.Description,
 			IsRequired:  question.IsRequired,
 			QuestionId:  &question.ID,
+			// 回答を見せない質問かは、回答する人にもわかるようにする
+			IsResponseHidden: &question.IsResponseHidden,
+			Points:           &question.Points,
+		}
+		var validation model.Validations
+		if showsQuizAnswers {
+			var err error
+			validation, err = getQuizAnswerValidation(question.ID)
+			if err != nil {
+				return nil, err
+			}
 		}
 		switch question.Type {
 		case "Text":
-			err := q.FromQuestionSettingsText(
-				openapi.QuestionSettingsText{
-					QuestionType: "Text",
-				},
-			)
+			settings := openapi.QuestionSettingsText{
+				QuestionType: "Text",
+			}
+			if validation.AnswerRegexPattern != "" {
+				settings.CorrectAnswerPattern = &validation.AnswerRegexPattern
+			}
+			err := q.FromQuestionSettingsText(settings)
 			if err != nil {
 				return nil, err
 			}
 		case "TextArea":
-			err := q.FromQuestionSettingsText(
-				openapi.QuestionSettingsText{
-					QuestionType: "TextLong",
-				},
-			)
+			settings := openapi.QuestionSettingsText{
+				QuestionType: "TextLong",
+			}
+			if validation.AnswerRegexPattern != "" {
+				settings.CorrectAnswerPattern = &validation.AnswerRegexPattern
+			}
+			err := q.FromQuestionSettingsText(settings)
 			if err != nil {
 				return nil, err
 			}
 		case "Number":
-			err := q.FromQuestionSettingsNumber(
+			correctMinValue, err := parseNumberBound(validation.AnswerMinBound)
+			if err != nil {
+				return nil, err
+			}
+			correctMaxValue, err := parseNumberBound(validation.AnswerMaxBound)
+			if err != nil {
+				return nil, err
+			}
+			err = q.FromQuestionSettingsNumber(
 				openapi.QuestionSettingsNumber{
-					QuestionType: "Number",
+					QuestionType:    "Number",
+					CorrectMinValue: correctMinValue,
+					CorrectMaxValue: correctMaxValue,
 				},
 			)
 			if err != nil {
@@ -131,12 +248,14 @@ func convertQuestions(questions []model.Questions) ([]openapi.Question, error) {
 			if err != nil {
 				return nil, err
 			}
-			err = q.FromQuestionSettingsSingleChoice(
-				openapi.QuestionSettingsSingleChoice{
-					QuestionType: "SingleChoice",
-					Options:      convertOptions(question.Options).Options,
-				},
-			)
+			settings := openapi.QuestionSettingsSingleChoice{
+				QuestionType: "SingleChoice",
+				Options:      convertOptions(question.Options).Options,
+			}
+			if showsQuizAnswers {
+				settings.CorrectOptions = convertCorrectOptions(question.Options)
+			}
+			err = q.FromQuestionSettingsSingleChoice(settings)
 			if err != nil {
 				return nil, err
 			}
@@ -146,12 +265,14 @@ func convertQuestions(questions []model.Questions) ([]openapi.Question, error) {
 			if err != nil {
 				return nil, err
 			}
-			err = q.FromQuestionSettingsMultipleChoice(
-				openapi.QuestionSettingsMultipleChoice{
-					QuestionType: "MultipleChoice",
-					Options:      convertOptions(question.Options).Options,
-				},
-			)
+			settings := openapi.QuestionSettingsMultipleChoice{
+				QuestionType: "MultipleChoice",
+				Options:      convertOptions(question.Options).Options,
+			}
+			if showsQuizAnswers {
+				settings.CorrectOptions = convertCorrectOptions(question.Options)
+			}
+			err = q.FromQuestionSettingsMultipleChoice(settings)
 			if err != nil {
 				return nil, err
 			}
@@ -182,12 +303,12 @@ func convertQuestions(questions []model.Questions) ([]openapi.Question, error) {
 	return res, nil
 }
 
-func questionnaire2QuestionnaireDetail(questionnaires model.Questionnaires, admins []string, adminUsers []string, adminGroups []uuid.UUID, targets []string, targetUsers []string, targetGroups []uuid.UUID, respondents []string) (openapi.QuestionnaireDetail, error) {
+func questionnaire2QuestionnaireDetail(questionnaires model.Questionnaires, admins []string, adminUsers []model.AdministratorUsers, adminGroups []model.AdministratorGroups, targets []string, targetUsers []string, targetGroups []uuid.UUID, responseViewerUsers []string, responseViewerGroups []uuid.UUID, respondents []string, tags []model.Tags, showsQuizAnswers bool) (openapi.QuestionnaireDetail, error) {
 	questions, err := model.NewQuestion().GetQuestions(context.Background(), questionnaires.ID)
 	if err != nil {
 		return openapi.QuestionnaireDetail{}, err
 	}
-	questionsConverted, err := convertQuestions(questions)
+	questionsConverted, err := convertQuestions(questions, showsQuizAnswers)
 	if err != nil {
 		return openapi.QuestionnaireDetail{}, err
 	}
@@ -205,25 +326,41 @@ func questionnaire2QuestionnaireDetail(questionnaires model.Questionnaires, admi
 	if questionnaires.IsAnonymous {
 		respondents = []string{}
 	}
+	adminsByRole := createUsersAndGroupsByRole(adminUsers, adminGroups)
+	editors := adminsByRole[model.AdministratorRoleEditor]
+	viewers := adminsByRole[model.AdministratorRoleViewer]
+	responseViewers := createUsersAndGroups(responseViewerUsers, responseViewerGroups)
+	language := openapi.Language(i18n.ParseLanguage(questionnaires.Language))
 	res := openapi.QuestionnaireDetail{
-		Admin:                    createUsersAndGroups(adminUsers, adminGroups),
-		Admins:                   admins,
-		CreatedAt:                questionnaires.CreatedAt,
-		Description:              questionnaires.Description,
-		IsDuplicateAnswerAllowed: questionnaires.IsDuplicateAnswerAllowed,
-		IsAnonymous:              questionnaires.IsAnonymous,
-		IsPublished:              questionnaires.IsPublished,
-		ModifiedAt:               questionnaires.ModifiedAt,
-		QuestionnaireId:          questionnaires.ID,
-		Questions:                questionsConverted,
-		Respondents:              respondents,
-		RespondentCount:          &respondentCount,
-		ResponseCount:            &responseCount,
-		ResponseDueDateTime:      responseDueDateTime,
-		ResponseViewableBy:       convertResSharedTo(questionnaires.ResSharedTo),
-		Target:                   createUsersAndGroups(targetUsers, targetGroups),
-		Targets:                  targets,
-		Title:                    questionnaires.Title,
+		Admin:                      adminsByRole[model.AdministratorRoleOwner],
+		Admins:                     admins,
+		AnnouncementTemplate:       questionnaires.AnnouncementTemplate.Ptr(),
+		Editor:                     &editors,
+		CreatedAt:                  questionnaires.CreatedAt,
+		Description:                questionnaires.Description,
+		IsDuplicateAnswerAllowed:   questionnaires.IsDuplicateAnswerAllowed,
+		IsAnonymous:                questionnaires.IsAnonymous,
+		IsPublished:                questionnaires.IsPublished,
+		IsQuiz:                     &questionnaires.IsQuiz,
+		IsQuizAnswerHiddenUntilDue: &questionnaires.IsQuizAnswerHiddenUntilDue,
+		IsResponseAggregateOnly:    &questionnaires.IsResponseAggregateOnly,
+		IsResponseHiddenUntilDue:   &questionnaires.IsResponseHiddenUntilDue,
+		Language:                   &language,
+		ModifiedAt:                 questionnaires.ModifiedAt,
+		QuestionnaireId:            questionnaires.ID,
+		Questions:                  questionsConverted,
+		ReminderTemplate:           questionnaires.ReminderTemplate.Ptr(),
+		Respondents:                respondents,
+		RespondentCount:            &respondentCount,
+		ResponseCount:              &responseCount,
+		ResponseDueDateTime:        responseDueDateTime,
+		ResponseViewableBy:         convertResSharedTo(questionnaires.ResSharedTo),
+		ResponseViewers:            &responseViewers,
+		Tags:                       convertTags(tags),
+		Target:                     createUsersAndGroups(targetUsers, targetGroups),
+		Targets:                    targets,
+		Title:                      questionnaires.Title,
+		Viewer:                     &viewers,
 	}
 	return res, nil
 }
@@ -339,6 +476,12 @@ func respondentDetail2ResponseWithMetadata(ctx echo.Context, respondentDetail mo
 		respondent = nil
 	}
 
+	var score *int
+	if respondentDetail.Score.Valid {
+		s := int(respondentDetail.Score.Int64)
+		score = &s
+	}
+
 	res := openapi.Response{
 		Body:            oResponseBodies,
 		IsAnonymous:     isAnonymous,
@@ -346,13 +489,31 @@ func respondentDetail2ResponseWithMetadata(ctx echo.Context, respondentDetail mo
 		ModifiedAt:      respondentDetail.ModifiedAt,
 		QuestionnaireId: respondentDetail.QuestionnaireID,
 		Respondent:      respondent,
+		EnteredBy:       respondentDetail.EnteredBy.Ptr(),
+		Review:          respondentDetail2ResponseReview(respondentDetail),
 		ResponseId:      respondentDetail.ResponseID,
+		Score:           score,
 		SubmittedAt:     respondentDetail.SubmittedAt.Time,
 	}
 
 	return res, nil
 }
 
+// respondentDetail2ResponseReview 回答の審査状況を変換する
+func respondentDetail2ResponseReview(respondentDetail model.RespondentDetail) *openapi.ResponseReview {
+	status := respondentDetail.ReviewStatus
+	if status == "" {
+		status = model.ReviewStatusPending
+	}
+
+	return &openapi.ResponseReview{
+		Status:     openapi.ReviewStatus(status),
+		Comment:    respondentDetail.ReviewComment.Ptr(),
+		ReviewedBy: respondentDetail.ReviewedBy.Ptr(),
+		ReviewedAt: respondentDetail.ReviewedAt.Ptr(),
+	}
+}
+
 func respondentDetail2Response(ctx echo.Context, respondentDetail model.RespondentDetail) (openapi.Response, error) {
 	isAnonymous, err := model.NewQuestionnaire().GetResponseIsAnonymousByQuestionnaireID(ctx.Request().Context(), respondentDetail.QuestionnaireID)
 	if err != nil {
@@ -368,6 +529,122 @@ func respondentDetail2Response(ctx echo.Context, respondentDetail model.Responde
 	return respondentDetail2ResponseWithMetadata(ctx, respondentDetail, respondent, isAnonymous)
 }
 
+// hideResponseBodies 回答を見せない質問への回答を取り除く
+func hideResponseBodies(response openapi.Response, hiddenQuestionIDs map[int]struct{}) openapi.Response {
+	if len(hiddenQuestionIDs) == 0 {
+		return response
+	}
+
+	body := make([]openapi.ResponseBody, 0, len(response.Body))
+	for _, responseBody := range response.Body {
+		if _, ok := hiddenQuestionIDs[responseBody.QuestionId]; ok {
+			continue
+		}
+		body = append(body, responseBody)
+	}
+	response.Body = body
+
+	return response
+}
+
+// respondentDetails2ResponsesSummary 提出済みの回答を質問ごとに集計する
+// 回答を見せない質問は集計結果に含めない
+func respondentDetails2ResponsesSummary(questionnaireID int, questions []model.Questions, options []model.Options, respondentDetails []model.RespondentDetail, hiddenQuestionIDs map[int]struct{}) (openapi.ResponsesSummary, error) {
+	type questionAggregate struct {
+		answerCount  int
+		optionCounts map[string]int
+		sum          float64
+	}
+
+	optionsByQuestionID := make(map[int][]model.Options, len(questions))
+	for _, option := range options {
+		optionsByQuestionID[option.QuestionID] = append(optionsByQuestionID[option.QuestionID], option)
+	}
+
+	aggregates := make(map[int]*questionAggregate, len(questions))
+	for _, question := range questions {
+		if _, ok := hiddenQuestionIDs[question.ID]; ok {
+			continue
+		}
+		aggregates[question.ID] = &questionAggregate{
+			optionCounts: map[string]int{},
+		}
+	}
+
+	for _, respondentDetail := range respondentDetails {
+		for _, responseBody := range respondentDetail.Responses {
+			aggregate, ok := aggregates[responseBody.QuestionID]
+			if !ok {
+				continue
+			}
+
+			switch responseBody.QuestionType {
+			case "MultipleChoice", "Checkbox":
+				if len(responseBody.OptionResponse) == 0 {
+					continue
+				}
+				for _, option := range responseBody.OptionResponse {
+					aggregate.optionCounts[option]++
+				}
+			case "Number", "LinearScale":
+				if !responseBody.Body.Valid {
+					continue
+				}
+				value, err := strconv.ParseFloat(responseBody.Body.String, 64)
+				if err != nil {
+					return openapi.ResponsesSummary{}, fmt.Errorf("failed to parse number response: %w", err)
+				}
+				aggregate.sum += value
+			default:
+				if !responseBody.Body.Valid || responseBody.Body.String == "" {
+					continue
+				}
+			}
+			aggregate.answerCount++
+		}
+	}
+
+	res := openapi.ResponsesSummary{
+		QuestionnaireId: questionnaireID,
+		ResponseCount:   len(respondentDetails),
+		Questions:       []openapi.QuestionSummary{},
+	}
+	for _, question := range questions {
+		aggregate, ok := aggregates[question.ID]
+		if !ok {
+			continue
+		}
+
+		questionSummary := openapi.QuestionSummary{
+			QuestionId:  question.ID,
+			AnswerCount: aggregate.answerCount,
+		}
+		switch question.Type {
+		case "MultipleChoice", "Checkbox":
+			questionOptions := optionsByQuestionID[question.ID]
+			sort.Slice(questionOptions, func(i, j int) bool {
+				return questionOptions[i].OptionNum < questionOptions[j].OptionNum
+			})
+			optionCounts := make([]openapi.OptionCount, 0, len(questionOptions))
+			for _, option := range questionOptions {
+				optionCounts = append(optionCounts, openapi.OptionCount{
+					Option: option.Body,
+					Count:  aggregate.optionCounts[option.Body],
+				})
+			}
+			questionSummary.OptionCounts = &optionCounts
+		case "Number", "LinearScale":
+			if aggregate.answerCount > 0 {
+				average := aggregate.sum / float64(aggregate.answerCount)
+				questionSummary.Average = &average
+			}
+		}
+		res.Questions = append(res.Questions, questionSummary)
+	}
+
+	return res, nil
+}
+
 func responseBody2ResponseMetas(body []openapi.NewResponseBody, questions []model.Questions) ([]*model.ResponseMeta, error) {
 	res := []*model.ResponseMeta{}
 
@@ -487,3 +764,100 @@ func responseBody2ResponseMetas(body []openapi.NewResponseBody, questions []mode
 	}
 	return res, nil
 }
+
+func convertSystemAdmin(systemAdmin model.SystemAdmins) openapi.SystemAdmin {
+	return openapi.SystemAdmin{
+		TraqId:    systemAdmin.UserTraqid,
+		GrantedBy: systemAdmin.GrantedBy,
+		CreatedAt: systemAdmin.CreatedAt,
+	}
+}
+
+func convertAccessToken(accessToken model.AccessTokens) openapi.AccessToken {
+	scopes := []openapi.AccessTokenScope{}
+	for _, scope := range accessToken.GetScopes() {
+		scopes = append(scopes, openapi.AccessTokenScope(scope))
+	}
+
+	return openapi.AccessToken{
+		AccessTokenId: accessToken.ID,
+		Name:          accessToken.Name,
+		Scopes:        scopes,
+		RateLimit:     accessToken.RateLimit,
+		ExpiresAt:     accessToken.ExpiresAt.Ptr(),
+		LastUsedAt:    accessToken.LastUsedAt.Ptr(),
+		CreatedAt:     accessToken.CreatedAt,
+	}
+}
+
+func convertQuestionnaireWebhook(webhook model.QuestionnaireWebhooks) openapi.QuestionnaireWebhook {
+	events := []openapi.WebhookEvent{}
+	for _, event := range webhook.GetEvents() {
+		events = append(events, openapi.WebhookEvent(event))
+	}
+
+	return openapi.QuestionnaireWebhook{
+		WebhookId: webhook.ID,
+		Url:       webhook.URL,
+		Events:    events,
+		CreatedBy: webhook.CreatedBy,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+func convertWebhookDelivery(delivery model.WebhookDeliveries) openapi.WebhookDelivery {
+	var statusCode *int
+	if delivery.StatusCode.Valid {
+		code := int(delivery.StatusCode.Int64)
+		statusCode = &code
+	}
+
+	return openapi.WebhookDelivery{
+		DeliveryId: delivery.ID,
+		Event:      openapi.WebhookEvent(delivery.Event),
+		Payload:    delivery.Payload,
+		Status:     openapi.WebhookDeliveryStatus(delivery.Status),
+		Attempts:   delivery.Attempts,
+		StatusCode: statusCode,
+		Error:      delivery.ErrorMessage,
+		CreatedAt:  delivery.CreatedAt,
+		UpdatedAt:  delivery.UpdatedAt,
+	}
+}
+
+func convertQuickPoll(quickPoll *model.QuickPolls, options []model.Options) (openapi.QuickPoll, error) {
+	channelID, err := uuid.Parse(quickPoll.ChannelID)
+	if err != nil {
+		return openapi.QuickPoll{}, fmt.Errorf("invalid channel id: %w", err)
+	}
+	messageID, err := uuid.Parse(quickPoll.MessageID)
+	if err != nil {
+		return openapi.QuickPoll{}, fmt.Errorf("invalid message id: %w", err)
+	}
+
+	optionBodies := make(map[int]string, len(options))
+	for _, option := range options {
+		optionBodies[option.OptionNum] = option.Body
+	}
+
+	stamps := make([]openapi.QuickPollStamp, 0, len(quickPoll.Stamps))
+	for _, stamp := range quickPoll.Stamps {
+		stampID, err := uuid.Parse(stamp.StampID)
+		if err != nil {
+			return openapi.QuickPoll{}, fmt.Errorf("invalid stamp id: %w", err)
+		}
+		stamps = append(stamps, openapi.QuickPollStamp{
+			StampId: stampID,
+			Option:  optionBodies[stamp.OptionNum],
+		})
+	}
+
+	return openapi.QuickPoll{
+		QuestionnaireId: quickPoll.QuestionnaireID,
+		ChannelId:       channelID,
+		MessageId:       messageID,
+		Stamps:          stamps,
+		CreatedBy:       quickPoll.CreatedBy,
+		CreatedAt:       quickPoll.CreatedAt,
+	}, nil
+}
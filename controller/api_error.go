@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/openapi"
+)
+
+// apiError エラーコード付きのエラー
+// echo.HTTPErrorのMessageに入れると、HTTPErrorHandlerがcodeをそのまま返す
+type apiError struct {
+	code    openapi.ErrorCode
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+// newAPIError エラーコード付きのHTTPエラーを作る
+func newAPIError(status int, code openapi.ErrorCode, message string) *echo.HTTPError {
+	return echo.NewHTTPError(status, &apiError{code: code, message: message})
+}
+
+// statusErrorCodes 個別のエラーコードがないときに使う、ステータスコードに対応するエラーコード
+var statusErrorCodes = map[int]openapi.ErrorCode{
+	http.StatusBadRequest:          openapi.BadRequest,
+	http.StatusUnauthorized:        openapi.Unauthorized,
+	http.StatusForbidden:           openapi.Forbidden,
+	http.StatusNotFound:            openapi.NotFound,
+	http.StatusConflict:            openapi.Conflict,
+	http.StatusUnprocessableEntity: openapi.UnprocessableEntity,
+	http.StatusTooManyRequests:     openapi.TooManyRequests,
+	http.StatusInternalServerError: openapi.InternalServerError,
+	http.StatusServiceUnavailable:  openapi.ServiceUnavailable,
+}
+
+// HTTPErrorHandler エラーをopenapi.Errorの形式で返す
+// handlerがcontrollerのエラーを包んでいる場合は、最も内側のecho.HTTPErrorのステータスコードとエラーコードを使う
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	httpError := innermostHTTPError(err)
+	body := newErrorBody(httpError)
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(httpError.Code)
+	} else {
+		err = c.JSON(httpError.Code, body)
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to send error response: %+v", err)
+	}
+}
+
+// innermostHTTPError エラーに含まれる最も内側のecho.HTTPErrorを返す
+// echo.HTTPErrorを含まないエラーは500として扱う
+func innermostHTTPError(err error) *echo.HTTPError {
+	var httpError *echo.HTTPError
+	if !errors.As(err, &httpError) {
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}
+	for {
+		messageErr, ok := httpError.Message.(error)
+		if !ok {
+			return httpError
+		}
+		var innerHTTPError *echo.HTTPError
+		if !errors.As(messageErr, &innerHTTPError) {
+			return httpError
+		}
+		httpError = innerHTTPError
+	}
+}
+
+func newErrorBody(httpError *echo.HTTPError) openapi.Error {
+	code, ok := statusErrorCodes[httpError.Code]
+	if !ok {
+		if httpError.Code >= http.StatusInternalServerError {
+			code = openapi.InternalServerError
+		} else {
+			code = openapi.BadRequest
+		}
+	}
+
+	var message string
+	switch m := httpError.Message.(type) {
+	case string:
+		message = m
+	case error:
+		var apiErr *apiError
+		if errors.As(m, &apiErr) {
+			code = apiErr.code
+		}
+		message = m.Error()
+	default:
+		message = http.StatusText(httpError.Code)
+	}
+	// 内部のエラーの詳細はログにのみ残し、レスポンスには含めない
+	if httpError.Code >= http.StatusInternalServerError {
+		message = http.StatusText(httpError.Code)
+	}
+
+	return openapi.Error{
+		Code:    code,
+		Message: message,
+	}
+}
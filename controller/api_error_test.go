@@ -0,0 +1,110 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/openapi"
+)
+
+func TestHTTPErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type expect struct {
+		code int
+		body openapi.Error
+	}
+	type test struct {
+		description string
+		err         error
+		expect
+	}
+
+	testCases := []test{
+		{
+			description: "エラーコード付きのエラーはそのコードを返す",
+			err:         newAPIError(http.StatusNotFound, openapi.QuestionnaireNotFound, "questionnaire not found"),
+			expect: expect{
+				code: http.StatusNotFound,
+				body: openapi.Error{Code: openapi.QuestionnaireNotFound, Message: "questionnaire not found"},
+			},
+		},
+		{
+			description: "文字列のメッセージはステータスコードに対応するコードを返す",
+			err:         echo.NewHTTPError(http.StatusForbidden, "only owners can change administrators"),
+			expect: expect{
+				code: http.StatusForbidden,
+				body: openapi.Error{Code: openapi.Forbidden, Message: "only owners can change administrators"},
+			},
+		},
+		{
+			description: "エラーのメッセージはエラーの文字列を返す",
+			err:         echo.NewHTTPError(http.StatusBadRequest, errors.New("invalid cursor")),
+			expect: expect{
+				code: http.StatusBadRequest,
+				body: openapi.Error{Code: openapi.BadRequest, Message: "invalid cursor"},
+			},
+		},
+		{
+			description: "handlerが包んだcontrollerのエラーは内側のステータスコードとコードを返す",
+			err: echo.NewHTTPError(
+				http.StatusInternalServerError,
+				fmt.Errorf("failed to post response: %w", newAPIError(http.StatusUnprocessableEntity, openapi.QuestionnaireExpired, "expired questionnaire")),
+			),
+			expect: expect{
+				code: http.StatusUnprocessableEntity,
+				body: openapi.Error{Code: openapi.QuestionnaireExpired, Message: "expired questionnaire"},
+			},
+		},
+		{
+			description: "サーバーのエラーは詳細を返さない",
+			err:         echo.NewHTTPError(http.StatusInternalServerError, errors.New("failed to connect to database")),
+			expect: expect{
+				code: http.StatusInternalServerError,
+				body: openapi.Error{Code: openapi.InternalServerError, Message: http.StatusText(http.StatusInternalServerError)},
+			},
+		},
+		{
+			description: "echo.HTTPErrorでないエラーはサーバーのエラーにする",
+			err:         errors.New("unexpected error"),
+			expect: expect{
+				code: http.StatusInternalServerError,
+				body: openapi.Error{Code: openapi.InternalServerError, Message: http.StatusText(http.StatusInternalServerError)},
+			},
+		},
+		{
+			description: "対応するコードがないステータスコードはbad_requestにする",
+			err:         echo.NewHTTPError(http.StatusMethodNotAllowed),
+			expect: expect{
+				code: http.StatusMethodNotAllowed,
+				body: openapi.Error{Code: openapi.BadRequest, Message: http.StatusText(http.StatusMethodNotAllowed)},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		HTTPErrorHandler(testCase.err, c)
+
+		assertion.Equal(testCase.expect.code, rec.Code, testCase.description, "status code")
+
+		var body openapi.Error
+		err := json.NewDecoder(rec.Body).Decode(&body)
+		if !assertion.NoError(err, testCase.description, "decode body") {
+			continue
+		}
+		assertion.Equal(testCase.expect.body, body, testCase.description, "body")
+	}
+}
@@ -0,0 +1,318 @@
+package controller
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/i18n"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/traq"
+)
+
+// Bot traQのBOTのイベントを処理する構造体
+type Bot struct {
+	*Questionnaire
+	traq.IBot
+	QuickPoll         *QuickPoll
+	verificationToken string
+	userID            string
+}
+
+// NewBot Botのコンストラクター
+func NewBot(questionnaire *Questionnaire, quickPoll *QuickPoll, bot traq.IBot) *Bot {
+	return &Bot{
+		Questionnaire:     questionnaire,
+		IBot:              bot,
+		QuickPoll:         quickPoll,
+		verificationToken: botVerificationToken,
+		userID:            botUserID,
+	}
+}
+
+// botLanguage アンケートに結びつかないBOTの返信の言語
+const botLanguage = i18n.DefaultLanguage
+
+// IsBotEnabled BOTのイベントを受け付けるか
+func (b *Bot) IsBotEnabled() bool {
+	return b.verificationToken != ""
+}
+
+// VerifyBotToken traQのBOTのイベントのVerification Tokenの確認
+// イベントの本文を読む前に呼ぶ
+func (b *Bot) VerifyBotToken(c echo.Context, token string) error {
+	if !b.IsBotEnabled() {
+		return echo.NewHTTPError(http.StatusNotFound, "bot is not enabled")
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(b.verificationToken)) != 1 {
+		c.Logger().Info("invalid bot verification token")
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid bot verification token")
+	}
+
+	return nil
+}
+
+// HandleBotEvent traQのBOTのイベントの処理
+// Verification TokenはVerifyBotTokenで確認しておく
+func (b *Bot) HandleBotEvent(c echo.Context, eventType traq.BotEventType, payload []byte) error {
+	switch eventType {
+	case traq.BotEventMessageCreated, traq.BotEventDirectMessageCreated:
+		var event traq.BotMessageCreatedEvent
+		err := json.Unmarshal(payload, &event)
+		if err != nil {
+			c.Logger().Infof("failed to parse bot event: %+v", err)
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to parse bot event: %w", err))
+		}
+
+		return b.handleBotMessage(c, event.Message, eventType == traq.BotEventDirectMessageCreated)
+	case traq.BotEventMessageStampsUpdated:
+		var event traq.BotMessageStampsUpdatedEvent
+		err := json.Unmarshal(payload, &event)
+		if err != nil {
+			c.Logger().Infof("failed to parse bot event: %+v", err)
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to parse bot event: %w", err))
+		}
+
+		return b.QuickPoll.RecordQuickPollStamps(c, event)
+	default:
+		// PINGなど応答が不要なイベントは何もしない
+		return nil
+	}
+}
+
+func (b *Bot) handleBotMessage(c echo.Context, message traq.BotMessage, isDirectMessage bool) error {
+	// BOT同士で応答し合わないよう、BOTのメッセージは無視する
+	if message.User.Bot {
+		return nil
+	}
+
+	mention, isMentioned := b.findBotMention(message.Embedded)
+	// チャンネルではほかのユーザー宛てのメッセージに応答しないよう、BOTへのメンションを含むメッセージにだけ応答する
+	if !isDirectMessage && !isMentioned {
+		return nil
+	}
+
+	reply := b.execBotCommand(c, message.User.Name, parseBotCommand(message.PlainText, mention), isDirectMessage)
+
+	_, err := b.PostChannelMessage(c.Request().Context(), message.ChannelID, reply)
+	if err != nil {
+		c.Logger().Errorf("failed to post bot message: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to post bot message: %w", err))
+	}
+
+	return nil
+}
+
+// findBotMention メッセージに埋め込まれたBOTへのメンションを探す
+func (b *Bot) findBotMention(embedded []traq.BotEmbedded) (string, bool) {
+	if b.userID == "" {
+		return "", false
+	}
+	for _, e := range embedded {
+		if e.Type == "user" && e.ID == b.userID {
+			return e.Raw, true
+		}
+	}
+
+	return "", false
+}
+
+// parseBotCommand メッセージからBOTへのメンションを除いたコマンドを取り出す
+// mentionが空の場合はメッセージをそのままコマンドにする
+func parseBotCommand(plainText string, mention string) []string {
+	if mention != "" {
+		plainText = strings.Replace(plainText, mention, "", 1)
+	}
+
+	return strings.Fields(plainText)
+}
+
+func (b *Bot) execBotCommand(c echo.Context, userID string, args []string, isDirectMessage bool) string {
+	botHelpMessage := i18n.Message(botLanguage, i18n.KeyBotHelp)
+	if len(args) == 0 {
+		return botHelpMessage
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "list":
+		return b.botListCommand(c, userID)
+	case "remind":
+		if len(args) != 3 {
+			return botHelpMessage
+		}
+
+		var isRemindEnabled bool
+		switch strings.ToLower(args[1]) {
+		case "on":
+			isRemindEnabled = true
+		case "off":
+			isRemindEnabled = false
+		default:
+			return botHelpMessage
+		}
+
+		questionnaireID, err := strconv.Atoi(args[2])
+		if err != nil {
+			return i18n.Message(botLanguage, i18n.KeyBotInvalidID, args[2])
+		}
+
+		return b.botRemindCommand(c, userID, questionnaireID, isRemindEnabled)
+	case "status":
+		if len(args) != 2 {
+			return botHelpMessage
+		}
+		// 回答状況には未回答の対象者が含まれるため、ほかのユーザーが見られるチャンネルには投稿しない
+		if !isDirectMessage {
+			return i18n.Message(botLanguage, i18n.KeyBotStatusDMOnly)
+		}
+
+		questionnaireID, err := strconv.Atoi(args[1])
+		if err != nil {
+			return i18n.Message(botLanguage, i18n.KeyBotInvalidID, args[1])
+		}
+
+		return b.botStatusCommand(c, userID, questionnaireID)
+	default:
+		return botHelpMessage
+	}
+}
+
+func (b *Bot) botListCommand(c echo.Context, userID string) string {
+	questionnaires, err := b.GetTargettedQuestionnaires(c.Request().Context(), userID, "unanswered", "")
+	if err != nil {
+		c.Logger().Errorf("failed to get targetted questionnaires: %+v", err)
+		return i18n.Message(botLanguage, i18n.KeyBotListFailed)
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString(i18n.Message(botLanguage, i18n.KeyBotListHeader))
+	count := 0
+	for _, questionnaire := range questionnaires {
+		if !questionnaire.IsPublished || questionnaire.DeletedAt.Valid {
+			continue
+		}
+
+		var resTimeLimitText string
+		if questionnaire.ResTimeLimit.Valid {
+			resTimeLimitText = questionnaire.ResTimeLimit.Time.In(jst).Format("2006/01/02 15:04")
+		} else {
+			resTimeLimitText = i18n.Message(botLanguage, i18n.KeyNone)
+		}
+
+		line := "\n" + i18n.Message(
+			botLanguage,
+			i18n.KeyBotListItem,
+			questionnaire.Title,
+			responseFormURL(questionnaire.ID),
+			resTimeLimitText,
+		)
+		if len([]rune(sb.String()))+len([]rune(line)) > traq.MessageLimit {
+			break
+		}
+		sb.WriteString(line)
+		count++
+	}
+
+	if count == 0 {
+		return i18n.Message(botLanguage, i18n.KeyBotListEmpty)
+	}
+
+	return sb.String()
+}
+
+func (b *Bot) botRemindCommand(c echo.Context, userID string, questionnaireID int, isRemindEnabled bool) string {
+	err := b.EditQuestionnaireMyRemindStatus(c, questionnaireID, userID, isRemindEnabled)
+	if err != nil {
+		var httpError *echo.HTTPError
+		if errors.As(err, &httpError) && httpError.Code == http.StatusNotFound {
+			return i18n.Message(botLanguage, i18n.KeyBotNotFound, questionnaireID)
+		}
+		return i18n.Message(botLanguage, i18n.KeyBotRemindFailed)
+	}
+
+	if isRemindEnabled {
+		return i18n.Message(botLanguage, i18n.KeyBotRemindOn, questionnaireID)
+	}
+	return i18n.Message(botLanguage, i18n.KeyBotRemindOff, questionnaireID)
+}
+
+func (b *Bot) botStatusCommand(c echo.Context, userID string, questionnaireID int) string {
+	isAdmin, err := b.checkAdministratorRole(c, userID, questionnaireID, model.AdministratorRoleViewer)
+	if err != nil {
+		c.Logger().Errorf("failed to check administrator role: %+v", err)
+		return i18n.Message(botLanguage, i18n.KeyBotStatusFailed)
+	}
+	if !isAdmin {
+		// 管理者でないユーザーにはアンケートの存在も知らせない
+		return i18n.Message(botLanguage, i18n.KeyBotStatusForbidden, questionnaireID)
+	}
+
+	questionnaire, targets, _, _, _, _, _, respondents, err := b.GetQuestionnaireInfo(c.Request().Context(), questionnaireID)
+	if err != nil {
+		if errors.Is(err, model.ErrRecordNotFound) {
+			return i18n.Message(botLanguage, i18n.KeyBotStatusForbidden, questionnaireID)
+		}
+		c.Logger().Errorf("failed to get questionnaire info: %+v", err)
+		return i18n.Message(botLanguage, i18n.KeyBotStatusFailed)
+	}
+
+	return createBotStatusMessage(questionnaire, targets, respondents)
+}
+
+// createBotStatusMessage アンケートの回答状況のメッセージを、アンケートの言語で作る
+func createBotStatusMessage(questionnaire *model.Questionnaires, targets []string, respondents []string) string {
+	language := i18n.ParseLanguage(questionnaire.Language)
+
+	respondentSet := make(map[string]struct{}, len(respondents))
+	for _, respondent := range respondents {
+		respondentSet[respondent] = struct{}{}
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString(i18n.Message(language, i18n.KeyBotStatusHeader, questionnaire.Title, questionnaireURL(questionnaire.ID)))
+
+	isTraPTarget := false
+	targetSet := make(map[string]struct{}, len(targets))
+	for _, target := range targets {
+		if target == "traP" {
+			isTraPTarget = true
+			continue
+		}
+		targetSet[target] = struct{}{}
+	}
+
+	if isTraPTarget || len(targetSet) == 0 {
+		sb.WriteString("\n" + i18n.Message(language, i18n.KeyBotStatusCounts, len(respondentSet), len(respondents)))
+		return sb.String()
+	}
+
+	unanswered := make([]string, 0, len(targetSet))
+	for target := range targetSet {
+		if _, ok := respondentSet[target]; !ok {
+			unanswered = append(unanswered, target)
+		}
+	}
+	sort.Strings(unanswered)
+
+	answeredCount := len(targetSet) - len(unanswered)
+	sb.WriteString("\n" + i18n.Message(language, i18n.KeyBotStatusTargets, answeredCount, len(targetSet), answeredCount*100/len(targetSet)))
+	sb.WriteString("\n" + i18n.Message(language, i18n.KeyBotStatusCounts, len(respondentSet), len(respondents)))
+	if len(unanswered) > 0 {
+		// 状況の確認でメンションが飛ばないよう、@を付けずに表示する
+		sb.WriteString("\n" + i18n.Message(language, i18n.KeyBotStatusUnanswered) + "\n")
+		sb.WriteString(strings.Join(unanswered, ", "))
+	}
+
+	message := sb.String()
+	if len([]rune(message)) > traq.MessageLimit {
+		message = string([]rune(message)[:traq.MessageLimit])
+	}
+
+	return message
+}
@@ -0,0 +1,427 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/i18n"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/model/mock_model"
+	"github.com/traPtitech/anke-to/traq"
+	gotraq "github.com/traPtitech/go-traq"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/guregu/null.v4"
+)
+
+type recordingBotMessage struct {
+	channelID string
+	content   string
+}
+
+type recordingBot struct {
+	messages []recordingBotMessage
+	// 押したスタンプのメッセージIDとスタンプID
+	stamps [][2]string
+	// traQのUUIDからtraQ IDへの対応
+	users map[string]string
+	// DMはchannelIDの代わりに宛先のtraQ IDを記録する
+	directMessages []recordingBotMessage
+}
+
+func (b *recordingBot) PostChannelMessage(_ context.Context, channelID string, content string) (string, error) {
+	b.messages = append(b.messages, recordingBotMessage{
+		channelID: channelID,
+		content:   content,
+	})
+	return "2d7ff3f5-c313-4f4a-a9bb-0b5f84d2b6f8", nil
+}
+
+func (b *recordingBot) PostDirectMessage(_ context.Context, userTraqID string, content string) error {
+	b.directMessages = append(b.directMessages, recordingBotMessage{
+		channelID: userTraqID,
+		content:   content,
+	})
+	return nil
+}
+
+func (b *recordingBot) AddMessageStamp(_ context.Context, messageID string, stampID string) error {
+	b.stamps = append(b.stamps, [2]string{messageID, stampID})
+	return nil
+}
+
+func (*recordingBot) GetStamps(_ context.Context) ([]gotraq.StampWithThumbnail, error) {
+	return []gotraq.StampWithThumbnail{
+		{Id: "0b9a1a9c-4d7e-4c1b-a8b3-6f5e1c2d3a4b", Name: "thumbs_up"},
+		{Id: "5c6d7e8f-9a0b-4c1d-8e2f-3a4b5c6d7e8f", Name: "thumbs_down"},
+	}, nil
+}
+
+func (b *recordingBot) GetUserTraqID(_ context.Context, userUUID string) (string, error) {
+	userID, ok := b.users[userUUID]
+	if !ok {
+		return "", errors.New("user not found")
+	}
+	return userID, nil
+}
+
+func readBotEvent(t *testing.T, name string) []byte {
+	t.Helper()
+
+	payload, err := os.ReadFile(filepath.Join("testdata", "bot_events", name))
+	if err != nil {
+		t.Fatalf("failed to read bot event: %v", err)
+	}
+
+	return payload
+}
+
+func TestHandleBotEvent(t *testing.T) {
+	t.Parallel()
+
+	resTimeLimit := time.Date(2024, 5, 20, 3, 0, 0, 0, time.UTC)
+
+	type args struct {
+		eventType traq.BotEventType
+		payload   string
+	}
+	type expect struct {
+		isErr    bool
+		code     int
+		messages []recordingBotMessage
+	}
+	type test struct {
+		description string
+		args
+		setup func(mockQuestionnaire *mock_model.MockIQuestionnaire, mockAdministrator *mock_model.MockIAdministrator, mockSystemAdmin *mock_model.MockISystemAdmin)
+		expect
+	}
+
+	testCases := []test{
+		{
+			description: "PINGには何もしない",
+			args: args{
+				eventType: traq.BotEventPing,
+				payload:   "ping.json",
+			},
+			expect: expect{
+				messages: []recordingBotMessage{},
+			},
+		},
+		{
+			description: "ペイロードが不正なのでエラー",
+			args: args{
+				eventType: traq.BotEventMessageCreated,
+				payload:   "",
+			},
+			expect: expect{
+				isErr:    true,
+				code:     http.StatusBadRequest,
+				messages: []recordingBotMessage{},
+			},
+		},
+		{
+			description: "listで未回答のアンケートを返す",
+			args: args{
+				eventType: traq.BotEventMessageCreated,
+				payload:   "message_created_list.json",
+			},
+			setup: func(mockQuestionnaire *mock_model.MockIQuestionnaire, _ *mock_model.MockIAdministrator, _ *mock_model.MockISystemAdmin) {
+				mockQuestionnaire.
+					EXPECT().
+					GetTargettedQuestionnaires(gomock.Any(), "mazrean", "unanswered", "").
+					Return([]model.TargettedQuestionnaire{
+						{
+							Questionnaires: model.Questionnaires{
+								ID:           1,
+								Title:        "第1回集会",
+								ResTimeLimit: null.TimeFrom(resTimeLimit),
+								IsPublished:  true,
+							},
+						},
+						{
+							Questionnaires: model.Questionnaires{
+								ID:          2,
+								Title:       "非公開",
+								IsPublished: false,
+							},
+						},
+						{
+							Questionnaires: model.Questionnaires{
+								ID:          3,
+								Title:       "期限なし",
+								IsPublished: true,
+							},
+						},
+					}, nil)
+			},
+			expect: expect{
+				messages: []recordingBotMessage{
+					{
+						channelID: "9aba50da-f605-4cd0-a428-5e4558cb911e",
+						content: "### 未回答のアンケート\n" +
+							"- [第1回集会](https://anke-to.trap.jp/responses/new/1) (回答期限: 2024/05/20 12:00)\n" +
+							"- [期限なし](https://anke-to.trap.jp/responses/new/3) (回答期限: なし)",
+					},
+				},
+			},
+		},
+		{
+			description: "BOTへのメンションがないメッセージには応答しない",
+			args: args{
+				eventType: traq.BotEventMessageCreated,
+				payload:   "message_created_without_mention.json",
+			},
+			expect: expect{
+				messages: []recordingBotMessage{},
+			},
+		},
+		{
+			description: "BOTのメッセージには応答しない",
+			args: args{
+				eventType: traq.BotEventMessageCreated,
+				payload:   "message_created_by_bot.json",
+			},
+			expect: expect{
+				messages: []recordingBotMessage{},
+			},
+		},
+		{
+			description: "statusで回答状況を返す",
+			args: args{
+				eventType: traq.BotEventDirectMessageCreated,
+				payload:   "direct_message_created_status.json",
+			},
+			setup: func(mockQuestionnaire *mock_model.MockIQuestionnaire, mockAdministrator *mock_model.MockIAdministrator, _ *mock_model.MockISystemAdmin) {
+				mockAdministrator.
+					EXPECT().
+					GetAdministratorRole(gomock.Any(), "mazrean", 1).
+					Return(model.AdministratorRoleViewer, nil)
+				mockQuestionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, Title: "第1回集会"}, []string{"mazrean", "ryoha", "xxarupakaxx", "kaitoyama"}, nil, nil, nil, nil, nil, []string{"mazrean", "ryoha", "ryoha", "cp20"}, nil)
+			},
+			expect: expect{
+				messages: []recordingBotMessage{
+					{
+						channelID: "c5a5a697-3bad-4540-b2da-93dc88181d34",
+						content: "### アンケート『[第1回集会](https://anke-to.trap.jp/questionnaires/1)』の回答状況\n" +
+							"回答済みの対象者: 2/4人 (50%)\n回答者: 3人\n回答数: 4\n" +
+							"#### 未回答の対象者\nkaitoyama, xxarupakaxx",
+					},
+				},
+			},
+		},
+		{
+			description: "DM以外ではstatusは使えない",
+			args: args{
+				eventType: traq.BotEventMessageCreated,
+				payload:   "message_created_status.json",
+			},
+			expect: expect{
+				messages: []recordingBotMessage{
+					{
+						channelID: "9aba50da-f605-4cd0-a428-5e4558cb911e",
+						content:   i18n.Message(i18n.LanguageJa, i18n.KeyBotStatusDMOnly),
+					},
+				},
+			},
+		},
+		{
+			description: "管理者でないとstatusは使えない",
+			args: args{
+				eventType: traq.BotEventDirectMessageCreated,
+				payload:   "direct_message_created_status.json",
+			},
+			setup: func(_ *mock_model.MockIQuestionnaire, mockAdministrator *mock_model.MockIAdministrator, mockSystemAdmin *mock_model.MockISystemAdmin) {
+				mockAdministrator.
+					EXPECT().
+					GetAdministratorRole(gomock.Any(), "mazrean", 1).
+					Return(model.AdministratorRole(""), model.ErrRecordNotFound)
+				mockSystemAdmin.
+					EXPECT().
+					CheckSystemAdmin(gomock.Any(), "mazrean").
+					Return(false, nil)
+			},
+			expect: expect{
+				messages: []recordingBotMessage{
+					{
+						channelID: "c5a5a697-3bad-4540-b2da-93dc88181d34",
+						content:   "アンケート 1 は見つからないか、回答状況を見る権限がありません",
+					},
+				},
+			},
+		},
+		{
+			description: "存在しないアンケートのリマインドは設定できない",
+			args: args{
+				eventType: traq.BotEventDirectMessageCreated,
+				payload:   "direct_message_created_remind_off.json",
+			},
+			setup: func(mockQuestionnaire *mock_model.MockIQuestionnaire, _ *mock_model.MockIAdministrator, _ *mock_model.MockISystemAdmin) {
+				mockQuestionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(nil, nil, nil, nil, nil, nil, nil, nil, model.ErrRecordNotFound)
+			},
+			expect: expect{
+				messages: []recordingBotMessage{
+					{
+						channelID: "c5a5a697-3bad-4540-b2da-93dc88181d34",
+						content:   "アンケート 1 は見つかりませんでした",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+
+		mockQuestionnaire := mock_model.NewMockIQuestionnaire(ctrl)
+		mockAdministrator := mock_model.NewMockIAdministrator(ctrl)
+		mockSystemAdmin := mock_model.NewMockISystemAdmin(ctrl)
+		if testCase.setup != nil {
+			testCase.setup(mockQuestionnaire, mockAdministrator, mockSystemAdmin)
+		}
+
+		questionnaire := NewQuestionnaire(mockQuestionnaire, nil, nil, nil, mockAdministrator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockSystemAdmin, nil, nil, nil, nil)
+		bot := &recordingBot{messages: []recordingBotMessage{}}
+		b := NewBot(questionnaire, nil, bot)
+		b.verificationToken = "token"
+		b.userID = "7c3d6b5e-0a8e-4f1e-9d3c-4c5e6f7a8b9c"
+
+		var payload []byte
+		if testCase.args.payload != "" {
+			payload = readBotEvent(t, testCase.args.payload)
+		}
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/bot/events", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := b.HandleBotEvent(c, testCase.args.eventType, payload)
+		if testCase.expect.isErr {
+			var httpError *echo.HTTPError
+			if assert.True(t, errors.As(err, &httpError), testCase.description, "error type") {
+				assert.Equal(t, testCase.expect.code, httpError.Code, testCase.description, "status code")
+			}
+		} else {
+			assert.NoError(t, err, testCase.description, "no error")
+		}
+		assert.Equal(t, testCase.expect.messages, bot.messages, testCase.description, "messages")
+
+		ctrl.Finish()
+	}
+}
+
+func TestVerifyBotToken(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type args struct {
+		verificationToken string
+		token             string
+	}
+	type expect struct {
+		isErr bool
+		code  int
+	}
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	testCases := []test{
+		{
+			description: "トークンが一致するので問題なし",
+			args: args{
+				verificationToken: "token",
+				token:             "token",
+			},
+		},
+		{
+			description: "トークンが違うのでエラー",
+			args: args{
+				verificationToken: "token",
+				token:             "invalid",
+			},
+			expect: expect{
+				isErr: true,
+				code:  http.StatusUnauthorized,
+			},
+		},
+		{
+			description: "BOTが有効でないのでエラー",
+			args: args{
+				verificationToken: "",
+				token:             "",
+			},
+			expect: expect{
+				isErr: true,
+				code:  http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		b := NewBot(nil, nil, &recordingBot{})
+		b.verificationToken = testCase.args.verificationToken
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/bot/events", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := b.VerifyBotToken(c, testCase.args.token)
+		if !testCase.expect.isErr {
+			assertion.NoError(err, testCase.description, "no error")
+			continue
+		}
+		var httpError *echo.HTTPError
+		if assertion.True(errors.As(err, &httpError), testCase.description, "error type") {
+			assertion.Equal(testCase.expect.code, httpError.Code, testCase.description, "status code")
+		}
+	}
+}
+
+func TestParseBotCommand(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	assertion.Equal([]string{"list"}, parseBotCommand("@BOT_anke-to list", "@BOT_anke-to"))
+	assertion.Equal([]string{"remind", "off", "123"}, parseBotCommand("@BOT_anke-to  remind off 123\n", "@BOT_anke-to"))
+	assertion.Equal([]string{"status", "1"}, parseBotCommand("status 1", ""))
+	assertion.Equal([]string{}, parseBotCommand("@BOT_anke-to", "@BOT_anke-to"))
+	assertion.Equal([]string{"@mazrean", "list"}, parseBotCommand("@mazrean @BOT_anke-to list", "@BOT_anke-to"))
+}
+
+func TestCreateBotStatusMessage(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	message := createBotStatusMessage(
+		&model.Questionnaires{ID: 1, Title: "meeting", Language: string(i18n.LanguageEn)},
+		[]string{"mazrean", "kaitoyama"},
+		[]string{"mazrean", "cp20"},
+	)
+	assertion.Equal(
+		"### Response status of questionnaire \"[meeting](https://anke-to.trap.jp/questionnaires/1)\"\n"+
+			"Targets answered: 1/2 (50%)\nRespondents: 2\nResponses: 2\n"+
+			"#### Targets who have not answered\nkaitoyama",
+		message,
+	)
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/traPtitech/anke-to/config"
+)
+
+// publicBaseURL traQに投稿するメッセージのリンクに使う、anke-toのURL
+var publicBaseURL string
+
+// reminderTimingMinutes リマインダーを送る、回答期限までの残り時間(分)。大きい順に並べる
+var reminderTimingMinutes = slices.Clone(config.DefaultReminderTimingMinutes)
+
+// questionnairesRateLimit ユーザーごとのアンケート一覧の取得の制限(回/秒)
+var questionnairesRateLimit float64 = config.DefaultQuestionnairesRateLimit
+
+// botVerificationToken traQのBOTのVerification Token。空の場合はBOTのイベントを受け付けない
+var botVerificationToken string
+
+// botUserID BOTのtraQのユーザーのUUID
+var botUserID string
+
+// Configure 設定を反映する
+// APIサーバーやリマインダーを作る前に呼ぶ
+func Configure(cfg *config.Config) {
+	publicBaseURL = cfg.PublicBaseURL
+	reminderTimingMinutes = slices.Clone(cfg.Reminder.TimingMinutes)
+	questionnairesRateLimit = cfg.RateLimit.Questionnaires
+	botVerificationToken = cfg.TraQ.BotVerificationToken
+	botUserID = cfg.TraQ.BotUserID
+}
+
+// questionnaireURL アンケートのページのURL
+func questionnaireURL(questionnaireID int) string {
+	return fmt.Sprintf("%s/questionnaires/%d", publicBaseURL, questionnaireID)
+}
+
+// responseFormURL アンケートの回答ページのURL
+func responseFormURL(questionnaireID int) string {
+	return fmt.Sprintf("%s/responses/new/%d", publicBaseURL, questionnaireID)
+}
+
+// responseURL 回答のページのURL
+func responseURL(responseID int) string {
+	return fmt.Sprintf("%s/responses/%d", publicBaseURL, responseID)
+}
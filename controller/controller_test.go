@@ -4,7 +4,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/traPtitech/anke-to/config"
 	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/pubsub"
 	"github.com/traPtitech/anke-to/traq"
 )
 
@@ -24,6 +26,10 @@ var (
 	IAdministratorUser  *model.AdministratorUser
 	IOption             *model.Option
 	ITransaction        *model.Transaction
+	ISearchIndex        *model.SearchIndex
+	ITag                *model.Tag
+	ISystemAdmin        *model.SystemAdmin
+	IResponseViewer     *model.ResponseViewer
 	IWebhook            *traq.Webhook
 
 	re *Reminder
@@ -47,13 +53,30 @@ func TestMain(m *testing.M) {
 	IAdministrator = model.NewAdministrator()
 	IAdministratorGroup = model.NewAdministratorGroup()
 	IAdministratorUser = model.NewAdministratorUser()
+	ISearchIndex = model.NewSearchIndex()
+	ITag = model.NewTag()
+	ISystemAdmin = model.NewSystemAdmin()
+	IResponseViewer = model.NewResponseViewer()
 	IWebhook = traq.NewWebhook()
 
+	// メッセージのリンクを検証するため、公開URLを固定する
+	publicBaseURL = "https://anke-to.trap.jp"
+
 	re = NewReminder()
-	r = NewResponse(IQuestionnaire, IRespondent, IResponse, ITarget, IQuestion, IOption, IValidation, IScaleLabel, ITransaction)
-	q = NewQuestionnaire(IQuestionnaire, ITarget, ITargetGroup, ITargetUser, IAdministrator, IAdministratorGroup, IAdministratorUser, IQuestion, IOption, IScaleLabel, IValidation, ITransaction, IRespondent, IWebhook, r, re)
+	r = NewResponse(IQuestionnaire, IRespondent, IResponse, ITarget, IQuestion, IOption, IValidation, IScaleLabel, ITransaction, NewOutgoingWebhook(model.NewQuestionnaireWebhook()), NewResponseStream(pubsub.NewMemory(), IQuestionnaire, IRespondent))
+	q = NewQuestionnaire(IQuestionnaire, ITarget, ITargetGroup, ITargetUser, IAdministrator, IAdministratorGroup, IAdministratorUser, IQuestion, IOption, IScaleLabel, IValidation, ITransaction, IRespondent, ISearchIndex, ITag, ISystemAdmin, IResponseViewer, IWebhook, r, re)
+
+	dir, err := os.MkdirTemp("", "anke-to-controller-test")
+	if err != nil {
+		panic(err)
+	}
 
-	err := model.EstablishConnection("test")
+	cfg, err := config.LoadTest(dir)
+	if err != nil {
+		panic(err)
+	}
+
+	err = model.EstablishConnection(cfg)
 	if err != nil {
 		panic(err)
 	}
@@ -67,5 +90,7 @@ func TestMain(m *testing.M) {
 	setupSampleResponse()
 
 	code := m.Run()
+
+	os.RemoveAll(dir)
 	os.Exit(code)
 }
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/traq"
+)
+
+// traqPinger traQのAPIに到達できるか確認するinterface
+type traqPinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Health ヘルスチェックの構造体
+type Health struct {
+	reminder *Reminder
+	traq     traqPinger
+	timeout  time.Duration
+}
+
+func NewHealth(reminder *Reminder, traqClient *traq.APIClient) *Health {
+	return &Health{
+		reminder: reminder,
+		traq:     traqClient,
+		timeout:  readinessCheckTimeout,
+	}
+}
+
+// readinessCheckTimeout リクエストを受け付けられるかの確認を打ち切るまでの時間
+const readinessCheckTimeout = 3 * time.Second
+
+// ヘルスチェックの結果
+const (
+	HealthStatusOK          = "ok"
+	HealthStatusUnavailable = "unavailable"
+)
+
+// ヘルスチェックの項目
+const (
+	HealthCheckDatabase   = "database"
+	HealthCheckMigrations = "migrations"
+	HealthCheckReminder   = "reminder"
+	HealthCheckTraQ       = "traq"
+)
+
+// HealthCheck 1つの項目のヘルスチェックの結果
+type HealthCheck struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// HealthStatus ヘルスチェックの結果
+type HealthStatus struct {
+	Status string                 `json:"status"`
+	Checks map[string]HealthCheck `json:"checks,omitempty"`
+}
+
+// IsOK すべての項目が正常か
+func (s HealthStatus) IsOK() bool {
+	return s.Status == HealthStatusOK
+}
+
+// Liveness プロセスが動いているかを返す
+// 外部のサービスには依存せず、リクエストに応答できれば正常とする
+func (h *Health) Liveness() HealthStatus {
+	return HealthStatus{Status: HealthStatusOK}
+}
+
+// Readiness リクエストを受け付けられるかを返す
+// DBへの接続、Migrationの適用、リマインダーの実行、traQへの到達を並行して確認する
+func (h *Health) Readiness(ctx context.Context) HealthStatus {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	checkers := map[string]func(ctx context.Context) error{
+		HealthCheckDatabase:   model.Ping,
+		HealthCheckMigrations: model.CheckMigrations,
+		HealthCheckReminder: func(context.Context) error {
+			if !h.reminder.IsRunning() {
+				return errors.New("reminder worker is not running")
+			}
+			return nil
+		},
+		HealthCheckTraQ: h.traq.Ping,
+	}
+
+	status := HealthStatus{
+		Status: HealthStatusOK,
+		Checks: make(map[string]HealthCheck, len(checkers)),
+	}
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for name, checker := range checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			check := HealthCheck{Status: HealthStatusOK}
+			err := checker(ctx)
+			if err != nil {
+				check = HealthCheck{
+					Status: HealthStatusUnavailable,
+					Error:  err.Error(),
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			status.Checks[name] = check
+			if err != nil {
+				status.Status = HealthStatusUnavailable
+			}
+		}()
+	}
+	wg.Wait()
+
+	return status
+}
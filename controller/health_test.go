@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTraqPinger func(ctx context.Context) error
+
+func (f fakeTraqPinger) Ping(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestLiveness(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	h := NewHealth(NewReminder(), nil)
+
+	status := h.Liveness()
+	assertion.True(status.IsOK())
+	assertion.Empty(status.Checks)
+}
+
+func TestReadiness(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	runningReminder := NewReminder()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go runningReminder.ReminderWorker(ctx)
+	if !assert.Eventually(t, runningReminder.IsRunning, time.Second, 10*time.Millisecond) {
+		return
+	}
+
+	type test struct {
+		description string
+		reminder    *Reminder
+		ping        fakeTraqPinger
+		expectOK    bool
+		expectError map[string]bool
+	}
+	testCases := []test{
+		{
+			description: "すべて正常なのでok",
+			reminder:    runningReminder,
+			ping: func(context.Context) error {
+				return nil
+			},
+			expectOK:    true,
+			expectError: map[string]bool{},
+		},
+		{
+			description: "リマインダーが動いていないのでunavailable",
+			reminder:    NewReminder(),
+			ping: func(context.Context) error {
+				return nil
+			},
+			expectError: map[string]bool{
+				HealthCheckReminder: true,
+			},
+		},
+		{
+			description: "traQに到達できないのでunavailable",
+			reminder:    runningReminder,
+			ping: func(context.Context) error {
+				return errors.New("connection refused")
+			},
+			expectError: map[string]bool{
+				HealthCheckTraQ: true,
+			},
+		},
+		{
+			description: "traQの応答が時間内に返らないのでunavailable",
+			reminder:    runningReminder,
+			ping: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			expectError: map[string]bool{
+				HealthCheckTraQ: true,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		h := &Health{
+			reminder: testCase.reminder,
+			traq:     testCase.ping,
+			timeout:  100 * time.Millisecond,
+		}
+
+		status := h.Readiness(context.Background())
+
+		assertion.Equal(testCase.expectOK, status.IsOK(), testCase.description, "status")
+		for _, name := range []string{HealthCheckDatabase, HealthCheckMigrations, HealthCheckReminder, HealthCheckTraQ} {
+			check, ok := status.Checks[name]
+			if !assertion.True(ok, testCase.description, name) {
+				continue
+			}
+			if testCase.expectError[name] {
+				assertion.Equal(HealthStatusUnavailable, check.Status, testCase.description, name)
+				assertion.NotEmpty(check.Error, testCase.description, name)
+			} else {
+				assertion.Equal(HealthStatusOK, check.Status, testCase.description, name)
+				assertion.Empty(check.Error, testCase.description, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,476 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/i18n"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/openapi"
+	"github.com/traPtitech/anke-to/traq"
+	"gopkg.in/guregu/null.v4"
+)
+
+// maxMessageTemplateLength メッセージのテンプレートの最大文字数
+const maxMessageTemplateLength = 2000
+
+// maxMessageTemplateOutputLength テンプレートを1回実行したときに出力できる最大文字数
+const maxMessageTemplateOutputLength = traq.MessageLimit
+
+// messageTemplateTimeout テンプレートを1回実行するときにかけられる最大時間
+const messageTemplateTimeout = time.Second
+
+// errInvalidMessageTemplate メッセージのテンプレートが不正
+var errInvalidMessageTemplate = errors.New("invalid message template")
+
+// errMessageTemplateOutputTooLong テンプレートの出力が長すぎる
+var errMessageTemplateOutputTooLong = errors.New("message template output too long")
+
+// errMessageTemplateTimeout テンプレートの実行に時間がかかりすぎた
+var errMessageTemplateTimeout = errors.New("message template execution timed out")
+
+// messageTemplateFuncs テンプレートで使える関数
+// 出力の長さを引数で大きくできるprintfなどは使えない
+var messageTemplateFuncs = map[string]bool{
+	"and":   true,
+	"or":    true,
+	"not":   true,
+	"eq":    true,
+	"ne":    true,
+	"lt":    true,
+	"le":    true,
+	"gt":    true,
+	"ge":    true,
+	"len":   true,
+	"index": true,
+}
+
+// messageTemplateData メッセージのテンプレートに渡す値
+// フィールド名はテンプレートから参照されるため、変更するとテンプレートが壊れる
+type messageTemplateData struct {
+	Title          string
+	Description    string
+	Administrators string
+	Deadline       string
+	RemainingTime  string
+	Link           string
+	ResponseLink   string
+	Mentions       string
+	Targets        []string
+}
+
+// sampleMessageTemplateData テンプレートを保存するときに、実行できるかを確かめるための値
+var sampleMessageTemplateData = messageTemplateData{
+	Title:          "title",
+	Description:    "description",
+	Administrators: "administrator",
+	Deadline:       "2006/01/02 15:04",
+	RemainingTime:  "1日",
+	Link:           "https://anke-to.trap.jp/questionnaires/1",
+	ResponseLink:   "https://anke-to.trap.jp/responses/new/1",
+	Mentions:       "@target",
+	Targets:        []string{"target"},
+}
+
+// newMessageTemplateData アンケートの情報からテンプレートに渡す値を作る
+func newMessageTemplateData(language i18n.Language, questionnaireID int, title string, description string, administrators []string, resTimeLimit null.Time, remainingTime string) messageTemplateData {
+	deadline := i18n.Message(language, i18n.KeyNone)
+	if resTimeLimit.Valid {
+		deadline = resTimeLimit.Time.Local().Format("2006/01/02 15:04")
+	}
+
+	return messageTemplateData{
+		Title:          title,
+		Description:    description,
+		Administrators: strings.Join(administrators, ","),
+		Deadline:       deadline,
+		RemainingTime:  remainingTime,
+		Link:           questionnaireURL(questionnaireID),
+		ResponseLink:   responseFormURL(questionnaireID),
+	}
+}
+
+// parseMessageTemplate メッセージのテンプレートを解析し、例の値で実行できるかを確かめる
+func parseMessageTemplate(text string) (*template.Template, error) {
+	if utf8.RuneCountInString(text) > maxMessageTemplateLength {
+		return nil, fmt.Errorf("%w: longer than %d characters", errInvalidMessageTemplate, maxMessageTemplateLength)
+	}
+
+	tmpl, err := template.New("message").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidMessageTemplate, err)
+	}
+	if len(tmpl.Templates()) > 1 {
+		return nil, fmt.Errorf("%w: define and block are not allowed", errInvalidMessageTemplate)
+	}
+	err = checkMessageTemplateNode(tmpl.Tree.Root, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidMessageTemplate, err)
+	}
+
+	message, err := executeMessageTemplate(tmpl, sampleMessageTemplateData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidMessageTemplate, err)
+	}
+	if strings.TrimSpace(message) == "" {
+		return nil, fmt.Errorf("%w: empty message", errInvalidMessageTemplate)
+	}
+
+	return tmpl, nil
+}
+
+// checkMessageTemplateNode テンプレートの実行にかかる時間と出力の長さが対象者の数に比例する範囲に収まるかを確かめる
+// rangeは入れ子にできず、.Targetsのようなフィールドに対してだけ使える。また、他のテンプレートの呼び出しと許可していない関数は使えない
+func checkMessageTemplateNode(node parse.Node, inRange bool) error {
+	switch node := node.(type) {
+	case nil:
+		return nil
+	case *parse.ListNode:
+		if node == nil {
+			return nil
+		}
+		for _, n := range node.Nodes {
+			err := checkMessageTemplateNode(n, inRange)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case *parse.TextNode, *parse.CommentNode, *parse.BreakNode, *parse.ContinueNode:
+		return nil
+	case *parse.ActionNode:
+		return checkMessageTemplatePipe(node.Pipe)
+	case *parse.IfNode:
+		return checkMessageTemplateBranch(&node.BranchNode, inRange)
+	case *parse.WithNode:
+		return checkMessageTemplateBranch(&node.BranchNode, inRange)
+	case *parse.RangeNode:
+		if inRange {
+			return errors.New("nested range is not allowed")
+		}
+		if !isMessageTemplateFieldPipe(node.Pipe) {
+			return fmt.Errorf("range over %s is not allowed", node.Pipe)
+		}
+		return checkMessageTemplateBranch(&node.BranchNode, true)
+	case *parse.TemplateNode:
+		return errors.New("template is not allowed")
+	default:
+		return fmt.Errorf("%s is not allowed", node)
+	}
+}
+
+// checkMessageTemplateBranch if、with、rangeの条件と中身を確かめる
+func checkMessageTemplateBranch(node *parse.BranchNode, inRange bool) error {
+	err := checkMessageTemplatePipe(node.Pipe)
+	if err != nil {
+		return err
+	}
+	err = checkMessageTemplateNode(node.List, inRange)
+	if err != nil {
+		return err
+	}
+
+	return checkMessageTemplateNode(node.ElseList, inRange)
+}
+
+// checkMessageTemplatePipe パイプラインで許可していない関数を使っていないかを確かめる
+func checkMessageTemplatePipe(pipe *parse.PipeNode) error {
+	if pipe == nil {
+		return nil
+	}
+	for _, cmd := range pipe.Cmds {
+		for _, arg := range cmd.Args {
+			switch arg := arg.(type) {
+			case *parse.IdentifierNode:
+				if !messageTemplateFuncs[arg.Ident] {
+					return fmt.Errorf("function %s is not allowed", arg.Ident)
+				}
+			case *parse.PipeNode:
+				err := checkMessageTemplatePipe(arg)
+				if err != nil {
+					return err
+				}
+			case *parse.ChainNode:
+				if pipe, ok := arg.Node.(*parse.PipeNode); ok {
+					err := checkMessageTemplatePipe(pipe)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// isMessageTemplateFieldPipe パイプラインが.Targetsや$.Targetsのようなフィールドの参照だけかを判定する
+func isMessageTemplateFieldPipe(pipe *parse.PipeNode) bool {
+	if pipe == nil || len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+
+	switch arg := pipe.Cmds[0].Args[0].(type) {
+	case *parse.FieldNode:
+		return true
+	case *parse.VariableNode:
+		return len(arg.Ident) > 1
+	default:
+		return false
+	}
+}
+
+// messageTemplateWriter テンプレートの出力の長さと実行時間を制限するio.Writer
+type messageTemplateWriter struct {
+	sb       strings.Builder
+	length   int
+	deadline time.Time
+}
+
+func (w *messageTemplateWriter) Write(p []byte) (int, error) {
+	if time.Now().After(w.deadline) {
+		return 0, errMessageTemplateTimeout
+	}
+	w.length += utf8.RuneCount(p)
+	if w.length > maxMessageTemplateOutputLength {
+		return 0, errMessageTemplateOutputTooLong
+	}
+
+	return w.sb.Write(p)
+}
+
+// executeMessageTemplate 出力の長さと実行時間を制限してテンプレートを実行する
+func executeMessageTemplate(tmpl *template.Template, data messageTemplateData) (string, error) {
+	w := &messageTemplateWriter{deadline: time.Now().Add(messageTemplateTimeout)}
+	err := tmpl.Execute(w, data)
+	if err != nil {
+		return "", err
+	}
+
+	return w.sb.String(), nil
+}
+
+// validateMessageTemplates 保存する前にメッセージのテンプレートを確かめる
+// nilと空文字列は既定の形式を使うので確かめない
+func validateMessageTemplates(c echo.Context, templates ...*string) error {
+	for _, text := range templates {
+		if text == nil || *text == "" {
+			continue
+		}
+		_, err := parseMessageTemplate(*text)
+		if err != nil {
+			c.Logger().Infof("invalid message template: %+v", err)
+			return newAPIError(http.StatusBadRequest, openapi.InvalidMessageTemplate, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// updateMessageTemplates アンケートのメッセージのテンプレートを更新する
+// nilのテンプレートはbeforeのまま変更せず、空文字列のテンプレートは既定の形式に戻す
+func (q *Questionnaire) updateMessageTemplates(ctx context.Context, questionnaireID int, before model.Questionnaires, announcementTemplate *string, reminderTemplate *string) error {
+	if announcementTemplate == nil && reminderTemplate == nil {
+		return nil
+	}
+
+	announcement := before.AnnouncementTemplate
+	if announcementTemplate != nil {
+		announcement = null.NewString(*announcementTemplate, *announcementTemplate != "")
+	}
+	reminder := before.ReminderTemplate
+	if reminderTemplate != nil {
+		reminder = null.NewString(*reminderTemplate, *reminderTemplate != "")
+	}
+
+	err := q.UpdateQuestionnaireMessageTemplates(ctx, questionnaireID, announcement, reminder)
+	if err != nil && !errors.Is(err, model.ErrNoRecordUpdated) {
+		return fmt.Errorf("failed to update questionnaire message templates: %w", err)
+	}
+
+	return nil
+}
+
+// messageTemplate リクエストで指定されたテンプレートを返す。指定されていない場合は保存されているテンプレートを返す
+func messageTemplate(text *string, saved null.String) string {
+	if text != nil {
+		return *text
+	}
+
+	return saved.String
+}
+
+// renderMessageTemplate テンプレートからメッセージを作る
+// 対象者へのメンションでlimit文字を超える場合は、対象者を分けて複数のメッセージにする
+func renderMessageTemplate(tmpl *template.Template, data messageTemplateData, targets []string, limit int) ([]string, error) {
+	render := func(group []string) (string, error) {
+		d := data
+		d.Targets = group
+		if len(group) > 0 {
+			d.Mentions = "@" + strings.Join(group, " @")
+		}
+		message, err := executeMessageTemplate(tmpl, d)
+		if err != nil {
+			return "", fmt.Errorf("failed to execute message template: %w", err)
+		}
+		return message, nil
+	}
+
+	current, err := render(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []string{}
+	group := []string{}
+	for _, target := range targets {
+		next := append(slices.Clone(group), target)
+		message, err := render(next)
+		tooLong := errors.Is(err, errMessageTemplateOutputTooLong)
+		if err != nil && !(tooLong && len(group) > 0) {
+			return nil, err
+		}
+		if (tooLong || utf8.RuneCountInString(message) > limit) && len(group) > 0 {
+			messages = append(messages, current)
+			group = []string{target}
+			current, err = render(group)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		group = next
+		current = message
+	}
+
+	return append(messages, current), nil
+}
+
+// createAnnouncementMessages アンケートの作成のメッセージを作る
+// テンプレートが空の場合は既定の形式のメッセージを作る
+func createAnnouncementMessages(language i18n.Language, announcementTemplate string, questionnaireID int, title string, description string, administrators []string, resTimeLimit null.Time, targets []string) ([]string, error) {
+	if announcementTemplate == "" {
+		return createQuestionnaireMessage(language, questionnaireID, title, description, administrators, resTimeLimit, targets), nil
+	}
+
+	tmpl, err := parseMessageTemplate(announcementTemplate)
+	if err != nil {
+		return nil, err
+	}
+	data := newMessageTemplateData(language, questionnaireID, title, description, administrators, resTimeLimit, "")
+
+	return renderMessageTemplate(tmpl, data, targets, traq.MessageLimit)
+}
+
+// createReminderMessages リマインダーのメッセージを作る
+// テンプレートが空の場合は既定の形式のメッセージを作る
+func createReminderMessages(language i18n.Language, reminderTemplate string, questionnaireID int, title string, description string, administrators []string, resTimeLimit time.Time, targets []string, leftTimeText string) ([]string, error) {
+	if reminderTemplate == "" {
+		return createReminderMessage(language, questionnaireID, title, description, administrators, resTimeLimit, targets, leftTimeText), nil
+	}
+
+	tmpl, err := parseMessageTemplate(reminderTemplate)
+	if err != nil {
+		return nil, err
+	}
+	data := newMessageTemplateData(language, questionnaireID, title, description, administrators, null.TimeFrom(resTimeLimit), leftTimeText)
+
+	return renderMessageTemplate(tmpl, data, targets, traq.MessageLimit)
+}
+
+// previewRemainingTime プレビューで使う回答期限までの残り時間
+// 次に送られるリマインダーの残り時間を使い、送られるリマインダーがない場合は最後のリマインダーの残り時間を使う
+func previewRemainingTime(resTimeLimit null.Time) time.Duration {
+	if resTimeLimit.Valid {
+		for _, timing := range reminderTimingMinutes {
+			if reminderTimestamp(resTimeLimit.Time, timing).After(time.Now()) {
+				return time.Duration(timing) * time.Minute
+			}
+		}
+	}
+
+	return time.Duration(reminderTimingMinutes[len(reminderTimingMinutes)-1]) * time.Minute
+}
+
+// PreviewQuestionnaireMessage アンケートの作成またはリマインダーのメッセージを、アンケートの現在の情報でプレビューする
+func (q *Questionnaire) PreviewQuestionnaireMessage(c echo.Context, questionnaireID int, params openapi.MessagePreviewRequest) (openapi.MessagePreview, error) {
+	ctx := c.Request().Context()
+
+	err := validateMessageTemplates(c, params.Template)
+	if err != nil {
+		return openapi.MessagePreview{}, err
+	}
+
+	questionnaire, _, targetUsers, targetGroups, _, administratorUsers, administratorGroups, respondents, err := q.GetQuestionnaireInfo(ctx, questionnaireID)
+	if err != nil {
+		if errors.Is(err, model.ErrRecordNotFound) {
+			return openapi.MessagePreview{}, newAPIError(http.StatusNotFound, openapi.QuestionnaireNotFound, "questionnaire not found")
+		}
+		c.Logger().Errorf("failed to get questionnaire info: %+v", err)
+		return openapi.MessagePreview{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire info")
+	}
+	administratorGroupNames, err := uuid2GroupNames(ctx, administratorGroups)
+	if err != nil {
+		c.Logger().Errorf("failed to get administrator group names: %+v", err)
+		return openapi.MessagePreview{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get administrator group names")
+	}
+	administrators := append(administratorUsers, administratorGroupNames...)
+	language := i18n.ParseLanguage(questionnaire.Language)
+
+	var messages []string
+	switch params.Type {
+	case openapi.Announcement:
+		var targetGroupNames []string
+		targetGroupNames, err = uuid2GroupNames(ctx, targetGroups)
+		if err != nil {
+			c.Logger().Errorf("failed to get target group names: %+v", err)
+			return openapi.MessagePreview{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get target group names")
+		}
+		messages, err = createAnnouncementMessages(
+			language,
+			messageTemplate(params.Template, questionnaire.AnnouncementTemplate),
+			questionnaireID,
+			questionnaire.Title,
+			questionnaire.Description,
+			administrators,
+			questionnaire.ResTimeLimit,
+			append(targetUsers, targetGroupNames...),
+		)
+	case openapi.Reminder:
+		var reminderTargets []string
+		reminderTargets, err = getReminderTargets(ctx, questionnaire, respondents)
+		if err != nil {
+			c.Logger().Errorf("failed to get reminder targets: %+v", err)
+			return openapi.MessagePreview{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get reminder targets")
+		}
+		messages, err = createReminderMessages(
+			language,
+			messageTemplate(params.Template, questionnaire.ReminderTemplate),
+			questionnaireID,
+			questionnaire.Title,
+			questionnaire.Description,
+			administrators,
+			questionnaire.ResTimeLimit.Time,
+			reminderTargets,
+			i18n.FormatDuration(language, previewRemainingTime(questionnaire.ResTimeLimit)),
+		)
+	default:
+		c.Logger().Infof("invalid message template type: %s", params.Type)
+		return openapi.MessagePreview{}, echo.NewHTTPError(http.StatusBadRequest, "invalid message template type")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to create messages: %+v", err)
+		return openapi.MessagePreview{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to create messages")
+	}
+
+	return openapi.MessagePreview{Messages: messages}, nil
+}
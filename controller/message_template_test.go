@@ -0,0 +1,328 @@
+package controller
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/i18n"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestParseMessageTemplate(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type test struct {
+		description string
+		text        string
+		isErr       bool
+	}
+
+	testCases := []test{
+		{
+			description: "値を使うテンプレートなので問題なし",
+			text:        "{{.Title}} {{.Link}}\n{{.Mentions}}",
+		},
+		{
+			description: "rangeを使うテンプレートなので問題なし",
+			text:        "{{.Title}}{{range .Targets}} @{{.}}{{end}}",
+		},
+		{
+			description: "値を使わないテンプレートなので問題なし",
+			text:        "アンケートに回答してください",
+		},
+		{
+			description: "構文が不正なのでエラー",
+			text:        "{{.Title",
+			isErr:       true,
+		},
+		{
+			description: "存在しない値を使うのでエラー",
+			text:        "{{.Unknown}}",
+			isErr:       true,
+		},
+		{
+			description: "空のメッセージになるのでエラー",
+			text:        "{{if false}}{{.Title}}{{end}}",
+			isErr:       true,
+		},
+		{
+			description: "長すぎるのでエラー",
+			text:        strings.Repeat("あ", maxMessageTemplateLength+1),
+			isErr:       true,
+		},
+		{
+			description: "整数に対するrangeなのでエラー",
+			text:        "{{range 3}}x{{end}}",
+			isErr:       true,
+		},
+		{
+			description: "入れ子のrangeなのでエラー",
+			text:        "{{range 3000}}{{range 3000}}{{range 30}}x{{end}}{{end}}{{end}}",
+			isErr:       true,
+		},
+		{
+			description: "フィールドに対する入れ子のrangeなのでエラー",
+			text:        "{{range .Targets}}{{range $.Targets}}x{{end}}{{end}}",
+			isErr:       true,
+		},
+		{
+			description: "$.Targetsに対するrangeなので問題なし",
+			text:        "{{with .Title}}{{.}}{{range $.Targets}} @{{.}}{{end}}{{end}}",
+		},
+		{
+			description: "printfを使うのでエラー",
+			text:        `{{printf "%*d" 100000000 1}}`,
+			isErr:       true,
+		},
+		{
+			description: "テンプレートを定義するのでエラー",
+			text:        `{{define "a"}}{{template "a" .}}{{template "a" .}}{{end}}{{template "a" .}}`,
+			isErr:       true,
+		},
+		{
+			description: "比較の関数は使えるので問題なし",
+			text:        `{{if eq .Title "title"}}{{.Title}}{{end}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		start := time.Now()
+		_, err := parseMessageTemplate(testCase.text)
+		assertion.Less(time.Since(start), messageTemplateTimeout, testCase.description, "time")
+		if testCase.isErr {
+			assertion.Error(err, testCase.description)
+			assertion.True(errors.Is(err, errInvalidMessageTemplate), testCase.description, "errInvalidMessageTemplate")
+		} else {
+			assertion.NoError(err, testCase.description)
+		}
+	}
+}
+
+func TestRenderMessageTemplate(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type args struct {
+		text    string
+		targets []string
+		limit   int
+	}
+	type expect struct {
+		isErr    bool
+		err      error
+		messages []string
+	}
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	data := messageTemplateData{
+		Title:       "title",
+		Description: strings.Repeat("a", 3000),
+		Link:        "https://anke-to.trap.jp/questionnaires/1",
+	}
+
+	testCases := []test{
+		{
+			description: "対象者をメンションできる",
+			args: args{
+				text:    "{{.Title}}\n{{.Mentions}}",
+				targets: []string{"target1", "target2"},
+				limit:   100,
+			},
+			expect: expect{
+				messages: []string{"title\n@target1 @target2"},
+			},
+		},
+		{
+			description: "対象者がいなくても1つのメッセージを作る",
+			args: args{
+				text:    "{{.Title}}\n{{.Mentions}}",
+				targets: []string{},
+				limit:   100,
+			},
+			expect: expect{
+				messages: []string{"title\n"},
+			},
+		},
+		{
+			description: "Targetsをrangeで使える",
+			args: args{
+				text:    "{{.Link}}{{range .Targets}} @{{.}}{{end}}",
+				targets: []string{"target1", "target2"},
+				limit:   100,
+			},
+			expect: expect{
+				messages: []string{"https://anke-to.trap.jp/questionnaires/1 @target1 @target2"},
+			},
+		},
+		{
+			description: "長すぎる場合は対象者を分けて複数のメッセージにする",
+			args: args{
+				text:    "{{.Title}}\n{{.Mentions}}",
+				targets: []string{"target1", "target2", "target3"},
+				limit:   23,
+			},
+			expect: expect{
+				messages: []string{"title\n@target1 @target2", "title\n@target3"},
+			},
+		},
+		{
+			description: "1人でも長すぎる場合はそのままのメッセージにする",
+			args: args{
+				text:    "{{.Title}}\n{{.Mentions}}",
+				targets: []string{"target1"},
+				limit:   5,
+			},
+			expect: expect{
+				messages: []string{"title\n@target1"},
+			},
+		},
+		{
+			description: "出力が最大文字数を超える場合はエラー",
+			args: args{
+				text:    strings.Repeat("{{.Description}}", 4),
+				targets: []string{},
+				limit:   maxMessageTemplateOutputLength * 2,
+			},
+			expect: expect{
+				isErr: true,
+				err:   errMessageTemplateOutputTooLong,
+			},
+		},
+		{
+			description: "対象者を加えると出力が最大文字数を超える場合は対象者を分ける",
+			args: args{
+				text:    "{{.Description}}{{.Description}}{{range .Targets}}{{$.Description}}{{end}}",
+				targets: []string{"target1", "target2"},
+				limit:   maxMessageTemplateOutputLength * 2,
+			},
+			expect: expect{
+				messages: []string{
+					strings.Repeat(data.Description, 3),
+					strings.Repeat(data.Description, 3),
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		tmpl, err := parseMessageTemplate(testCase.args.text)
+		if !assertion.NoError(err, testCase.description, "parse") {
+			continue
+		}
+
+		messages, err := renderMessageTemplate(tmpl, data, testCase.args.targets, testCase.args.limit)
+		if testCase.expect.isErr {
+			assertion.Error(err, testCase.description, "render")
+			if testCase.expect.err != nil {
+				assertion.ErrorIs(err, testCase.expect.err, testCase.description, "render")
+			}
+			continue
+		}
+		if !assertion.NoError(err, testCase.description, "render") {
+			continue
+		}
+		assertion.Equal(testCase.expect.messages, messages, testCase.description, "messages")
+	}
+}
+
+func TestCreateAnnouncementMessages(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type args struct {
+		language i18n.Language
+		template string
+	}
+	type expect struct {
+		messages []string
+	}
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	tm, err := time.ParseInLocation("2006/01/02 15:04", "2021/10/01 09:06", time.Local)
+	if err != nil {
+		t.Errorf("failed to parse time: %v", err)
+	}
+
+	testCases := []test{
+		{
+			description: "テンプレートが空なので既定の形式",
+			args: args{
+				language: i18n.LanguageJa,
+				template: "",
+			},
+			expect: expect{
+				messages: createQuestionnaireMessage(i18n.LanguageJa, 1, "title", "description", []string{"administrator1"}, null.TimeFrom(tm), []string{"target1"}),
+			},
+		},
+		{
+			description: "テンプレートの値で置き換える",
+			args: args{
+				language: i18n.LanguageJa,
+				template: "{{.Title}} ({{.Administrators}}) {{.Deadline}}\n{{.ResponseLink}}\n{{.Mentions}}",
+			},
+			expect: expect{
+				messages: []string{"title (administrator1) 2021/10/01 09:06\nhttps://anke-to.trap.jp/responses/new/1\n@target1"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		messages, err := createAnnouncementMessages(
+			testCase.args.language,
+			testCase.args.template,
+			1,
+			"title",
+			"description",
+			[]string{"administrator1"},
+			null.TimeFrom(tm),
+			[]string{"target1"},
+		)
+		if !assertion.NoError(err, testCase.description) {
+			continue
+		}
+		assertion.Equal(testCase.expect.messages, messages, testCase.description, "messages")
+	}
+}
+
+func TestCreateReminderMessages(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	tm, err := time.ParseInLocation("2006/01/02 15:04", "2021/10/01 09:06", time.Local)
+	if err != nil {
+		t.Errorf("failed to parse time: %v", err)
+	}
+
+	messages, err := createReminderMessages(
+		i18n.LanguageEn,
+		"{{.Title}}: {{.RemainingTime}} left\n{{.Mentions}}",
+		1,
+		"title",
+		"description",
+		[]string{"administrator1"},
+		tm,
+		[]string{"target1", "target2"},
+		i18n.FormatDuration(i18n.LanguageEn, 24*time.Hour),
+	)
+	assertion.NoError(err)
+	assertion.Equal([]string{"title: 1 day left\n@target1 @target2"}, messages)
+
+	_, err = createReminderMessages(i18n.LanguageEn, "{{.Unknown}}", 1, "title", "description", nil, tm, nil, "1 day")
+	assertion.ErrorIs(err, errInvalidMessageTemplate)
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// metricsNamespace anke-toのメトリクスの名前の接頭辞
+const metricsNamespace = "anke_to"
+
+// unmatchedRoute どのルートにも一致しなかったリクエストのrouteラベル
+// パスをそのままラベルにすると、存在しないパスへのリクエストでラベルの種類が際限なく増えてしまう
+const unmatchedRoute = "unmatched"
+
+var (
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests by route and status",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	reminderJobsScheduled = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "reminder",
+		Name:      "jobs_scheduled_total",
+		Help:      "Number of reminder jobs scheduled",
+	})
+	reminderJobsFired = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "reminder",
+		Name:      "jobs_fired_total",
+		Help:      "Number of reminder jobs fired",
+	})
+	reminderJobsFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "reminder",
+		Name:      "jobs_failed_total",
+		Help:      "Number of reminder jobs failed",
+	})
+	reminderQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "reminder",
+		Name:      "queue_depth",
+		Help:      "Number of reminder jobs waiting to be fired",
+	})
+)
+
+// MetricsMiddleware リクエストの処理時間をルートとステータスコードごとに記録するミドルウェア
+func MetricsMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+
+		err := next(c)
+		// エラーのレスポンスはこの後でHTTPErrorHandlerが書き込むため、HTTPErrorHandlerと同じくエラーからステータスコードを求める
+		status := c.Response().Status
+		if err != nil && !c.Response().Committed {
+			status = innermostHTTPError(err).Code
+		}
+
+		route := c.Path()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		httpRequestDuration.
+			WithLabelValues(c.Request().Method, route, strconv.Itoa(status)).
+			Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/anke-to/openapi"
+)
+
+// histogramSampleCount ヒストグラムに記録された値の数を返す
+func histogramSampleCount(t *testing.T, observer prometheus.Observer) uint64 {
+	t.Helper()
+
+	histogram, ok := observer.(prometheus.Histogram)
+	require.True(t, ok, "observer is histogram")
+
+	var metric dto.Metric
+	require.NoError(t, histogram.Write(&metric))
+
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	var errorHandlerCalls int
+	e := echo.New()
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		errorHandlerCalls++
+		HTTPErrorHandler(err, c)
+	}
+	e.Use(MetricsMiddleware)
+	e.GET("/metrics-test/:id", func(c echo.Context) error {
+		switch c.Param("id") {
+		case "missing":
+			return echo.NewHTTPError(http.StatusNotFound, "not found")
+		case "forbidden":
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check: %w", newAPIError(http.StatusForbidden, openapi.Forbidden, "forbidden")))
+		case "broken":
+			return errors.New("broken")
+		}
+		return c.NoContent(http.StatusOK)
+	})
+
+	type test struct {
+		description       string
+		path              string
+		route             string
+		status            string
+		errorHandlerCalls int
+	}
+
+	testCases := []test{
+		{
+			description: "success",
+			path:        "/metrics-test/1",
+			route:       "/metrics-test/:id",
+			status:      "200",
+		},
+		{
+			description:       "error returned from handler",
+			path:              "/metrics-test/missing",
+			route:             "/metrics-test/:id",
+			status:            "404",
+			errorHandlerCalls: 1,
+		},
+		{
+			description:       "wrapped error uses the innermost status",
+			path:              "/metrics-test/forbidden",
+			route:             "/metrics-test/:id",
+			status:            "403",
+			errorHandlerCalls: 1,
+		},
+		{
+			description:       "error without status",
+			path:              "/metrics-test/broken",
+			route:             "/metrics-test/:id",
+			status:            "500",
+			errorHandlerCalls: 1,
+		},
+		{
+			description:       "unmatched route",
+			path:              "/metrics-test",
+			route:             unmatchedRoute,
+			status:            "404",
+			errorHandlerCalls: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		observer := httpRequestDuration.WithLabelValues(http.MethodGet, testCase.route, testCase.status)
+		before := histogramSampleCount(t, observer)
+		errorHandlerCalls = 0
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, testCase.path, nil))
+
+		assertion.Equal(testCase.status, strconv.Itoa(rec.Code), testCase.description)
+		assertion.Equal(before+1, histogramSampleCount(t, observer), testCase.description)
+		assertion.Equal(testCase.errorHandlerCalls, errorHandlerCalls, testCase.description, "error handler calls")
+	}
+}
+
+func TestReminderMetrics(t *testing.T) {
+	assertion := assert.New(t)
+
+	re := NewReminder()
+
+	scheduled := testutil.ToFloat64(reminderJobsScheduled)
+	fired := testutil.ToFloat64(reminderJobsFired)
+
+	executedCh := make(chan struct{}, 1)
+	re.push(&Job{
+		Timestamp:       time.Now(),
+		QuestionnaireID: 1,
+		Action: func() {
+			executedCh <- struct{}{}
+		},
+	})
+	re.push(&Job{
+		Timestamp:       time.Now().Add(time.Hour),
+		QuestionnaireID: 2,
+		Action:          func() {},
+	})
+
+	assertion.Equal(scheduled+2, testutil.ToFloat64(reminderJobsScheduled), "scheduled")
+	assertion.Equal(float64(2), testutil.ToFloat64(reminderQueueDepth), "queue depth after push")
+
+	err := re.DeleteReminder(2)
+	assertion.NoError(err)
+	assertion.Equal(float64(1), testutil.ToFloat64(reminderQueueDepth), "queue depth after delete")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go re.ReminderWorker(ctx)
+
+	select {
+	case <-executedCh:
+	case <-time.After(1 * time.Second):
+		t.Fatal("reminder was not executed in time")
+	}
+
+	assertion.Equal(fired+1, testutil.ToFloat64(reminderJobsFired), "fired")
+	assertion.Equal(float64(0), testutil.ToFloat64(reminderQueueDepth), "queue depth after fire")
+}
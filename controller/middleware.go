@@ -5,10 +5,16 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/traPtitech/anke-to/auth"
 	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/openapi"
+	"golang.org/x/time/rate"
 )
 
 // Middleware Middlewareの構造体
@@ -17,6 +23,17 @@ type Middleware struct {
 	model.IRespondent
 	model.IQuestion
 	model.IQuestionnaire
+	model.ISystemAdmin
+	model.IAccessToken
+	auth.Authenticator
+
+	accessTokenLimiters *accessTokenLimiters
+}
+
+// accessTokenLimiters アクセストークンごとのレート制限
+type accessTokenLimiters struct {
+	locker   sync.Mutex
+	limiters map[int]*rate.Limiter
 }
 
 // NewMiddleware Middlewareのコンストラクタ
@@ -25,12 +42,22 @@ func NewMiddleware(
 	respondent model.IRespondent,
 	question model.IQuestion,
 	questionnaire model.IQuestionnaire,
+	systemAdmin model.ISystemAdmin,
+	accessToken model.IAccessToken,
+	authenticator auth.Authenticator,
 ) *Middleware {
 	return &Middleware{
 		IAdministrator: administrator,
 		IRespondent:    respondent,
 		IQuestion:      question,
 		IQuestionnaire: questionnaire,
+		ISystemAdmin:   systemAdmin,
+		IAccessToken:   accessToken,
+		Authenticator:  authenticator,
+
+		accessTokenLimiters: &accessTokenLimiters{
+			limiters: map[int]*rate.Limiter{},
+		},
 	}
 }
 
@@ -40,21 +67,24 @@ const (
 	questionnaireIDKey = "questionnaireID"
 	responseIDKey      = "responseID"
 	questionIDKey      = "questionID"
+	accessTokenKey     = "accessToken"
 )
 
-/*
-	消せないアンケートの発生を防ぐための管理者
-
-暫定的にハードコーディングで対応
-*/
-var adminUserIDs = []string{"ryoha", "xxarupakaxx", "kaitoyama", "cp20", "itzmeowww"}
-
-// SetUserIDMiddleware X-Forwarded-UserからユーザーIDを取得しセットする
+// SetUserIDMiddleware AuthenticatorでユーザーIDを取得しセットする
+// 認証できなかった場合は未ログインを表す"-"をセットする
 func (m *Middleware) SetUserIDMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		userID := c.Request().Header.Get("X-Forwarded-User")
-		if userID == "" {
-			userID = "mds_boy"
+		// アクセストークンで認証済み
+		if _, ok := c.Get(userIDKey).(string); ok {
+			return next(c)
+		}
+
+		userID, err := m.Authenticate(c.Request())
+		if err != nil {
+			if !errors.Is(err, auth.ErrNoCredentials) {
+				c.Logger().Infof("failed to authenticate: %+v", err)
+			}
+			userID = "-"
 		}
 
 		c.Set(userIDKey, userID)
@@ -63,6 +93,114 @@ func (m *Middleware) SetUserIDMiddleware(next echo.HandlerFunc) echo.HandlerFunc
 	}
 }
 
+// AccessTokenMiddleware 個人用アクセストークンからユーザーIDを取得しセットする
+// アクセストークンでないAuthorizationヘッダーはSetUserIDMiddlewareに任せる
+func (m *Middleware) AccessTokenMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		scheme, token, ok := strings.Cut(c.Request().Header.Get(echo.HeaderAuthorization), " ")
+		token = strings.TrimSpace(token)
+		if !ok || !strings.EqualFold(scheme, "Bearer") || !strings.HasPrefix(token, AccessTokenPrefix) {
+			return next(c)
+		}
+
+		accessToken, err := m.GetAccessTokenByHash(c.Request().Context(), hashAccessToken(token))
+		if errors.Is(err, model.ErrRecordNotFound) {
+			c.Logger().Info("access token not found")
+			return newAPIError(http.StatusUnauthorized, openapi.InvalidAccessToken, "invalid access token")
+		}
+		if err != nil {
+			c.Logger().Errorf("failed to get access token: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get access token: %w", err))
+		}
+
+		now := time.Now()
+		if accessToken.IsExpired(now) {
+			c.Logger().Infof("access token expired: access_token_id=%d", accessToken.ID)
+			return newAPIError(http.StatusUnauthorized, openapi.AccessTokenExpired, "access token expired")
+		}
+
+		if !m.accessTokenLimiters.get(accessToken).Allow() {
+			return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+		}
+
+		// 書き込みを減らすため、最終使用日時は1分単位で更新する
+		if !accessToken.LastUsedAt.Valid || now.Sub(accessToken.LastUsedAt.Time) >= time.Minute {
+			err = m.UpdateAccessTokenLastUsedAt(c.Request().Context(), accessToken.ID, now)
+			if err != nil {
+				c.Logger().Errorf("failed to update access token last used at: %+v", err)
+			}
+		}
+
+		c.Set(userIDKey, accessToken.UserTraqid)
+		c.Set(accessTokenKey, accessToken)
+
+		return next(c)
+	}
+}
+
+// get アクセストークンのレート制限を取得する
+// 1分あたりRateLimit回までリクエストできる
+func (l *accessTokenLimiters) get(accessToken *model.AccessTokens) *rate.Limiter {
+	l.locker.Lock()
+	defer l.locker.Unlock()
+
+	limiter, ok := l.limiters[accessToken.ID]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Every(time.Minute/time.Duration(accessToken.RateLimit)), accessToken.RateLimit)
+		l.limiters[accessToken.ID] = limiter
+	}
+
+	return limiter
+}
+
+// accessTokenRouteScopes アクセストークンで呼び出せるAPIと必要なスコープ
+// ここにないAPIはアクセストークンでは呼び出せない
+var accessTokenRouteScopes = map[string]model.AccessTokenScope{
+	"GET /api/questionnaires":                                 model.AccessTokenScopeQuestionnairesRead,
+	"GET /api/questionnaires/:questionnaireID":                model.AccessTokenScopeQuestionnairesRead,
+	"GET /api/questionnaires/:questionnaireID/myRemindStatus": model.AccessTokenScopeQuestionnairesRead,
+	"GET /api/tags":                                              model.AccessTokenScopeQuestionnairesRead,
+	"POST /api/questionnaires":                                   model.AccessTokenScopeQuestionnairesWrite,
+	"PATCH /api/questionnaires/:questionnaireID":                 model.AccessTokenScopeQuestionnairesWrite,
+	"DELETE /api/questionnaires/:questionnaireID":                model.AccessTokenScopeQuestionnairesWrite,
+	"POST /api/questionnaires/:questionnaireID/close":            model.AccessTokenScopeQuestionnairesWrite,
+	"POST /api/questionnaires/:questionnaireID/messagePreview":   model.AccessTokenScopeQuestionnairesWrite,
+	"PATCH /api/questionnaires/:questionnaireID/myRemindStatus":  model.AccessTokenScopeQuestionnairesWrite,
+	"POST /api/tags":                                             model.AccessTokenScopeQuestionnairesWrite,
+	"PATCH /api/tags/:tagID":                                     model.AccessTokenScopeQuestionnairesWrite,
+	"DELETE /api/tags/:tagID":                                    model.AccessTokenScopeQuestionnairesWrite,
+	"GET /api/questionnaires/:questionnaireID/responses":         model.AccessTokenScopeResponsesRead,
+	"GET /api/questionnaires/:questionnaireID/responses/stream":  model.AccessTokenScopeResponsesRead,
+	"GET /api/questionnaires/:questionnaireID/responses/summary": model.AccessTokenScopeResponsesRead,
+	"GET /api/responses/:responseID":                             model.AccessTokenScopeResponsesRead,
+	"GET /api/responses/myResponses":                             model.AccessTokenScopeResponsesRead,
+	"POST /api/questionnaires/:questionnaireID/responses":        model.AccessTokenScopeResponsesWrite,
+	"PATCH /api/responses/:responseID":                           model.AccessTokenScopeResponsesWrite,
+	"DELETE /api/responses/:responseID":                          model.AccessTokenScopeResponsesWrite,
+	"PUT /api/responses/:responseID/review":                      model.AccessTokenScopeResponsesWrite,
+}
+
+// AccessTokenScopeAuthenticate アクセストークンのスコープの認証
+// アクセストークンを使っていないリクエストはそのまま通す
+func (m *Middleware) AccessTokenScopeAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		accessToken, ok := c.Get(accessTokenKey).(*model.AccessTokens)
+		if !ok {
+			return next(c)
+		}
+
+		scope, ok := accessTokenRouteScopes[c.Request().Method+" "+c.Path()]
+		if !ok {
+			return newAPIError(http.StatusForbidden, openapi.InsufficientScope, "This API cannot be called with an access token.")
+		}
+		if !accessToken.HasScope(scope) {
+			return newAPIError(http.StatusForbidden, openapi.InsufficientScope, fmt.Sprintf("The access token needs the %s scope.", scope))
+		}
+
+		return next(c)
+	}
+}
+
 // TraPMemberAuthenticate traP部員かの認証
 func (m *Middleware) TraPMemberAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -85,7 +223,7 @@ func (m *Middleware) TraPMemberAuthenticate(next echo.HandlerFunc) echo.HandlerF
 // TrapRateLimitMiddlewareFunc traP IDベースのリクエスト制限
 func (m *Middleware) TrapRateLimitMiddlewareFunc() echo.MiddlewareFunc {
 	config := middleware.RateLimiterConfig{
-		Store: middleware.NewRateLimiterMemoryStore(60),
+		Store: middleware.NewRateLimiterMemoryStore(rate.Limit(questionnairesRateLimit)),
 		IdentifierExtractor: func(c echo.Context) (string, error) {
 			userID, err := m.GetUserID(c)
 			if err != nil {
@@ -118,13 +256,6 @@ func (m *Middleware) QuestionnaireReadAuthenticate(next echo.HandlerFunc) echo.H
 		}
 
 		// 管理者ならOK
-		for _, adminID := range adminUserIDs {
-			if userID == adminID {
-				c.Set(questionnaireIDKey, questionnaireID)
-
-				return next(c)
-			}
-		}
 		isAdmin, err := m.IAdministrator.CheckQuestionnaireAdmin(c.Request().Context(), userID, questionnaireID)
 		if err != nil {
 			c.Logger().Errorf("failed to check questionnaire admin: %+v", err)
@@ -139,14 +270,23 @@ func (m *Middleware) QuestionnaireReadAuthenticate(next echo.HandlerFunc) echo.H
 		questionnaire, _, _, _, _, _, _, _, err := m.IQuestionnaire.GetQuestionnaireInfo(c.Request().Context(), questionnaireID)
 		if errors.Is(err, model.ErrRecordNotFound) {
 			c.Logger().Infof("questionnaire not found: %+v", err)
-			return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("questionnaire not found:%d", questionnaireID))
+			return newAPIError(http.StatusNotFound, openapi.QuestionnaireNotFound, fmt.Sprintf("questionnaire not found:%d", questionnaireID))
 		}
 		if err != nil {
 			c.Logger().Errorf("failed to get questionnaire read privilege info: %+v", err)
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get questionnaire read privilege info: %w", err))
 		}
 		if !questionnaire.IsPublished {
-			return c.String(http.StatusForbidden, "The questionnaire is not published.")
+			// 消せないアンケートの発生を防ぐため、システム管理者は未公開のアンケートも閲覧できる
+			isSystemAdmin, err := m.CheckSystemAdmin(c.Request().Context(), userID)
+			if err != nil {
+				c.Logger().Errorf("failed to check system admin: %+v", err)
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check if you are system admin: %w", err))
+			}
+			if !isSystemAdmin {
+				return newAPIError(http.StatusForbidden, openapi.QuestionnaireNotPublished, "The questionnaire is not published.")
+			}
+			logSystemAdminAction(c, userID)
 		}
 
 		c.Set(questionnaireIDKey, questionnaireID)
@@ -155,8 +295,23 @@ func (m *Middleware) QuestionnaireReadAuthenticate(next echo.HandlerFunc) echo.H
 	}
 }
 
-// QuestionnaireAdministratorAuthenticate アンケートの管理者かどうかの認証
-func (m *Middleware) QuestionnaireAdministratorAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+// QuestionnaireEditorAuthenticate アンケートの構造を編集できる管理者かどうかの認証
+func (m *Middleware) QuestionnaireEditorAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return m.questionnaireAdministratorAuthenticate(model.AdministratorRoleEditor, next)
+}
+
+// QuestionnaireViewerAuthenticate アンケートの結果を閲覧できる管理者かどうかの認証
+func (m *Middleware) QuestionnaireViewerAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return m.questionnaireAdministratorAuthenticate(model.AdministratorRoleViewer, next)
+}
+
+// QuestionnaireOwnerAuthenticate アンケートの削除や管理者の変更ができる管理者かどうかの認証
+func (m *Middleware) QuestionnaireOwnerAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return m.questionnaireAdministratorAuthenticate(model.AdministratorRoleOwner, next)
+}
+
+// questionnaireAdministratorAuthenticate アンケートに対してrole以上の権限を持つ管理者かどうかの認証
+func (m *Middleware) questionnaireAdministratorAuthenticate(role model.AdministratorRole, next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 
 		userID, err := m.GetUserID(c)
@@ -172,23 +327,56 @@ func (m *Middleware) QuestionnaireAdministratorAuthenticate(next echo.HandlerFun
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid questionnaireID:%s(error: %w)", strQuestionnaireID, err))
 		}
 
-		for _, adminID := range adminUserIDs {
-			if userID == adminID {
-				c.Set(questionnaireIDKey, questionnaireID)
+		userRole, err := m.IAdministrator.GetAdministratorRole(c.Request().Context(), userID, questionnaireID)
+		if err != nil && !errors.Is(err, model.ErrRecordNotFound) {
+			c.Logger().Errorf("failed to get administrator role: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check if you are administrator: %w", err))
+		}
+		if err == nil && userRole.Includes(role) {
+			c.Set(questionnaireIDKey, questionnaireID)
 
-				return next(c)
-			}
+			return next(c)
 		}
-		isAdmin, err := m.IAdministrator.CheckQuestionnaireAdmin(c.Request().Context(), userID, questionnaireID)
+
+		// 消せないアンケートの発生を防ぐため、システム管理者はすべてのアンケートのオーナー権限を持つ
+		isSystemAdmin, err := m.CheckSystemAdmin(c.Request().Context(), userID)
 		if err != nil {
-			c.Logger().Errorf("failed to check questionnaire admin: %+v", err)
-			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check if you are administrator: %w", err))
+			c.Logger().Errorf("failed to check system admin: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check if you are system admin: %w", err))
 		}
-		if !isAdmin {
-			return c.String(http.StatusForbidden, "You are not a administrator of this questionnaire.")
+		if isSystemAdmin {
+			logSystemAdminAction(c, userID)
+			c.Set(questionnaireIDKey, questionnaireID)
+
+			return next(c)
 		}
 
-		c.Set(questionnaireIDKey, questionnaireID)
+		if userRole == "" {
+			return newAPIError(http.StatusForbidden, openapi.Forbidden, "You are not a administrator of this questionnaire.")
+		}
+		return newAPIError(http.StatusForbidden, openapi.Forbidden, fmt.Sprintf("You need the %s role of this questionnaire.", role))
+	}
+}
+
+// SystemAdminAuthenticate システム管理者かどうかの認証
+func (m *Middleware) SystemAdminAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		userID, err := m.GetUserID(c)
+		if err != nil {
+			c.Logger().Errorf("failed to get userID: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+		}
+
+		isSystemAdmin, err := m.CheckSystemAdmin(c.Request().Context(), userID)
+		if err != nil {
+			c.Logger().Errorf("failed to check system admin: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check if you are system admin: %w", err))
+		}
+		if !isSystemAdmin {
+			return newAPIError(http.StatusForbidden, openapi.Forbidden, "You are not a system admin.")
+		}
+
+		logSystemAdminAction(c, userID)
 
 		return next(c)
 	}
@@ -215,7 +403,7 @@ func (m *Middleware) ResponseReadAuthenticate(next echo.HandlerFunc) echo.Handle
 		respondent, err := m.IRespondent.GetRespondent(c.Request().Context(), responseID)
 		if errors.Is(err, model.ErrRecordNotFound) {
 			c.Logger().Infof("response not found: %+v", err)
-			return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("response not found:%d", responseID))
+			return newAPIError(http.StatusNotFound, openapi.ResponseNotFound, fmt.Sprintf("response not found:%d", responseID))
 		}
 		if err != nil {
 			c.Logger().Errorf("failed to check if you are a respondent: %+v", err)
@@ -225,7 +413,7 @@ func (m *Middleware) ResponseReadAuthenticate(next echo.HandlerFunc) echo.Handle
 			c.Logger().Error("respondent is nil")
 			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
-		if respondent.UserTraqid == userID {
+		if respondent.IsRespondedBy(userID) {
 			return next(c)
 		}
 
@@ -234,7 +422,7 @@ func (m *Middleware) ResponseReadAuthenticate(next echo.HandlerFunc) echo.Handle
 			c.Logger().Info("not submitted")
 
 			// Note: 一時保存の回答の存在もわかってはいけないので、Respondentが見つからない時と全く同じエラーを返す
-			return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("response not found:%d", responseID))
+			return newAPIError(http.StatusNotFound, openapi.ResponseNotFound, fmt.Sprintf("response not found:%d", responseID))
 		}
 
 		// アンケートごとの回答閲覧権限チェック
@@ -253,7 +441,7 @@ func (m *Middleware) ResponseReadAuthenticate(next echo.HandlerFunc) echo.Handle
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check response read privilege: %w", err))
 		}
 		if !haveReadPrivilege {
-			return c.String(http.StatusForbidden, "You do not have permission to view this response.")
+			return newAPIError(http.StatusForbidden, openapi.Forbidden, "You do not have permission to view this response.")
 		}
 
 		return next(c)
@@ -262,6 +450,16 @@ func (m *Middleware) ResponseReadAuthenticate(next echo.HandlerFunc) echo.Handle
 
 // RespondentAuthenticate 回答者かどうかの認証
 func (m *Middleware) RespondentAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return m.respondentAuthenticate(next, false)
+}
+
+// RespondentOrEditorAuthenticate 回答者か、代理で回答を変更できる運営かどうかの認証
+// 匿名のアンケートの回答は回答者本人のみが変更できる
+func (m *Middleware) RespondentOrEditorAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return m.respondentAuthenticate(next, true)
+}
+
+func (m *Middleware) respondentAuthenticate(next echo.HandlerFunc, allowEditor bool) echo.HandlerFunc {
 	return func(c echo.Context) error {
 
 		userID, err := m.GetUserID(c)
@@ -280,7 +478,7 @@ func (m *Middleware) RespondentAuthenticate(next echo.HandlerFunc) echo.HandlerF
 		respondent, err := m.IRespondent.GetRespondent(c.Request().Context(), responseID)
 		if errors.Is(err, model.ErrRecordNotFound) {
 			c.Logger().Infof("response not found: %+v", err)
-			return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("response not found:%d", responseID))
+			return newAPIError(http.StatusNotFound, openapi.ResponseNotFound, fmt.Sprintf("response not found:%d", responseID))
 		}
 		if err != nil {
 			c.Logger().Errorf("failed to check if you are a respondent: %+v", err)
@@ -290,8 +488,18 @@ func (m *Middleware) RespondentAuthenticate(next echo.HandlerFunc) echo.HandlerF
 			c.Logger().Error("respondent is nil")
 			return echo.NewHTTPError(http.StatusInternalServerError)
 		}
-		if respondent.UserTraqid != userID {
-			return c.String(http.StatusForbidden, "You are not a respondent of this response.")
+		if !respondent.IsRespondedBy(userID) {
+			isEditor := false
+			if allowEditor && !respondent.AnonymousKey.Valid {
+				isEditor, err = m.isQuestionnaireEditor(c, userID, respondent.QuestionnaireID)
+				if err != nil {
+					c.Logger().Errorf("failed to check if you are an editor: %+v", err)
+					return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check if you are an editor: %w", err))
+				}
+			}
+			if !isEditor {
+				return newAPIError(http.StatusForbidden, openapi.Forbidden, "You are not a respondent of this response.")
+			}
 		}
 
 		c.Set(responseIDKey, responseID)
@@ -300,8 +508,86 @@ func (m *Middleware) RespondentAuthenticate(next echo.HandlerFunc) echo.HandlerF
 	}
 }
 
+// ResponseEditorAuthenticate 回答のアンケートに対してeditor以上の権限を持つかの認証
+// 回答者であってもeditorでなければ通さない
+func (m *Middleware) ResponseEditorAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		userID, err := m.GetUserID(c)
+		if err != nil {
+			c.Logger().Errorf("failed to get userID: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+		}
+
+		strResponseID := c.Param("responseID")
+		responseID, err := strconv.Atoi(strResponseID)
+		if err != nil {
+			c.Logger().Infof("failed to convert responseID to int: %+v", err)
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid responseID:%s(error: %w)", strResponseID, err))
+		}
+
+		respondent, err := m.IRespondent.GetRespondent(c.Request().Context(), responseID)
+		if errors.Is(err, model.ErrRecordNotFound) {
+			c.Logger().Infof("response not found: %+v", err)
+			return newAPIError(http.StatusNotFound, openapi.ResponseNotFound, fmt.Sprintf("response not found:%d", responseID))
+		}
+		if err != nil {
+			c.Logger().Errorf("failed to get respondent: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get respondent: %w", err))
+		}
+		if respondent == nil {
+			c.Logger().Error("respondent is nil")
+			return echo.NewHTTPError(http.StatusInternalServerError)
+		}
+
+		isEditor, err := m.isQuestionnaireEditor(c, userID, respondent.QuestionnaireID)
+		if err != nil {
+			c.Logger().Errorf("failed to check if you are an editor: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check if you are an editor: %w", err))
+		}
+		if !isEditor {
+			return newAPIError(http.StatusForbidden, openapi.Forbidden, "You are not an editor of this questionnaire.")
+		}
+
+		c.Set(responseIDKey, responseID)
+
+		return next(c)
+	}
+}
+
+// isQuestionnaireEditor アンケートに対してeditor以上の権限を持つか
+// システム管理者はすべてのアンケートのオーナー権限を持つ
+func (m *Middleware) isQuestionnaireEditor(c echo.Context, userID string, questionnaireID int) (bool, error) {
+	userRole, err := m.IAdministrator.GetAdministratorRole(c.Request().Context(), userID, questionnaireID)
+	if err != nil && !errors.Is(err, model.ErrRecordNotFound) {
+		return false, fmt.Errorf("failed to get administrator role: %w", err)
+	}
+	if err == nil && userRole.Includes(model.AdministratorRoleEditor) {
+		return true, nil
+	}
+
+	isSystemAdmin, err := m.CheckSystemAdmin(c.Request().Context(), userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check system admin: %w", err)
+	}
+	if isSystemAdmin {
+		logSystemAdminAction(c, userID)
+	}
+
+	return isSystemAdmin, nil
+}
+
 // ResultAuthenticate アンケートの回答を確認できるかの認証
 func (m Middleware) ResultAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return m.resultAuthenticate(next, checkResponseReadPrivilege)
+}
+
+// ResultSummaryAuthenticate アンケートの回答の集計結果を確認できるかの認証
+// 集計結果のみを見せるアンケートでは、個々の回答を確認できなくても通す
+func (m Middleware) ResultSummaryAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return m.resultAuthenticate(next, checkResponseSummaryReadPrivilege)
+}
+
+func (m Middleware) resultAuthenticate(next echo.HandlerFunc, checkPrivilege func(*model.ResponseReadPrivilegeInfo) (bool, error)) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		userID, err := m.GetUserID(c)
 		if err != nil {
@@ -325,13 +611,13 @@ func (m Middleware) ResultAuthenticate(next echo.HandlerFunc) echo.HandlerFunc {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get response read privilege info: %w", err))
 		}
 
-		haveReadPrivilege, err := checkResponseReadPrivilege(responseReadPrivilegeInfo)
+		haveReadPrivilege, err := checkPrivilege(responseReadPrivilegeInfo)
 		if err != nil {
 			c.Logger().Errorf("failed to check response read privilege: %+v", err)
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check response read privilege: %w", err))
 		}
 		if !haveReadPrivilege {
-			return c.String(http.StatusForbidden, "You do not have permission to view this response.")
+			return newAPIError(http.StatusForbidden, openapi.Forbidden, "You do not have permission to view this response.")
 		}
 
 		return next(c)
@@ -361,15 +647,59 @@ func (m *Middleware) GetUserID(c echo.Context) (string, error) {
 	return userID, nil
 }
 
+// logSystemAdminAction システム管理者の権限で行われた操作を記録する
+func logSystemAdminAction(c echo.Context, userID string) {
+	c.Logger().Infof("system admin action: user=%s method=%s path=%s", userID, c.Request().Method, c.Request().URL.Path)
+}
+
+// checkResponseReadPrivilege 個々の回答を閲覧できるか
 func checkResponseReadPrivilege(responseReadPrivilegeInfo *model.ResponseReadPrivilegeInfo) (bool, error) {
+	haveReadPrivilege, err := checkResponseSummaryReadPrivilege(responseReadPrivilegeInfo)
+	if err != nil || !haveReadPrivilege {
+		return false, err
+	}
+
+	// 集計結果のみを見せるアンケートでは、運営以外は個々の回答を閲覧できない
+	if responseReadPrivilegeInfo.IsResponseAggregateOnly && !responseReadPrivilegeInfo.IsAdministrator {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// checkResponseSummaryReadPrivilege 回答の集計結果を閲覧できるか
+// 運営はどの設定でも閲覧できる
+func checkResponseSummaryReadPrivilege(responseReadPrivilegeInfo *model.ResponseReadPrivilegeInfo) (bool, error) {
+	var isShared bool
 	switch responseReadPrivilegeInfo.ResSharedTo {
 	case "administrators":
-		return responseReadPrivilegeInfo.IsAdministrator, nil
+		isShared = false
 	case "respondents":
-		return responseReadPrivilegeInfo.IsAdministrator || responseReadPrivilegeInfo.IsRespondent, nil
+		isShared = responseReadPrivilegeInfo.IsRespondent
+	case "targets":
+		isShared = responseReadPrivilegeInfo.IsTarget
+	case "viewers":
+		isShared = responseReadPrivilegeInfo.IsResponseViewer
 	case "public":
+		isShared = true
+	default:
+		return false, errors.New("invalid resSharedTo")
+	}
+
+	if responseReadPrivilegeInfo.IsAdministrator {
 		return true, nil
 	}
+	if !isShared {
+		return false, nil
+	}
+
+	// 回答期限が過ぎるまで結果を見せない場合、期限のないアンケートは締め切られるまで見せない
+	if responseReadPrivilegeInfo.IsResponseHiddenUntilDue {
+		resTimeLimit := responseReadPrivilegeInfo.ResTimeLimit
+		if !resTimeLimit.Valid || time.Now().Before(resTimeLimit.Time) {
+			return false, nil
+		}
+	}
 
-	return false, errors.New("invalid resSharedTo")
+	return true, nil
 }
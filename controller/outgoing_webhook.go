@@ -0,0 +1,405 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/openapi"
+	"gopkg.in/guregu/null.v4"
+)
+
+// OutgoingWebhook アンケートごとのWebhookの構造体
+// 送信は送信履歴に保存し、WebhookWorkerが送信・再送する
+type OutgoingWebhook struct {
+	model.IQuestionnaireWebhook
+	client         *http.Client
+	retryIntervals []time.Duration
+	pollInterval   time.Duration
+	wakeUpCh       chan struct{}
+	Wg             sync.WaitGroup
+}
+
+func NewOutgoingWebhook(questionnaireWebhook model.IQuestionnaireWebhook) *OutgoingWebhook {
+	return &OutgoingWebhook{
+		IQuestionnaireWebhook: questionnaireWebhook,
+		client:                newWebhookClient(),
+		retryIntervals:        webhookRetryIntervals,
+		pollInterval:          webhookPollInterval,
+		wakeUpCh:              make(chan struct{}, 1),
+	}
+}
+
+const (
+	MaxWebhookURLLength      = 500
+	MaxQuestionnaireWebhooks = 10
+	webhookDeliveriesLimit   = 100
+	webhookPendingLimit      = 100
+	webhookTimeout           = 10 * time.Second
+	webhookSignatureHeader   = "X-Anke-To-Signature"
+	webhookEventHeader       = "X-Anke-To-Event"
+	webhookDeliveryHeader    = "X-Anke-To-Delivery"
+	webhookUserAgent         = "anke-to-webhook"
+)
+
+const (
+	// webhookPollInterval 送信する時刻になった送信履歴を確認する間隔
+	webhookPollInterval = 15 * time.Second
+	// webhookLeaseDuration 送信を始めてから、送信が終わらなかったものとして再送するまでの時間
+	webhookLeaseDuration = 6 * webhookTimeout
+)
+
+// webhookRetryIntervals 送信に失敗したときの再送までの間隔
+var webhookRetryIntervals = []time.Duration{time.Minute, 5 * time.Minute, 30 * time.Minute}
+
+// webhookPayload Webhookで送信するJSON
+type webhookPayload struct {
+	Event           model.WebhookEvent `json:"event"`
+	QuestionnaireID int                `json:"questionnaire_id"`
+	OccurredAt      time.Time          `json:"occurred_at"`
+	ResponseID      *int               `json:"response_id,omitempty"`
+	Response        *openapi.Response  `json:"response,omitempty"`
+}
+
+func (w *OutgoingWebhook) GetQuestionnaireWebhooks(c echo.Context, questionnaireID int) ([]openapi.QuestionnaireWebhook, error) {
+	webhooks, err := w.IQuestionnaireWebhook.GetQuestionnaireWebhooks(c.Request().Context(), questionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get questionnaire webhooks: %+v", err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire webhooks")
+	}
+
+	res := make([]openapi.QuestionnaireWebhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		res = append(res, convertQuestionnaireWebhook(webhook))
+	}
+
+	return res, nil
+}
+
+func (w *OutgoingWebhook) PostQuestionnaireWebhook(c echo.Context, questionnaireID int, userID string, params openapi.PostQuestionnaireWebhookJSONRequestBody) (openapi.QuestionnaireWebhookWithSecret, error) {
+	webhookURL := strings.TrimSpace(params.Url)
+	parsedURL, err := url.Parse(webhookURL)
+	if err != nil || len(webhookURL) > MaxWebhookURLLength || parsedURL.Scheme != "https" || parsedURL.Host == "" {
+		c.Logger().Infof("invalid webhook url: %+v", params.Url)
+		return openapi.QuestionnaireWebhookWithSecret{}, echo.NewHTTPError(http.StatusBadRequest, "invalid webhook url")
+	}
+	// ホスト名の場合は送信時に接続先のアドレスを確認する
+	if addr, err := netip.ParseAddr(parsedURL.Hostname()); err == nil && isBlockedWebhookAddr(addr) {
+		c.Logger().Infof("blocked webhook url: %+v", params.Url)
+		return openapi.QuestionnaireWebhookWithSecret{}, echo.NewHTTPError(http.StatusBadRequest, "invalid webhook url")
+	}
+
+	if len(params.Events) == 0 {
+		c.Logger().Info("no webhook events")
+		return openapi.QuestionnaireWebhookWithSecret{}, echo.NewHTTPError(http.StatusBadRequest, "no webhook events")
+	}
+	events := make([]model.WebhookEvent, 0, len(params.Events))
+	for _, event := range params.Events {
+		events = append(events, model.WebhookEvent(event))
+	}
+
+	webhooks, err := w.IQuestionnaireWebhook.GetQuestionnaireWebhooks(c.Request().Context(), questionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get questionnaire webhooks: %+v", err)
+		return openapi.QuestionnaireWebhookWithSecret{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire webhooks")
+	}
+	if len(webhooks) >= MaxQuestionnaireWebhooks {
+		c.Logger().Infof("too many webhooks: questionnaire_id=%d", questionnaireID)
+		return openapi.QuestionnaireWebhookWithSecret{}, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("a questionnaire can have up to %d webhooks", MaxQuestionnaireWebhooks))
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		c.Logger().Errorf("failed to generate webhook secret: %+v", err)
+		return openapi.QuestionnaireWebhookWithSecret{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to generate webhook secret")
+	}
+
+	webhookID, err := w.InsertQuestionnaireWebhook(c.Request().Context(), questionnaireID, webhookURL, secret, events, userID)
+	if errors.Is(err, model.ErrInvalidWebhookEvent) {
+		c.Logger().Infof("invalid webhook events: %+v", params.Events)
+		return openapi.QuestionnaireWebhookWithSecret{}, echo.NewHTTPError(http.StatusBadRequest, "invalid webhook events")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to insert questionnaire webhook: %+v", err)
+		return openapi.QuestionnaireWebhookWithSecret{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to insert questionnaire webhook")
+	}
+
+	webhook, err := w.GetQuestionnaireWebhook(c.Request().Context(), questionnaireID, webhookID)
+	if err != nil {
+		c.Logger().Errorf("failed to get questionnaire webhook: %+v", err)
+		return openapi.QuestionnaireWebhookWithSecret{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire webhook")
+	}
+
+	res := convertQuestionnaireWebhook(*webhook)
+
+	return openapi.QuestionnaireWebhookWithSecret{
+		WebhookId: res.WebhookId,
+		Url:       res.Url,
+		Events:    res.Events,
+		CreatedBy: res.CreatedBy,
+		CreatedAt: res.CreatedAt,
+		Secret:    webhook.Secret,
+	}, nil
+}
+
+func (w *OutgoingWebhook) DeleteQuestionnaireWebhook(c echo.Context, questionnaireID int, webhookID int) error {
+	err := w.IQuestionnaireWebhook.DeleteQuestionnaireWebhook(c.Request().Context(), questionnaireID, webhookID)
+	if errors.Is(err, model.ErrNoRecordDeleted) {
+		return echo.NewHTTPError(http.StatusNotFound, "webhook not found")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to delete questionnaire webhook: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete questionnaire webhook")
+	}
+
+	return nil
+}
+
+func (w *OutgoingWebhook) GetQuestionnaireWebhookDeliveries(c echo.Context, questionnaireID int, webhookID int) ([]openapi.WebhookDelivery, error) {
+	_, err := w.GetQuestionnaireWebhook(c.Request().Context(), questionnaireID, webhookID)
+	if errors.Is(err, model.ErrRecordNotFound) {
+		return nil, echo.NewHTTPError(http.StatusNotFound, "webhook not found")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to get questionnaire webhook: %+v", err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire webhook")
+	}
+
+	deliveries, err := w.GetWebhookDeliveries(c.Request().Context(), webhookID, webhookDeliveriesLimit)
+	if err != nil {
+		c.Logger().Errorf("failed to get webhook deliveries: %+v", err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get webhook deliveries")
+	}
+
+	res := make([]openapi.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		res = append(res, convertWebhookDelivery(delivery))
+	}
+
+	return res, nil
+}
+
+// PublishResponseEvent 回答の提出・編集をWebhookで通知する
+func (w *OutgoingWebhook) PublishResponseEvent(c echo.Context, event model.WebhookEvent, response openapi.Response) {
+	responseID := response.ResponseId
+	w.publish(c, webhookPayload{
+		Event:           event,
+		QuestionnaireID: response.QuestionnaireId,
+		OccurredAt:      time.Now(),
+		ResponseID:      &responseID,
+		Response:        &response,
+	})
+}
+
+// PublishResponseDeletedEvent 回答の削除をWebhookで通知する
+func (w *OutgoingWebhook) PublishResponseDeletedEvent(c echo.Context, questionnaireID int, responseID int) {
+	w.publish(c, webhookPayload{
+		Event:           model.WebhookEventResponseDeleted,
+		QuestionnaireID: questionnaireID,
+		OccurredAt:      time.Now(),
+		ResponseID:      &responseID,
+	})
+}
+
+// PublishQuestionnaireClosedEvent アンケートの締め切りをWebhookで通知する
+func (w *OutgoingWebhook) PublishQuestionnaireClosedEvent(c echo.Context, questionnaireID int) {
+	w.publish(c, webhookPayload{
+		Event:           model.WebhookEventQuestionnaireClosed,
+		QuestionnaireID: questionnaireID,
+		OccurredAt:      time.Now(),
+	})
+}
+
+// publish イベントを購読しているWebhookへの送信履歴を作り、WebhookWorkerに送信させる
+// Webhookの送信に失敗しても元の操作は失敗させないため、エラーはログに残すのみ
+func (w *OutgoingWebhook) publish(c echo.Context, payload webhookPayload) {
+	ctx := c.Request().Context()
+
+	webhooks, err := w.IQuestionnaireWebhook.GetQuestionnaireWebhooks(ctx, payload.QuestionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get questionnaire webhooks: %+v", err)
+		return
+	}
+
+	var body []byte
+	for _, webhook := range webhooks {
+		if !webhook.HasEvent(payload.Event) {
+			continue
+		}
+
+		if body == nil {
+			body, err = json.Marshal(payload)
+			if err != nil {
+				c.Logger().Errorf("failed to marshal webhook payload: %+v", err)
+				return
+			}
+		}
+
+		_, err := w.InsertWebhookDelivery(ctx, webhook.ID, payload.Event, string(body))
+		if err != nil {
+			c.Logger().Errorf("failed to insert webhook delivery: %+v", err)
+			continue
+		}
+	}
+
+	if body != nil {
+		w.notifyWorker()
+	}
+}
+
+// WebhookWorker 送信する時刻になったWebhookを送信する
+// 送信履歴はDBに保存しているため、再起動前に送信できなかったものも送信する
+// ctxが終了すると新しい送信を止めて返る。送信中のWebhookはWgで待つ
+func (w *OutgoingWebhook) WebhookWorker(ctx context.Context) {
+	for {
+		w.deliverPending(ctx)
+
+		timer := time.NewTimer(w.pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-w.wakeUpCh:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func (w *OutgoingWebhook) notifyWorker() {
+	select {
+	case w.wakeUpCh <- struct{}{}:
+	default:
+	}
+}
+
+// deliverPending 送信する時刻になったWebhookの送信を始める
+func (w *OutgoingWebhook) deliverPending(ctx context.Context) {
+	deliveries, err := w.GetPendingWebhookDeliveries(ctx, time.Now(), webhookPendingLimit)
+	if err != nil {
+		log.Printf("failed to get pending webhook deliveries: %+v", err)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+
+		// 複数のインスタンスで動かしていても、1つのインスタンスからのみ送信する
+		claimed, err := w.ClaimWebhookDelivery(ctx, delivery.ID, delivery.Attempts, time.Now().Add(webhookLeaseDuration))
+		if err != nil {
+			log.Printf("failed to claim webhook delivery(delivery_id: %d): %+v", delivery.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		w.Wg.Add(1)
+		go func(delivery model.PendingWebhookDelivery) {
+			defer w.Wg.Done()
+			w.deliver(delivery, delivery.Attempts+1)
+		}(delivery)
+	}
+}
+
+// deliver Webhookを1回送信し、結果を送信履歴に保存する
+// 失敗した場合は、再送の回数が残っていれば再送の時刻を保存する
+func (w *OutgoingWebhook) deliver(delivery model.PendingWebhookDelivery, attempts int) {
+	statusCode, err := w.send(delivery, model.WebhookEvent(delivery.Event), []byte(delivery.Payload))
+
+	status := model.WebhookDeliveryStatusPending
+	errorMessage := ""
+	nextAttemptAt := null.Time{}
+	if err == nil {
+		status = model.WebhookDeliveryStatusSucceeded
+	} else {
+		errorMessage = err.Error()
+		if attempts > len(w.retryIntervals) {
+			status = model.WebhookDeliveryStatusFailed
+		} else {
+			nextAttemptAt = null.TimeFrom(time.Now().Add(w.retryIntervals[attempts-1]))
+		}
+	}
+
+	updateErr := w.UpdateWebhookDelivery(context.Background(), delivery.ID, status, attempts, statusCode, errorMessage, nextAttemptAt)
+	if updateErr != nil {
+		log.Printf("failed to update webhook delivery(delivery_id: %d): %+v", delivery.ID, updateErr)
+	}
+
+	if status == model.WebhookDeliveryStatusFailed {
+		log.Printf("failed to deliver webhook(webhook_id: %d, delivery_id: %d): %+v", delivery.WebhookID, delivery.ID, err)
+	}
+}
+
+// send Webhookを1回送信する
+func (w *OutgoingWebhook) send(delivery model.PendingWebhookDelivery, event model.WebhookEvent, body []byte) (null.Int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return null.Int{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	signature, err := calcHMACSHA256(delivery.Secret, body)
+	if err != nil {
+		return null.Int{}, err
+	}
+
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("User-Agent", webhookUserAgent)
+	req.Header.Set(webhookEventHeader, string(event))
+	req.Header.Set(webhookDeliveryHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(webhookSignatureHeader, "sha256="+signature)
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return null.Int{}, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer res.Body.Close()
+
+	statusCode := null.IntFrom(int64(res.StatusCode))
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return statusCode, fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+
+	return statusCode, nil
+}
+
+// generateWebhookSecret Webhookの署名の鍵を生成する
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func calcHMACSHA256(secret string, message []byte) (string, error) {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, err := mac.Write(message)
+	if err != nil {
+		return "", fmt.Errorf("failed to write message to mac: %w", err)
+	}
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+)
+
+// errBlockedWebhookAddress Webhookの送信先にできないアドレス
+var errBlockedWebhookAddress = errors.New("webhook address is not allowed")
+
+// blockedWebhookPrefixes ループバック・プライベート・リンクローカル以外で、Webhookの送信先にできないアドレスの範囲
+var blockedWebhookPrefixes = []netip.Prefix{
+	// 「このネットワーク」
+	netip.MustParsePrefix("0.0.0.0/8"),
+	// キャリアグレードNAT。一部のクラウドのメタデータサーバーを含む
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	// 予約済み。ブロードキャストアドレスを含む
+	netip.MustParsePrefix("240.0.0.0/4"),
+	// NAT64と6to4。IPv4のアドレスに変換されるため、内部のアドレスに届きうる
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// isBlockedWebhookAddr Webhookの送信先にできないアドレスか
+// 内部のサービスやクラウドのメタデータサーバー(169.254.169.254など)に署名付きのリクエストを送れないようにする
+func isBlockedWebhookAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() {
+		return true
+	}
+
+	for _, prefix := range blockedWebhookPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// webhookDialControl 接続する直前に接続先のアドレスを確認する
+// 名前解決の後に確認するため、URLの確認の後にDNSの応答を変えられても内部のアドレスには接続しない
+func webhookDialControl(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("failed to parse address: %w", err)
+	}
+
+	if isBlockedWebhookAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", errBlockedWebhookAddress, addrPort.Addr())
+	}
+
+	return nil
+}
+
+// newWebhookClient Webhookの送信に使うHTTPクライアント
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: webhookDialControl,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// プロキシを経由すると接続先のアドレスを確認できないため、環境変数のプロキシは使わない
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   webhookTimeout,
+		// リダイレクト先に署名付きのリクエストを送らないように、リダイレクトは失敗として扱う
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
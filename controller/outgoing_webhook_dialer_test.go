@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/model/mock_model"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIsBlockedWebhookAddr(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type test struct {
+		description string
+		addr        string
+		expect      bool
+	}
+
+	testCases := []test{
+		{description: "loopback", addr: "127.0.0.1", expect: true},
+		{description: "private(10/8)", addr: "10.0.0.1", expect: true},
+		{description: "private(172.16/12)", addr: "172.16.0.1", expect: true},
+		{description: "private(192.168/16)", addr: "192.168.1.1", expect: true},
+		{description: "metadata server", addr: "169.254.169.254", expect: true},
+		{description: "carrier-grade NAT", addr: "100.100.100.200", expect: true},
+		{description: "unspecified", addr: "0.0.0.0", expect: true},
+		{description: "broadcast", addr: "255.255.255.255", expect: true},
+		{description: "IPv6 loopback", addr: "::1", expect: true},
+		{description: "IPv6 link-local", addr: "fe80::1", expect: true},
+		{description: "IPv6 unique local", addr: "fd00:ec2::254", expect: true},
+		{description: "IPv4-mapped loopback", addr: "::ffff:127.0.0.1", expect: true},
+		{description: "NAT64", addr: "64:ff9b::a00:1", expect: true},
+		{description: "global IPv4", addr: "8.8.8.8", expect: false},
+		{description: "global IPv6", addr: "2001:4860:4860::8888", expect: false},
+	}
+
+	for _, testCase := range testCases {
+		assertion.Equal(testCase.expect, isBlockedWebhookAddr(netip.MustParseAddr(testCase.addr)), testCase.description)
+	}
+}
+
+func TestOutgoingWebhookBlocksInternalAddress(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuestionnaireWebhook := mock_model.NewMockIQuestionnaireWebhook(ctrl)
+
+	// 送信先はループバックアドレスで待ち受けている
+	server, received := newWebhookReceiver(t, http.StatusOK)
+
+	outgoingWebhook := NewOutgoingWebhook(mockQuestionnaireWebhook)
+
+	var errorMessage string
+	mockQuestionnaireWebhook.
+		EXPECT().
+		UpdateWebhookDelivery(gomock.Any(), 13, model.WebhookDeliveryStatusPending, 1, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, _ int, _ model.WebhookDeliveryStatus, _ int, _ interface{}, message string, _ interface{}) error {
+			errorMessage = message
+			return nil
+		})
+
+	outgoingWebhook.deliver(model.PendingWebhookDelivery{
+		WebhookDeliveries: model.WebhookDeliveries{
+			ID:        13,
+			WebhookID: 1,
+			Event:     string(model.WebhookEventQuestionnaireClosed),
+			Payload:   "{}",
+			Status:    model.WebhookDeliveryStatusPending,
+		},
+		URL:    strings.Replace(server.URL, "127.0.0.1", "localhost", 1),
+		Secret: "secret",
+	}, 1)
+
+	assertion.Empty(received())
+	assertion.Contains(errorMessage, errBlockedWebhookAddress.Error())
+}
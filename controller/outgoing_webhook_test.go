@@ -0,0 +1,417 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/model/mock_model"
+	"github.com/traPtitech/anke-to/openapi"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/guregu/null.v4"
+)
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+// newWebhookReceiver statusCodesの順にステータスコードを返すWebhookの送信先
+func newWebhookReceiver(t *testing.T, statusCodes ...int) (*httptest.Server, func() []receivedWebhook) {
+	t.Helper()
+
+	var mu sync.Mutex
+	received := []receivedWebhook{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		statusCode := statusCodes[min(len(received), len(statusCodes)-1)]
+		received = append(received, receivedWebhook{
+			header: r.Header.Clone(),
+			body:   body,
+		})
+		mu.Unlock()
+
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []receivedWebhook {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]receivedWebhook{}, received...)
+	}
+}
+
+func newWebhookTestContext() echo.Context {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec)
+}
+
+func TestOutgoingWebhookPublish(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuestionnaireWebhook := mock_model.NewMockIQuestionnaireWebhook(ctrl)
+
+	outgoingWebhook := NewOutgoingWebhook(mockQuestionnaireWebhook)
+
+	mockQuestionnaireWebhook.
+		EXPECT().
+		GetQuestionnaireWebhooks(gomock.Any(), 1).
+		Return([]model.QuestionnaireWebhooks{
+			{
+				ID:              1,
+				QuestionnaireID: 1,
+				URL:             "https://example.com/hook",
+				Secret:          "secret",
+				Events:          "response.submitted response.deleted",
+			},
+			{
+				ID:              2,
+				QuestionnaireID: 1,
+				URL:             "https://example.com/hook",
+				Secret:          "secret",
+				Events:          "questionnaire.closed",
+			},
+		}, nil)
+
+	var body string
+	mockQuestionnaireWebhook.
+		EXPECT().
+		InsertWebhookDelivery(gomock.Any(), 1, model.WebhookEventResponseDeleted, gomock.Any()).
+		DoAndReturn(func(_ interface{}, _ int, _ model.WebhookEvent, payload string) (int, error) {
+			body = payload
+			return 10, nil
+		})
+
+	outgoingWebhook.PublishResponseDeletedEvent(newWebhookTestContext(), 1, 100)
+
+	select {
+	case <-outgoingWebhook.wakeUpCh:
+	default:
+		t.Error("worker is not notified")
+	}
+
+	var payload map[string]interface{}
+	err := json.Unmarshal([]byte(body), &payload)
+	if assertion.NoError(err) {
+		assertion.Equal("response.deleted", payload["event"])
+		assertion.Equal(float64(1), payload["questionnaire_id"])
+		assertion.Equal(float64(100), payload["response_id"])
+		assertion.NotContains(payload, "response")
+	}
+}
+
+func TestOutgoingWebhookPublishResponse(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuestionnaireWebhook := mock_model.NewMockIQuestionnaireWebhook(ctrl)
+
+	outgoingWebhook := NewOutgoingWebhook(mockQuestionnaireWebhook)
+
+	mockQuestionnaireWebhook.
+		EXPECT().
+		GetQuestionnaireWebhooks(gomock.Any(), 1).
+		Return([]model.QuestionnaireWebhooks{
+			{
+				ID:              1,
+				QuestionnaireID: 1,
+				URL:             "https://example.com/hook",
+				Secret:          "secret",
+				Events:          "response.edited",
+			},
+		}, nil)
+
+	var body string
+	mockQuestionnaireWebhook.
+		EXPECT().
+		InsertWebhookDelivery(gomock.Any(), 1, model.WebhookEventResponseEdited, gomock.Any()).
+		DoAndReturn(func(_ interface{}, _ int, _ model.WebhookEvent, payload string) (int, error) {
+			body = payload
+			return 11, nil
+		})
+
+	outgoingWebhook.PublishResponseEvent(newWebhookTestContext(), model.WebhookEventResponseEdited, openapi.Response{
+		QuestionnaireId: 1,
+		ResponseId:      100,
+		IsAnonymous:     true,
+		Body:            []openapi.ResponseBody{},
+	})
+
+	var payload map[string]interface{}
+	err := json.Unmarshal([]byte(body), &payload)
+	if assertion.NoError(err) {
+		assertion.Equal("response.edited", payload["event"])
+		response, ok := payload["response"].(map[string]interface{})
+		if assertion.True(ok) {
+			assertion.Equal(float64(100), response["response_id"])
+			assertion.NotContains(response, "respondent")
+		}
+	}
+}
+
+func TestOutgoingWebhookDeliverPending(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuestionnaireWebhook := mock_model.NewMockIQuestionnaireWebhook(ctrl)
+
+	server, received := newWebhookReceiver(t, http.StatusOK)
+
+	outgoingWebhook := NewOutgoingWebhook(mockQuestionnaireWebhook)
+	outgoingWebhook.client = server.Client()
+
+	body := `{"event":"response.deleted","questionnaire_id":1}`
+	mockQuestionnaireWebhook.
+		EXPECT().
+		GetPendingWebhookDeliveries(gomock.Any(), gomock.Any(), webhookPendingLimit).
+		Return([]model.PendingWebhookDelivery{
+			{
+				WebhookDeliveries: model.WebhookDeliveries{
+					ID:        10,
+					WebhookID: 1,
+					Event:     string(model.WebhookEventResponseDeleted),
+					Payload:   body,
+					Status:    model.WebhookDeliveryStatusPending,
+				},
+				URL:    server.URL,
+				Secret: "secret",
+			},
+			{
+				WebhookDeliveries: model.WebhookDeliveries{
+					ID:        11,
+					WebhookID: 1,
+					Event:     string(model.WebhookEventResponseDeleted),
+					Payload:   body,
+					Status:    model.WebhookDeliveryStatusPending,
+				},
+				URL:    server.URL,
+				Secret: "secret",
+			},
+		}, nil)
+	mockQuestionnaireWebhook.
+		EXPECT().
+		ClaimWebhookDelivery(gomock.Any(), 10, 0, gomock.Any()).
+		Return(true, nil)
+	// 他のインスタンスが送信を始めたものは送信しない
+	mockQuestionnaireWebhook.
+		EXPECT().
+		ClaimWebhookDelivery(gomock.Any(), 11, 0, gomock.Any()).
+		Return(false, nil)
+	mockQuestionnaireWebhook.
+		EXPECT().
+		UpdateWebhookDelivery(gomock.Any(), 10, model.WebhookDeliveryStatusSucceeded, 1, null.IntFrom(http.StatusOK), "", null.Time{}).
+		Return(nil)
+
+	outgoingWebhook.deliverPending(context.Background())
+	outgoingWebhook.Wg.Wait()
+
+	webhooks := received()
+	if !assertion.Len(webhooks, 1) {
+		return
+	}
+
+	signature, err := calcHMACSHA256("secret", webhooks[0].body)
+	if err != nil {
+		t.Fatalf("failed to calc signature: %v", err)
+	}
+	assertion.Equal(body, string(webhooks[0].body))
+	assertion.Equal("sha256="+signature, webhooks[0].header.Get("X-Anke-To-Signature"))
+	assertion.Equal("response.deleted", webhooks[0].header.Get("X-Anke-To-Event"))
+	assertion.Equal("10", webhooks[0].header.Get("X-Anke-To-Delivery"))
+	assertion.Equal(echo.MIMEApplicationJSON, webhooks[0].header.Get(echo.HeaderContentType))
+}
+
+func TestOutgoingWebhookRetry(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		description        string
+		statusCode         int
+		attempts           int
+		expectStatus       model.WebhookDeliveryStatus
+		expectNextAttempt  bool
+		expectNextInterval time.Duration
+	}
+
+	testCases := []test{
+		{
+			description:  "成功",
+			statusCode:   http.StatusOK,
+			attempts:     1,
+			expectStatus: model.WebhookDeliveryStatusSucceeded,
+		},
+		{
+			description:        "1回目の失敗は1つ目の間隔で再送",
+			statusCode:         http.StatusInternalServerError,
+			attempts:           1,
+			expectStatus:       model.WebhookDeliveryStatusPending,
+			expectNextAttempt:  true,
+			expectNextInterval: time.Minute,
+		},
+		{
+			description:        "2回目の失敗は2つ目の間隔で再送",
+			statusCode:         http.StatusInternalServerError,
+			attempts:           2,
+			expectStatus:       model.WebhookDeliveryStatusPending,
+			expectNextAttempt:  true,
+			expectNextInterval: time.Hour,
+		},
+		{
+			description:  "再送しても失敗",
+			statusCode:   http.StatusInternalServerError,
+			attempts:     3,
+			expectStatus: model.WebhookDeliveryStatusFailed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+
+		mockQuestionnaireWebhook := mock_model.NewMockIQuestionnaireWebhook(ctrl)
+
+		server, received := newWebhookReceiver(t, testCase.statusCode)
+
+		outgoingWebhook := NewOutgoingWebhook(mockQuestionnaireWebhook)
+		outgoingWebhook.client = server.Client()
+		outgoingWebhook.retryIntervals = []time.Duration{time.Minute, time.Hour}
+
+		var nextAttemptAt null.Time
+		mockQuestionnaireWebhook.
+			EXPECT().
+			UpdateWebhookDelivery(gomock.Any(), 12, testCase.expectStatus, testCase.attempts, null.IntFrom(int64(testCase.statusCode)), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, _ int, _ model.WebhookDeliveryStatus, _ int, _ null.Int, _ string, next null.Time) error {
+				nextAttemptAt = next
+				return nil
+			})
+
+		outgoingWebhook.deliver(model.PendingWebhookDelivery{
+			WebhookDeliveries: model.WebhookDeliveries{
+				ID:        12,
+				WebhookID: 1,
+				Event:     string(model.WebhookEventQuestionnaireClosed),
+				Payload:   "{}",
+				Status:    model.WebhookDeliveryStatusPending,
+			},
+			URL:    server.URL,
+			Secret: "secret",
+		}, testCase.attempts)
+
+		assert.Len(t, received(), 1, testCase.description, "received")
+		if assert.Equal(t, testCase.expectNextAttempt, nextAttemptAt.Valid, testCase.description, "next attempt") && testCase.expectNextAttempt {
+			assert.WithinDuration(t, time.Now().Add(testCase.expectNextInterval), nextAttemptAt.Time, 5*time.Second, testCase.description, "next attempt")
+		}
+
+		ctrl.Finish()
+	}
+}
+
+func TestWebhookWorker(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuestionnaireWebhook := mock_model.NewMockIQuestionnaireWebhook(ctrl)
+
+	outgoingWebhook := NewOutgoingWebhook(mockQuestionnaireWebhook)
+	outgoingWebhook.pollInterval = time.Hour
+
+	// 起動時に確認し、通知を受けるともう一度確認する
+	checkedCh := make(chan struct{}, 2)
+	mockQuestionnaireWebhook.
+		EXPECT().
+		GetPendingWebhookDeliveries(gomock.Any(), gomock.Any(), webhookPendingLimit).
+		DoAndReturn(func(context.Context, time.Time, int) ([]model.PendingWebhookDelivery, error) {
+			checkedCh <- struct{}{}
+			return []model.PendingWebhookDelivery{}, nil
+		}).
+		Times(2)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	doneCh := make(chan struct{})
+	go func() {
+		outgoingWebhook.WebhookWorker(ctx)
+		close(doneCh)
+	}()
+
+	for i := range 2 {
+		select {
+		case <-checkedCh:
+		case <-time.After(time.Second):
+			t.Fatalf("pending deliveries were not checked(%d)", i)
+		}
+		if i == 0 {
+			outgoingWebhook.notifyWorker()
+		}
+	}
+
+	cancel()
+
+	select {
+	case <-doneCh:
+	case <-time.After(time.Second):
+		t.Fatal("webhook worker did not stop")
+	}
+}
+
+func TestPostQuestionnaireWebhookInvalidURL(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuestionnaireWebhook := mock_model.NewMockIQuestionnaireWebhook(ctrl)
+	outgoingWebhook := NewOutgoingWebhook(mockQuestionnaireWebhook)
+
+	for _, url := range []string{
+		"http://example.com/hook",
+		"example.com/hook",
+		"https://",
+		"https://example.com/" + strings.Repeat("a", MaxWebhookURLLength),
+		"https://127.0.0.1/hook",
+		"https://[::1]/hook",
+		"https://169.254.169.254/latest/meta-data",
+		"https://10.0.0.1:8443/hook",
+	} {
+		_, err := outgoingWebhook.PostQuestionnaireWebhook(newWebhookTestContext(), 1, "mazrean", openapi.PostQuestionnaireWebhookJSONRequestBody{
+			Url:    url,
+			Events: []openapi.WebhookEvent{openapi.ResponseSubmitted},
+		})
+
+		httpError, ok := err.(*echo.HTTPError)
+		if assert.True(t, ok, url) {
+			assert.Equal(t, http.StatusBadRequest, httpError.Code, url)
+		}
+	}
+}
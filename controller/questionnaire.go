@@ -10,7 +10,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/i18n"
 	"github.com/traPtitech/anke-to/model"
 	"github.com/traPtitech/anke-to/openapi"
 	"github.com/traPtitech/anke-to/traq"
@@ -32,6 +34,10 @@ type Questionnaire struct {
 	model.IValidation
 	model.ITransaction
 	model.IRespondent
+	model.ISearchIndex
+	model.ITag
+	model.ISystemAdmin
+	model.IResponseViewer
 	traq.IWebhook
 	*Response
 	*Reminder
@@ -51,6 +57,10 @@ func NewQuestionnaire(
 	validation model.IValidation,
 	transaction model.ITransaction,
 	respondent model.IRespondent,
+	searchIndex model.ISearchIndex,
+	tag model.ITag,
+	systemAdmin model.ISystemAdmin,
+	responseViewer model.IResponseViewer,
 	webhook traq.IWebhook,
 	response *Response,
 	reminder *Reminder,
@@ -69,6 +79,10 @@ func NewQuestionnaire(
 		IValidation:         validation,
 		ITransaction:        transaction,
 		IRespondent:         respondent,
+		ISearchIndex:        searchIndex,
+		ITag:                tag,
+		ISystemAdmin:        systemAdmin,
+		IResponseViewer:     responseViewer,
 		IWebhook:            webhook,
 		Response:            response,
 		Reminder:            reminder,
@@ -96,6 +110,7 @@ func formatNumberBound(value *float64) string {
 func (q *Questionnaire) GetQuestionnaires(ctx echo.Context, userID string, params openapi.GetQuestionnairesParams) (openapi.QuestionnaireList, error) {
 	res := openapi.QuestionnaireList{
 		Questionnaires: []openapi.QuestionnaireSummary{},
+		TagCounts:      []openapi.TagWithCount{},
 	}
 	var sort string
 	if params.Sort == nil {
@@ -109,6 +124,14 @@ func (q *Questionnaire) GetQuestionnaires(ctx echo.Context, userID string, param
 	} else {
 		search = string(*params.Search)
 	}
+	var keyword string
+	if params.Keyword != nil {
+		keyword = *params.Keyword
+	}
+	var tagIDs []int
+	if params.Tags != nil {
+		tagIDs = *params.Tags
+	}
 	var pageNum int
 	if params.Page == nil {
 		pageNum = 1
@@ -118,6 +141,16 @@ func (q *Questionnaire) GetQuestionnaires(ctx echo.Context, userID string, param
 	if pageNum < 1 {
 		pageNum = 1
 	}
+	var limit int
+	if params.Limit == nil {
+		limit = model.DefaultPageLimit
+	} else {
+		limit = *params.Limit
+	}
+	cursor, err := parseCursor(params.Cursor)
+	if err != nil {
+		return res, err
+	}
 
 	var onlyTargetingMe, onlyAdministratedByMe, notOverDue bool
 	if params.OnlyTargetingMe == nil {
@@ -153,12 +186,19 @@ func (q *Questionnaire) GetQuestionnaires(ctx echo.Context, userID string, param
 
 	countOnly := params.CountOnly != nil && *params.CountOnly
 
-	questionnaireList, totalRecords, pageMax, err := q.IQuestionnaire.GetQuestionnaires(ctx.Request().Context(), userID, sort, search, pageNum, onlyTargetingMe, onlyAdministratedByMe, notOverDue, hasMyResponse, hasMyDraft, isDraft, countOnly)
+	questionnaireList, totalRecords, pageMax, nextCursor, err := q.IQuestionnaire.GetQuestionnaires(ctx.Request().Context(), userID, sort, search, keyword, tagIDs, pageNum, limit, cursor, onlyTargetingMe, onlyAdministratedByMe, notOverDue, hasMyResponse, hasMyDraft, isDraft, countOnly)
 	if err != nil {
 		return res, err
 	}
 	res.PageMax = pageMax
 	res.TotalRecords = totalRecords
+	res.NextCursor = setNextPageLink(ctx, nextCursor)
+
+	tagCounts, err := q.GetQuestionnaireTagCounts(ctx.Request().Context(), userID, search, keyword, tagIDs, onlyTargetingMe, onlyAdministratedByMe, notOverDue, hasMyResponse, hasMyDraft, isDraft)
+	if err != nil {
+		return res, err
+	}
+	res.TagCounts = convertTagCounts(tagCounts)
 	if countOnly || len(questionnaireList) == 0 {
 		return res, nil
 	}
@@ -180,6 +220,10 @@ func (q *Questionnaire) GetQuestionnaires(ctx echo.Context, userID string, param
 	if err != nil {
 		return res, err
 	}
+	questionnaireTags, err := q.GetQuestionnaireTags(ctx.Request().Context(), questionnaireIDs)
+	if err != nil {
+		return res, err
+	}
 
 	targetsByQuestionnaireID := make(map[int][]model.Targets, len(questionnaireList))
 	for _, target := range targets {
@@ -193,6 +237,10 @@ func (q *Questionnaire) GetQuestionnaires(ctx echo.Context, userID string, param
 	for _, respondent := range myRespondents {
 		myRespondentsByQuestionnaireID[respondent.QuestionnaireID] = append(myRespondentsByQuestionnaireID[respondent.QuestionnaireID], respondent)
 	}
+	tagsByQuestionnaireID := make(map[int][]model.Tags, len(questionnaireList))
+	for _, questionnaireTag := range questionnaireTags {
+		tagsByQuestionnaireID[questionnaireTag.QuestionnaireID] = append(tagsByQuestionnaireID[questionnaireTag.QuestionnaireID], questionnaireTag.Tags)
+	}
 
 	for _, questionnaire := range questionnaireList {
 		allRespondend := len(targetsByQuestionnaireID[questionnaire.ID]) == 0 || isAllTargetsReponded(targetsByQuestionnaireID[questionnaire.ID], respondentsByQuestionnaireID[questionnaire.ID])
@@ -211,12 +259,15 @@ func (q *Questionnaire) GetQuestionnaires(ctx echo.Context, userID string, param
 			hasMyResponseForQuestionnaire = true
 		}
 
-		res.Questionnaires = append(res.Questionnaires, *questionnaireInfo2questionnaireSummary(questionnaire, allRespondend, hasMyDraftForQuestionnaire, hasMyResponseForQuestionnaire, respondendDateTimeByMe))
+		res.Questionnaires = append(res.Questionnaires, *questionnaireInfo2questionnaireSummary(questionnaire, allRespondend, hasMyDraftForQuestionnaire, hasMyResponseForQuestionnaire, respondendDateTimeByMe, tagsByQuestionnaireID[questionnaire.ID]))
 	}
 	return res, nil
 }
 
 func (q *Questionnaire) PostQuestionnaire(c echo.Context, params openapi.PostQuestionnaireJSONRequestBody) (openapi.QuestionnaireDetail, error) {
+	span := startSpan(c, "Questionnaire.PostQuestionnaire")
+	defer span.End()
+
 	responseDueDateTime := null.Time{}
 	if params.ResponseDueDateTime != nil {
 		responseDueDateTime.Valid = true
@@ -224,7 +275,7 @@ func (q *Questionnaire) PostQuestionnaire(c echo.Context, params openapi.PostQue
 	}
 	if responseDueDateTime.Valid && responseDueDateTime.Time.Before(time.Now()) {
 		c.Logger().Infof("invalid resTimeLimit: %+v", responseDueDateTime)
-		return openapi.QuestionnaireDetail{}, echo.NewHTTPError(http.StatusBadRequest, "invalid resTimeLimit")
+		return openapi.QuestionnaireDetail{}, newAPIError(http.StatusBadRequest, openapi.InvalidResTimeLimit, "invalid resTimeLimit")
 	}
 
 	questionnaireID := 0
@@ -232,7 +283,11 @@ func (q *Questionnaire) PostQuestionnaire(c echo.Context, params openapi.PostQue
 
 	if len(params.Title) == 0 || len(params.Title) > MaxTitleLength {
 		c.Logger().Infof("invalid title: %+v", params.Title)
-		return openapi.QuestionnaireDetail{}, echo.NewHTTPError(http.StatusBadRequest, "invalid title")
+		return openapi.QuestionnaireDetail{}, newAPIError(http.StatusBadRequest, openapi.InvalidTitle, "invalid title")
+	}
+	err = validateMessageTemplates(c, params.AnnouncementTemplate, params.ReminderTemplate)
+	if err != nil {
+		return openapi.QuestionnaireDetail{}, err
 	}
 
 	var notificationMessages []string
@@ -242,12 +297,12 @@ func (q *Questionnaire) PostQuestionnaire(c echo.Context, params openapi.PostQue
 			c.Logger().Errorf("failed to insert questionnaire: %+v", err)
 			return err
 		}
-		allTargetUsers, err := rollOutUsersAndGroups(params.Target.Users, params.Target.Groups)
+		allTargetUsers, err := rollOutUsersAndGroups(ctx, params.Target.Users, params.Target.Groups)
 		if err != nil {
 			c.Logger().Errorf("failed to roll out users and groups: %+v", err)
 			return err
 		}
-		targetGroupNames, err := uuid2GroupNames(params.Target.Groups)
+		targetGroupNames, err := uuid2GroupNames(ctx, params.Target.Groups)
 		if err != nil {
 			c.Logger().Errorf("failed to get group names: %+v", err)
 			return err
@@ -267,33 +322,34 @@ func (q *Questionnaire) PostQuestionnaire(c echo.Context, params openapi.PostQue
 			c.Logger().Errorf("failed to insert target groups: %+v", err)
 			return err
 		}
-		allAdminUsers, err := rollOutUsersAndGroups(params.Admin.Users, params.Admin.Groups)
+		allAdminUsers, adminGroupIDs, err := q.insertAdministrators(ctx, questionnaireID, params.Admin, params.Editor, params.Viewer)
 		if err != nil {
-			c.Logger().Errorf("failed to roll out administrators: %+v", err)
+			c.Logger().Errorf("failed to insert administrators: %+v", err)
 			return err
 		}
-		if len(allAdminUsers) == 0 {
-			c.Logger().Errorf("no administrators")
-			return errors.New("no administrators")
+		err = q.updateResponseVisibility(ctx, questionnaireID, model.Questionnaires{}, params.ResponseViewers, params.IsResponseHiddenUntilDue, params.IsResponseAggregateOnly)
+		if err != nil {
+			c.Logger().Errorf("failed to update response visibility: %+v", err)
+			return err
 		}
-		adminGroupNames, err := uuid2GroupNames(params.Admin.Groups)
+		err = q.updateQuizSettings(ctx, questionnaireID, model.Questionnaires{}, params.IsQuiz, params.IsQuizAnswerHiddenUntilDue)
 		if err != nil {
-			c.Logger().Errorf("failed to get group names: %+v", err)
+			c.Logger().Errorf("failed to update quiz settings: %+v", err)
 			return err
 		}
-		err = q.InsertAdministrators(ctx, questionnaireID, allAdminUsers)
+		err = q.updateLanguage(ctx, questionnaireID, params.Language)
 		if err != nil {
-			c.Logger().Errorf("failed to insert administrators: %+v", err)
+			c.Logger().Errorf("failed to update language: %+v", err)
 			return err
 		}
-		err = q.InsertAdministratorUsers(ctx, questionnaireID, params.Admin.Users)
+		err = q.updateMessageTemplates(ctx, questionnaireID, model.Questionnaires{}, params.AnnouncementTemplate, params.ReminderTemplate)
 		if err != nil {
-			c.Logger().Errorf("failed to insert administrator users: %+v", err)
+			c.Logger().Errorf("failed to update message templates: %+v", err)
 			return err
 		}
-		err = q.InsertAdministratorGroups(ctx, questionnaireID, params.Admin.Groups)
+		adminGroupNames, err := uuid2GroupNames(ctx, adminGroupIDs)
 		if err != nil {
-			c.Logger().Errorf("failed to insert administrator groups: %+v", err)
+			c.Logger().Errorf("failed to get group names: %+v", err)
 			return err
 		}
 		for questoinNum, question := range params.Questions {
@@ -340,6 +396,13 @@ func (q *Questionnaire) PostQuestionnaire(c echo.Context, params openapi.PostQue
 				c.Logger().Errorf("failed to insert question: %+v", err)
 				return err
 			}
+			if question.IsResponseHidden != nil && *question.IsResponseHidden {
+				err = q.UpdateQuestionIsResponseHidden(ctx, questionID, true)
+				if err != nil {
+					c.Logger().Errorf("failed to update is_response_hidden: %+v", err)
+					return err
+				}
+			}
 
 			// insert validations
 			switch questionType {
@@ -450,10 +513,32 @@ func (q *Questionnaire) PostQuestionnaire(c echo.Context, params openapi.PostQue
 					return errors.New("failed to insert validation")
 				}
 			}
+
+			err = q.updateQuizAnswer(ctx, questionID, questionType, question.Points, question)
+			if err != nil {
+				c.Logger().Errorf("failed to update quiz answer: %+v", err)
+				return err
+			}
+		}
+
+		if params.TagIds != nil {
+			err = q.SetQuestionnaireTags(ctx, questionnaireID, *params.TagIds)
+			if err != nil {
+				c.Logger().Errorf("failed to set questionnaire tags: %+v", err)
+				return err
+			}
+		}
+
+		err = q.UpdateSearchIndex(ctx, questionnaireID)
+		if err != nil {
+			c.Logger().Errorf("failed to update search index: %+v", err)
+			return err
 		}
 
 		if params.IsPublished {
-			notificationMessages = createQuestionnaireMessage(
+			notificationMessages, err = createAnnouncementMessages(
+				questionnaireLanguage(params.Language, i18n.DefaultLanguage),
+				messageTemplate(params.AnnouncementTemplate, null.String{}),
 				questionnaireID,
 				params.Title,
 				params.Description,
@@ -461,6 +546,10 @@ func (q *Questionnaire) PostQuestionnaire(c echo.Context, params openapi.PostQue
 				responseDueDateTime,
 				append(allTargetUsers, targetGroupNames...),
 			)
+			if err != nil {
+				c.Logger().Errorf("failed to create questionnaire messages: %+v", err)
+				return err
+			}
 		}
 
 		if params.ResponseDueDateTime != nil && params.IsPublished {
@@ -474,6 +563,12 @@ func (q *Questionnaire) PostQuestionnaire(c echo.Context, params openapi.PostQue
 
 		return nil
 	})
+	if errors.Is(err, model.ErrTagNotFound) {
+		return openapi.QuestionnaireDetail{}, newAPIError(http.StatusBadRequest, openapi.TagNotFound, "tag not found")
+	}
+	if errors.Is(err, errInvalidQuizAnswer) {
+		return openapi.QuestionnaireDetail{}, newAPIError(http.StatusBadRequest, openapi.InvalidQuizAnswer, err.Error())
+	}
 	if err != nil {
 		c.Logger().Errorf("failed to create a questionnaire: %+v", err)
 		return openapi.QuestionnaireDetail{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to create a questionnaire")
@@ -482,30 +577,62 @@ func (q *Questionnaire) PostQuestionnaire(c echo.Context, params openapi.PostQue
 	// Send traQ notifications after the DB transaction commits.
 	// Failures are only logged; the questionnaire creation itself is treated as successful.
 	for _, message := range notificationMessages {
-		if err := q.PostMessage(message); err != nil {
+		if err := q.PostMessage(c.Request().Context(), message); err != nil {
 			c.Logger().Errorf("failed to post questionnaire creation message (questionnaireID: %d): %+v", questionnaireID, err)
 		}
 	}
 
-	questionnaireInfo, targets, targetUsers, targetGroups, admins, adminUsers, adminGroups, respondents, err := q.GetQuestionnaireInfo(c.Request().Context(), questionnaireID)
+	questionnaireInfo, targets, targetUsers, targetGroups, admins, _, _, respondents, err := q.GetQuestionnaireInfo(c.Request().Context(), questionnaireID)
 	if err != nil {
 		c.Logger().Errorf("failed to get questionnaire info: %+v", err)
 		return openapi.QuestionnaireDetail{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire info")
 	}
+	adminUsers, adminGroups, err := q.getAdministratorsByRole(c.Request().Context(), questionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get administrators: %+v", err)
+		return openapi.QuestionnaireDetail{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get administrators")
+	}
+	tags, err := q.getQuestionnaireTags(c.Request().Context(), questionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get questionnaire tags: %+v", err)
+		return openapi.QuestionnaireDetail{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire tags")
+	}
+	responseViewerUsers, responseViewerGroups, err := q.GetResponseViewers(c.Request().Context(), questionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get response viewers: %+v", err)
+		return openapi.QuestionnaireDetail{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get response viewers")
+	}
 
-	questionnaireDetail, err := questionnaire2QuestionnaireDetail(*questionnaireInfo, admins, adminUsers, adminGroups, targets, targetUsers, targetGroups, respondents)
+	questionnaireDetail, err := questionnaire2QuestionnaireDetail(*questionnaireInfo, admins, adminUsers, adminGroups, targets, targetUsers, targetGroups, responseViewerUsers, responseViewerGroups, respondents, tags, true)
 	if err != nil {
 		c.Logger().Errorf("failed to convert questionnaire to questionnaire detail: %+v", err)
 		return openapi.QuestionnaireDetail{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to convert questionnaire to questionnaire detail")
 	}
 	return questionnaireDetail, nil
 }
-func (q *Questionnaire) GetQuestionnaire(ctx echo.Context, questionnaireID int) (openapi.QuestionnaireDetail, error) {
-	questionnaireInfo, targets, targetUsers, targetGroups, admins, adminUsers, adminGroups, respondents, err := q.GetQuestionnaireInfo(ctx.Request().Context(), questionnaireID)
+func (q *Questionnaire) GetQuestionnaire(ctx echo.Context, questionnaireID int, userID string) (openapi.QuestionnaireDetail, error) {
+	questionnaireInfo, targets, targetUsers, targetGroups, admins, _, _, respondents, err := q.GetQuestionnaireInfo(ctx.Request().Context(), questionnaireID)
 	if err != nil {
 		return openapi.QuestionnaireDetail{}, err
 	}
-	questionnaireDetail, err := questionnaire2QuestionnaireDetail(*questionnaireInfo, admins, adminUsers, adminGroups, targets, targetUsers, targetGroups, respondents)
+	adminUsers, adminGroups, err := q.getAdministratorsByRole(ctx.Request().Context(), questionnaireID)
+	if err != nil {
+		return openapi.QuestionnaireDetail{}, err
+	}
+	tags, err := q.getQuestionnaireTags(ctx.Request().Context(), questionnaireID)
+	if err != nil {
+		return openapi.QuestionnaireDetail{}, err
+	}
+	responseViewerUsers, responseViewerGroups, err := q.GetResponseViewers(ctx.Request().Context(), questionnaireID)
+	if err != nil {
+		return openapi.QuestionnaireDetail{}, err
+	}
+	showsQuizAnswers, err := q.canReadQuizAnswer(ctx.Request().Context(), questionnaireID, userID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to check quiz answer visibility: %+v", err)
+		return openapi.QuestionnaireDetail{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to check quiz answer visibility")
+	}
+	questionnaireDetail, err := questionnaire2QuestionnaireDetail(*questionnaireInfo, admins, adminUsers, adminGroups, targets, targetUsers, targetGroups, responseViewerUsers, responseViewerGroups, respondents, tags, showsQuizAnswers)
 	if err != nil {
 		ctx.Logger().Errorf("failed to convert questionnaire to questionnaire detail: %+v", err)
 		return openapi.QuestionnaireDetail{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to convert questionnaire to questionnaire detail")
@@ -513,16 +640,241 @@ func (q *Questionnaire) GetQuestionnaire(ctx echo.Context, questionnaireID int)
 	return questionnaireDetail, nil
 }
 
-func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, params openapi.EditQuestionnaireJSONRequestBody) error {
+func (q *Questionnaire) getQuestionnaireTags(ctx context.Context, questionnaireID int) ([]model.Tags, error) {
+	questionnaireTags, err := q.GetQuestionnaireTags(ctx, []int{questionnaireID})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]model.Tags, 0, len(questionnaireTags))
+	for _, questionnaireTag := range questionnaireTags {
+		tags = append(tags, questionnaireTag.Tags)
+	}
+	return tags, nil
+}
+
+// insertAdministrators 権限ごとの管理者を追加し、展開した管理者のtraQ IDと管理者のグループIDを返す
+// 複数の権限に含まれるユーザー・グループは、最も強い権限のみを持つ
+func (q *Questionnaire) insertAdministrators(ctx context.Context, questionnaireID int, owner openapi.UsersAndGroups, editor *openapi.UsersAndGroups, viewer *openapi.UsersAndGroups) ([]string, []uuid.UUID, error) {
+	adminsByRole := map[model.AdministratorRole]openapi.UsersAndGroups{
+		model.AdministratorRoleOwner: owner,
+	}
+	if editor != nil {
+		adminsByRole[model.AdministratorRoleEditor] = *editor
+	}
+	if viewer != nil {
+		adminsByRole[model.AdministratorRoleViewer] = *viewer
+	}
+
+	allAdminUsers := []string{}
+	adminGroupIDs := []uuid.UUID{}
+	insertedAdmins := map[string]struct{}{}
+	insertedAdminUsers := map[string]struct{}{}
+	insertedAdminGroups := map[uuid.UUID]struct{}{}
+	for _, role := range model.AdministratorRoles {
+		admins, ok := adminsByRole[role]
+		if !ok {
+			continue
+		}
+
+		rolledOutUsers, err := rollOutUsersAndGroups(ctx, admins.Users, admins.Groups)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to roll out administrators: %w", err)
+		}
+		roleAdmins := []string{}
+		for _, user := range rolledOutUsers {
+			if _, ok := insertedAdmins[user]; ok {
+				continue
+			}
+			insertedAdmins[user] = struct{}{}
+			roleAdmins = append(roleAdmins, user)
+		}
+		if role == model.AdministratorRoleOwner && len(roleAdmins) == 0 {
+			return nil, nil, errors.New("no administrators")
+		}
+		roleAdminUsers := []string{}
+		for _, user := range admins.Users {
+			if _, ok := insertedAdminUsers[user]; ok {
+				continue
+			}
+			insertedAdminUsers[user] = struct{}{}
+			roleAdminUsers = append(roleAdminUsers, user)
+		}
+		roleAdminGroups := []uuid.UUID{}
+		for _, group := range admins.Groups {
+			if _, ok := insertedAdminGroups[group]; ok {
+				continue
+			}
+			insertedAdminGroups[group] = struct{}{}
+			roleAdminGroups = append(roleAdminGroups, group)
+		}
+
+		err = q.InsertAdministrators(ctx, questionnaireID, roleAdmins, role)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to insert administrators: %w", err)
+		}
+		err = q.InsertAdministratorUsers(ctx, questionnaireID, roleAdminUsers, role)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to insert administrator users: %w", err)
+		}
+		err = q.InsertAdministratorGroups(ctx, questionnaireID, roleAdminGroups, role)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to insert administrator groups: %w", err)
+		}
+
+		allAdminUsers = append(allAdminUsers, roleAdmins...)
+		adminGroupIDs = append(adminGroupIDs, roleAdminGroups...)
+	}
+
+	return allAdminUsers, adminGroupIDs, nil
+}
+
+// checkAdministratorRole ユーザーがアンケートに対してrole以上の権限を持つか判定
+// システム管理者はすべてのアンケートのオーナー権限を持つ
+func (q *Questionnaire) checkAdministratorRole(c echo.Context, userID string, questionnaireID int, role model.AdministratorRole) (bool, error) {
+	userRole, err := q.GetAdministratorRole(c.Request().Context(), userID, questionnaireID)
+	if err != nil && !errors.Is(err, model.ErrRecordNotFound) {
+		return false, err
+	}
+	if err == nil && userRole.Includes(role) {
+		return true, nil
+	}
+
+	isSystemAdmin, err := q.CheckSystemAdmin(c.Request().Context(), userID)
+	if err != nil {
+		return false, err
+	}
+	if isSystemAdmin {
+		logSystemAdminAction(c, userID)
+	}
+
+	return isSystemAdmin, nil
+}
+
+// getAdministratorsByRole アンケートの権限ごとの管理者のユーザー・グループを取得
+func (q *Questionnaire) getAdministratorsByRole(ctx context.Context, questionnaireID int) ([]model.AdministratorUsers, []model.AdministratorGroups, error) {
+	adminUsers, err := q.GetAdministratorUsers(ctx, []int{questionnaireID})
+	if err != nil {
+		return nil, nil, err
+	}
+	adminGroups, err := q.GetAdministratorGroups(ctx, []int{questionnaireID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return adminUsers, adminGroups, nil
+}
+
+// updateResponseVisibility 結果を閲覧できるユーザー・グループと、結果の公開範囲の細かい設定を更新する
+// nilの設定はbeforeのまま変更しない
+func (q *Questionnaire) updateResponseVisibility(ctx context.Context, questionnaireID int, before model.Questionnaires, responseViewers *openapi.UsersAndGroups, isResponseHiddenUntilDue *bool, isResponseAggregateOnly *bool) error {
+	if responseViewers != nil {
+		err := q.DeleteResponseViewers(ctx, questionnaireID)
+		if err != nil {
+			return fmt.Errorf("failed to delete response viewers: %w", err)
+		}
+		allResponseViewers, err := rollOutUsersAndGroups(ctx, responseViewers.Users, responseViewers.Groups)
+		if err != nil {
+			return fmt.Errorf("failed to roll out users and groups: %w", err)
+		}
+		err = q.InsertResponseViewers(ctx, questionnaireID, responseViewers.Users, responseViewers.Groups, allResponseViewers)
+		if err != nil {
+			return fmt.Errorf("failed to insert response viewers: %w", err)
+		}
+	}
+
+	if isResponseHiddenUntilDue == nil && isResponseAggregateOnly == nil {
+		return nil
+	}
+	if isResponseHiddenUntilDue == nil {
+		isResponseHiddenUntilDue = &before.IsResponseHiddenUntilDue
+	}
+	if isResponseAggregateOnly == nil {
+		isResponseAggregateOnly = &before.IsResponseAggregateOnly
+	}
+	err := q.UpdateQuestionnaireResponseVisibility(ctx, questionnaireID, *isResponseHiddenUntilDue, *isResponseAggregateOnly)
+	if err != nil && !errors.Is(err, model.ErrNoRecordUpdated) {
+		return fmt.Errorf("failed to update questionnaire response visibility: %w", err)
+	}
+
+	return nil
+}
+
+// isResponseHiddenChanged 回答を見せない質問の設定が変更されるか
+func (q *Questionnaire) isResponseHiddenChanged(ctx context.Context, questionnaireID int, questions []openapi.Question) (bool, error) {
+	questionsBeforeEdit, err := q.GetQuestions(ctx, questionnaireID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get questions: %w", err)
+	}
+	isResponseHiddenBeforeEdit := make(map[int]bool, len(questionsBeforeEdit))
+	for _, question := range questionsBeforeEdit {
+		isResponseHiddenBeforeEdit[question.ID] = question.IsResponseHidden
+	}
+
+	for _, question := range questions {
+		isResponseHidden := question.IsResponseHidden != nil && *question.IsResponseHidden
+		if question.QuestionId == nil {
+			if isResponseHidden {
+				return true, nil
+			}
+			continue
+		}
+		if isResponseHiddenBeforeEdit[*question.QuestionId] != isResponseHidden {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, params openapi.EditQuestionnaireJSONRequestBody, userID string) error {
+	span := startSpan(c, "Questionnaire.EditQuestionnaire")
+	defer span.End()
+
+	if params.Admin == nil && (params.Editor != nil || params.Viewer != nil) {
+		c.Logger().Info("editor and viewer must be specified with admin")
+		return echo.NewHTTPError(http.StatusBadRequest, "editor and viewer must be specified with admin")
+	}
+	if params.Admin != nil {
+		// 管理者の変更はオーナーのみができる
+		canManage, err := q.checkAdministratorRole(c, userID, questionnaireID, model.AdministratorRoleOwner)
+		if err != nil {
+			c.Logger().Errorf("failed to check administrator role: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check administrator role")
+		}
+		if !canManage {
+			c.Logger().Info("only owners can change administrators")
+			return echo.NewHTTPError(http.StatusForbidden, "only owners can change administrators")
+		}
+	}
+
 	questionnaireBeforeEdit, targetsBeforeEdit, _, targetGroupsBeforeEdit, adminsBeforeEdit, _, adminGroupsBeforeEdit, _, err := q.GetQuestionnaireInfo(c.Request().Context(), questionnaireID)
 	if err != nil {
 		if errors.Is(err, model.ErrRecordNotFound) {
-			return echo.NewHTTPError(http.StatusNotFound, "questionnaire not found")
+			return newAPIError(http.StatusNotFound, openapi.QuestionnaireNotFound, "questionnaire not found")
 		}
 		c.Logger().Errorf("failed to get questionnaire info before edit: %+v", err)
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get questionnaire info before edit")
 	}
 
+	// 回答を見せない質問はオーナー以外の管理者からも隠すため、変更はオーナーのみができる
+	isResponseHiddenChanged, err := q.isResponseHiddenChanged(c.Request().Context(), questionnaireID, params.Questions)
+	if err != nil {
+		c.Logger().Errorf("failed to check is_response_hidden: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check is_response_hidden")
+	}
+	if isResponseHiddenChanged {
+		isOwner, err := q.checkAdministratorRole(c, userID, questionnaireID, model.AdministratorRoleOwner)
+		if err != nil {
+			c.Logger().Errorf("failed to check administrator role: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to check administrator role")
+		}
+		if !isOwner {
+			c.Logger().Info("only owners can change hidden questions")
+			return echo.NewHTTPError(http.StatusForbidden, "only owners can change hidden questions")
+		}
+	}
+
 	// unable to change the questionnaire from anonymous to non-anonymous
 	isAnonymous, err := q.GetResponseIsAnonymousByQuestionnaireID(c.Request().Context(), questionnaireID)
 	if err != nil {
@@ -541,12 +893,16 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 	}
 	if responseDueDateTime.Valid && responseDueDateTime.Time.Before(time.Now()) {
 		c.Logger().Infof("invalid resTimeLimit: %+v", responseDueDateTime)
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid resTimeLimit")
+		return newAPIError(http.StatusBadRequest, openapi.InvalidResTimeLimit, "invalid resTimeLimit")
 	}
 
 	if len(params.Title) == 0 || len(params.Title) > MaxTitleLength {
 		c.Logger().Infof("invalid title: %+v", params.Title)
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid title")
+		return newAPIError(http.StatusBadRequest, openapi.InvalidTitle, "invalid title")
+	}
+	err = validateMessageTemplates(c, params.AnnouncementTemplate, params.ReminderTemplate)
+	if err != nil {
+		return err
 	}
 
 	var notificationMessages []string
@@ -561,6 +917,14 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 			c.Logger().Errorf("failed to update questionnaire: %+v", err)
 			return err
 		}
+		if !isAnonymous && params.IsAnonymous {
+			// 匿名にする前の回答も、ユーザーと結びつかないようにする
+			err = q.AnonymizeRespondents(ctx, questionnaireID)
+			if err != nil {
+				c.Logger().Errorf("failed to anonymize respondents: %+v", err)
+				return err
+			}
+		}
 		if params.Target != nil {
 			err = q.DeleteTargets(ctx, questionnaireID)
 			if err != nil {
@@ -577,7 +941,7 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 				c.Logger().Errorf("failed to delete target groups: %+v", err)
 				return err
 			}
-			allTargetUsers, err = rollOutUsersAndGroups((*params.Target).Users, params.Target.Groups)
+			allTargetUsers, err = rollOutUsersAndGroups(ctx, (*params.Target).Users, params.Target.Groups)
 			if err != nil {
 				c.Logger().Errorf("failed to roll out users and groups: %+v", err)
 				return err
@@ -615,31 +979,31 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 				c.Logger().Errorf("failed to delete administrator groups: %+v", err)
 				return err
 			}
-			allAdminUsers, err = rollOutUsersAndGroups(params.Admin.Users, params.Admin.Groups)
-			if err != nil {
-				c.Logger().Errorf("failed to roll out administrators: %+v", err)
-				return err
-			}
-			if len(allAdminUsers) == 0 {
-				c.Logger().Errorf("no administrators")
-				return errors.New("no administrators")
-			}
-			adminGroupIDs = params.Admin.Groups
-			err = q.InsertAdministrators(ctx, questionnaireID, allAdminUsers)
+			allAdminUsers, adminGroupIDs, err = q.insertAdministrators(ctx, questionnaireID, *params.Admin, params.Editor, params.Viewer)
 			if err != nil {
 				c.Logger().Errorf("failed to insert administrators: %+v", err)
 				return err
 			}
-			err = q.InsertAdministratorUsers(ctx, questionnaireID, params.Admin.Users)
-			if err != nil {
-				c.Logger().Errorf("failed to insert administrator users: %+v", err)
-				return err
-			}
-			err = q.InsertAdministratorGroups(ctx, questionnaireID, params.Admin.Groups)
-			if err != nil {
-				c.Logger().Errorf("failed to insert administrator groups: %+v", err)
-				return err
-			}
+		}
+		err = q.updateResponseVisibility(ctx, questionnaireID, *questionnaireBeforeEdit, params.ResponseViewers, params.IsResponseHiddenUntilDue, params.IsResponseAggregateOnly)
+		if err != nil {
+			c.Logger().Errorf("failed to update response visibility: %+v", err)
+			return err
+		}
+		err = q.updateQuizSettings(ctx, questionnaireID, *questionnaireBeforeEdit, params.IsQuiz, params.IsQuizAnswerHiddenUntilDue)
+		if err != nil {
+			c.Logger().Errorf("failed to update quiz settings: %+v", err)
+			return err
+		}
+		err = q.updateLanguage(ctx, questionnaireID, params.Language)
+		if err != nil {
+			c.Logger().Errorf("failed to update language: %+v", err)
+			return err
+		}
+		err = q.updateMessageTemplates(ctx, questionnaireID, *questionnaireBeforeEdit, params.AnnouncementTemplate, params.ReminderTemplate)
+		if err != nil {
+			c.Logger().Errorf("failed to update message templates: %+v", err)
+			return err
 		}
 
 		var ifQuestionExist = make(map[int]bool)
@@ -689,6 +1053,13 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 					return err
 				}
 				ifQuestionExist[questionID] = true
+				if question.IsResponseHidden != nil && *question.IsResponseHidden {
+					err = q.UpdateQuestionIsResponseHidden(ctx, questionID, true)
+					if err != nil {
+						c.Logger().Errorf("failed to update is_response_hidden: %+v", err)
+						return err
+					}
+				}
 				// insert validations
 				switch questionType {
 				case "MultipleChoice":
@@ -798,6 +1169,12 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 						return errors.New("failed to insert validation")
 					}
 				}
+
+				err = q.updateQuizAnswer(ctx, questionID, questionType, question.Points, question)
+				if err != nil {
+					c.Logger().Errorf("failed to update quiz answer: %+v", err)
+					return err
+				}
 			} else {
 				ifQuestionExist[*question.QuestionId] = true
 				err = q.UpdateQuestion(ctx, questionnaireID, 1, questoinNum+1, questionType, question.Title, question.Description, question.IsRequired, *question.QuestionId)
@@ -805,6 +1182,11 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 					c.Logger().Errorf("failed to update question: %+v", err)
 					return err
 				}
+				err = q.UpdateQuestionIsResponseHidden(ctx, *question.QuestionId, question.IsResponseHidden != nil && *question.IsResponseHidden)
+				if err != nil && !errors.Is(err, model.ErrNoRecordUpdated) {
+					c.Logger().Errorf("failed to update is_response_hidden: %+v", err)
+					return err
+				}
 				// update validations
 				switch questionType {
 				case "MultipleChoice":
@@ -910,6 +1292,12 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 						return errors.New("failed to insert validation")
 					}
 				}
+
+				err = q.updateQuizAnswer(ctx, *question.QuestionId, questionType, question.Points, question)
+				if err != nil {
+					c.Logger().Errorf("failed to update quiz answer: %+v", err)
+					return err
+				}
 			}
 		}
 		questions, err := q.IQuestion.GetQuestions(ctx, questionnaireID)
@@ -927,6 +1315,19 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 			}
 		}
 
+		// 正解や配点が変わっているかもしれないので、提出済みの回答を採点し直す
+		isQuiz := questionnaireBeforeEdit.IsQuiz
+		if params.IsQuiz != nil {
+			isQuiz = *params.IsQuiz
+		}
+		if isQuiz || questionnaireBeforeEdit.IsQuiz {
+			err = q.rescoreResponses(ctx, questionnaireID, isQuiz)
+			if err != nil {
+				c.Logger().Errorf("failed to rescore responses: %+v", err)
+				return err
+			}
+		}
+
 		err = q.DeleteReminder(questionnaireID)
 		if err != nil {
 			c.Logger().Errorf("failed to delete reminder: %+v", err)
@@ -941,18 +1342,34 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 			}
 		}
 
+		if params.TagIds != nil {
+			err = q.SetQuestionnaireTags(ctx, questionnaireID, *params.TagIds)
+			if err != nil {
+				c.Logger().Errorf("failed to set questionnaire tags: %+v", err)
+				return err
+			}
+		}
+
+		err = q.UpdateSearchIndex(ctx, questionnaireID)
+		if err != nil {
+			c.Logger().Errorf("failed to update search index: %+v", err)
+			return err
+		}
+
 		if !questionnaireBeforeEdit.IsPublished && params.IsPublished {
-			targetGroupNames, err := uuid2GroupNames(targetGroupIDs)
+			targetGroupNames, err := uuid2GroupNames(ctx, targetGroupIDs)
 			if err != nil {
 				c.Logger().Errorf("failed to get target group names: %+v", err)
 				return err
 			}
-			adminGroupNames, err := uuid2GroupNames(adminGroupIDs)
+			adminGroupNames, err := uuid2GroupNames(ctx, adminGroupIDs)
 			if err != nil {
 				c.Logger().Errorf("failed to get admin group names: %+v", err)
 				return err
 			}
-			notificationMessages = createQuestionnaireMessage(
+			notificationMessages, err = createAnnouncementMessages(
+				questionnaireLanguage(params.Language, i18n.ParseLanguage(questionnaireBeforeEdit.Language)),
+				messageTemplate(params.AnnouncementTemplate, questionnaireBeforeEdit.AnnouncementTemplate),
 				questionnaireID,
 				params.Title,
 				params.Description,
@@ -960,17 +1377,27 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 				responseDueDateTime,
 				append(allTargetUsers, targetGroupNames...),
 			)
+			if err != nil {
+				c.Logger().Errorf("failed to create questionnaire messages: %+v", err)
+				return err
+			}
 		}
 
 		return nil
 	})
+	if errors.Is(err, model.ErrTagNotFound) {
+		return newAPIError(http.StatusBadRequest, openapi.TagNotFound, "tag not found")
+	}
+	if errors.Is(err, errInvalidQuizAnswer) {
+		return newAPIError(http.StatusBadRequest, openapi.InvalidQuizAnswer, err.Error())
+	}
 	if err != nil {
 		c.Logger().Errorf("failed to update a questionnaire: %+v", err)
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update a questionnaire")
 	}
 
 	for _, message := range notificationMessages {
-		if err := q.PostMessage(message); err != nil {
+		if err := q.PostMessage(c.Request().Context(), message); err != nil {
 			c.Logger().Errorf("failed to post questionnaire publication message (questionnaireID: %d): %+v", questionnaireID, err)
 		}
 	}
@@ -980,7 +1407,7 @@ func (q *Questionnaire) EditQuestionnaire(c echo.Context, questionnaireID int, p
 
 func (q *Questionnaire) DeleteQuestionnaire(c echo.Context, questionnaireID int) error {
 	err := q.ITransaction.Do(c.Request().Context(), nil, func(ctx context.Context) error {
-		respondentDetails, err := q.GetRespondentDetails(ctx, questionnaireID, "", false, "", nil)
+		respondentDetails, _, err := q.GetRespondentDetails(ctx, questionnaireID, "", false, "", nil, nil, 0, nil)
 		if err != nil {
 			c.Logger().Errorf("failed to get respondent details: %+v", err)
 			return err
@@ -992,6 +1419,12 @@ func (q *Questionnaire) DeleteQuestionnaire(c echo.Context, questionnaireID int)
 			return err
 		}
 
+		err = q.DeleteSearchIndex(ctx, questionnaireID)
+		if err != nil {
+			c.Logger().Errorf("failed to delete search index: %+v", err)
+			return err
+		}
+
 		err = q.DeleteTargets(ctx, questionnaireID)
 		if err != nil {
 			c.Logger().Errorf("failed to delete targets: %+v", err)
@@ -1114,7 +1547,7 @@ func (q *Questionnaire) CloseQuestionnaire(c echo.Context, questionnaireID int)
 	})
 	if err != nil {
 		if errors.Is(err, model.ErrNoRecordUpdated) {
-			return echo.NewHTTPError(http.StatusNotFound, "questionnaire not found")
+			return newAPIError(http.StatusNotFound, openapi.QuestionnaireNotFound, "questionnaire not found")
 		}
 		c.Logger().Errorf("failed to close questionnaire: %+v", err)
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to close questionnaire")
@@ -1122,6 +1555,9 @@ func (q *Questionnaire) CloseQuestionnaire(c echo.Context, questionnaireID int)
 	if err := q.DeleteReminder(questionnaireID); err != nil {
 		c.Logger().Errorf("failed to delete reminder: %+v", err)
 	}
+
+	q.PublishQuestionnaireClosedEvent(c, questionnaireID)
+
 	return nil
 }
 
@@ -1129,7 +1565,7 @@ func (q *Questionnaire) GetQuestionnaireMyRemindStatus(c echo.Context, questionn
 	_, _, _, _, _, _, _, _, err := q.GetQuestionnaireInfo(c.Request().Context(), questionnaireID)
 	if err != nil {
 		if errors.Is(err, model.ErrRecordNotFound) {
-			return false, echo.NewHTTPError(http.StatusNotFound, "questionnaire not found")
+			return false, newAPIError(http.StatusNotFound, openapi.QuestionnaireNotFound, "questionnaire not found")
 		}
 		c.Logger().Errorf("failed to get questionnaire info: %+v", err)
 		return false, echo.NewHTTPError(http.StatusInternalServerError, "failed to check remind status")
@@ -1160,7 +1596,7 @@ func (q *Questionnaire) EditQuestionnaireMyRemindStatus(c echo.Context, question
 	_, _, _, _, _, _, _, _, err := q.GetQuestionnaireInfo(c.Request().Context(), questionnaireID)
 	if err != nil {
 		if errors.Is(err, model.ErrRecordNotFound) {
-			return echo.NewHTTPError(http.StatusNotFound, "questionnaire not found")
+			return newAPIError(http.StatusNotFound, openapi.QuestionnaireNotFound, "questionnaire not found")
 		}
 		c.Logger().Errorf("failed to get questionnaire info: %+v", err)
 		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update remind status")
@@ -1198,27 +1634,135 @@ func (q *Questionnaire) GetQuestionnaireResponses(c echo.Context, questionnaireI
 		submittedOnly := false
 		isDraft = &submittedOnly
 	}
-	respondentDetails, err := q.GetRespondentDetails(c.Request().Context(), questionnaireID, sort, onlyMyResponse, userID, isDraft)
+	// limitとcursorのどちらも指定されていない場合はすべて取得する
+	var limit int
+	if params.Limit != nil {
+		limit = *params.Limit
+	} else if params.Cursor != nil {
+		limit = model.DefaultPageLimit
+	}
+	cursor, err := parseCursor(params.Cursor)
+	if err != nil {
+		c.Logger().Infof("invalid cursor: %+v", err)
+		return res, newAPIError(http.StatusBadRequest, openapi.InvalidCursor, "invalid cursor")
+	}
+
+	isAdministrator, err := q.isQuestionnaireAdministrator(c.Request().Context(), questionnaireID, userID)
+	if err != nil {
+		c.Logger().Errorf("failed to check administrator: %+v", err)
+		return res, echo.NewHTTPError(http.StatusInternalServerError, "failed to check administrator")
+	}
+	var reviewStatus *model.ReviewStatus
+	if params.ReviewStatus != nil {
+		status := model.ReviewStatus(*params.ReviewStatus)
+		if !status.IsValid() {
+			c.Logger().Infof("invalid review status: %s", status)
+			return res, newAPIError(http.StatusBadRequest, openapi.InvalidReviewStatus, "invalid review status")
+		}
+		// 他の人の回答の審査状況は運営にしか見せないので、絞り込みも運営のみができる
+		if !onlyMyResponse && !isAdministrator {
+			c.Logger().Info("only administrators can filter responses by review status")
+			return res, echo.NewHTTPError(http.StatusForbidden, "only administrators can filter responses by review status")
+		}
+		reviewStatus = &status
+	}
+
+	canReadScore, err := q.canReadQuizAnswer(c.Request().Context(), questionnaireID, userID)
+	if err != nil {
+		c.Logger().Errorf("failed to check quiz answer visibility: %+v", err)
+		return res, echo.NewHTTPError(http.StatusInternalServerError, "failed to check quiz answer visibility")
+	}
+
+	respondentDetails, nextCursor, err := q.GetRespondentDetails(c.Request().Context(), questionnaireID, sort, onlyMyResponse, userID, isDraft, reviewStatus, limit, cursor)
 	if err != nil {
 		if errors.Is(err, model.ErrRecordNotFound) {
 			return res, echo.NewHTTPError(http.StatusNotFound, "respondent not found")
 		}
+		if errors.Is(err, model.ErrInvalidCursor) || errors.Is(err, model.ErrInvalidSortParam) {
+			c.Logger().Infof("invalid pagination params: %+v", err)
+			return res, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		c.Logger().Errorf("failed to get respondent details: %+v", err)
 		return res, echo.NewHTTPError(http.StatusInternalServerError, "failed to get respondent details")
 	}
 
+	hiddenQuestionIDs := map[int]struct{}{}
+	if !onlyMyResponse {
+		hiddenQuestionIDs, err = q.getHiddenQuestionIDs(c.Request().Context(), questionnaireID, userID)
+		if err != nil {
+			c.Logger().Errorf("failed to get hidden question ids: %+v", err)
+			return res, echo.NewHTTPError(http.StatusInternalServerError, "failed to get hidden question ids")
+		}
+	}
+
 	for _, respondentDetail := range respondentDetails {
 		response, err := respondentDetail2Response(c, respondentDetail)
 		if err != nil {
 			c.Logger().Errorf("failed to convert respondent detail to response: %+v", err)
 			return res, echo.NewHTTPError(http.StatusInternalServerError, "failed to convert respondent detail to response")
 		}
+		// 自分の回答は回答を見せない質問や審査状況も含めて返す
+		if !respondentDetail.IsRespondedBy(userID) {
+			response = hideResponseBodies(response, hiddenQuestionIDs)
+			if !isAdministrator {
+				response.Review = nil
+				response.Score = nil
+			}
+		} else if !canReadScore {
+			response.Score = nil
+		}
 		res = append(res, response)
 	}
+	setNextPageLink(c, nextCursor)
 
 	return res, nil
 }
 
+// GetQuestionnaireResponsesSummary アンケートの提出済みの回答を質問ごとに集計する
+// 回答を見せない質問は、オーナー以外には件数も返さない
+func (q *Questionnaire) GetQuestionnaireResponsesSummary(c echo.Context, questionnaireID int, userID string) (openapi.ResponsesSummary, error) {
+	ctx := c.Request().Context()
+
+	submittedOnly := false
+	respondentDetails, _, err := q.GetRespondentDetails(ctx, questionnaireID, "", false, userID, &submittedOnly, nil, 0, nil)
+	if err != nil {
+		if errors.Is(err, model.ErrRecordNotFound) {
+			return openapi.ResponsesSummary{}, echo.NewHTTPError(http.StatusNotFound, "respondent not found")
+		}
+		c.Logger().Errorf("failed to get respondent details: %+v", err)
+		return openapi.ResponsesSummary{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get respondent details")
+	}
+
+	questions, err := q.GetQuestions(ctx, questionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get questions: %+v", err)
+		return openapi.ResponsesSummary{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get questions")
+	}
+	questionIDs := make([]int, 0, len(questions))
+	for _, question := range questions {
+		questionIDs = append(questionIDs, question.ID)
+	}
+	options, err := q.GetOptions(ctx, questionIDs)
+	if err != nil {
+		c.Logger().Errorf("failed to get options: %+v", err)
+		return openapi.ResponsesSummary{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get options")
+	}
+
+	hiddenQuestionIDs, err := q.getHiddenQuestionIDs(ctx, questionnaireID, userID)
+	if err != nil {
+		c.Logger().Errorf("failed to get hidden question ids: %+v", err)
+		return openapi.ResponsesSummary{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get hidden question ids")
+	}
+
+	summary, err := respondentDetails2ResponsesSummary(questionnaireID, questions, options, respondentDetails, hiddenQuestionIDs)
+	if err != nil {
+		c.Logger().Errorf("failed to summarize responses: %+v", err)
+		return openapi.ResponsesSummary{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to summarize responses")
+	}
+
+	return summary, nil
+}
+
 func (q *Questionnaire) PostQuestionnaireResponse(c echo.Context, questionnaireID int, params openapi.PostQuestionnaireResponseJSONRequestBody, userID string) (openapi.Response, error) {
 	res := openapi.Response{}
 
@@ -1226,16 +1770,39 @@ func (q *Questionnaire) PostQuestionnaireResponse(c echo.Context, questionnaireI
 	if err != nil {
 		if errors.Is(err, model.ErrRecordNotFound) {
 			c.Logger().Info("questionnaire not found")
-			return res, echo.NewHTTPError(http.StatusNotFound, err)
+			return res, newAPIError(http.StatusNotFound, openapi.QuestionnaireNotFound, "questionnaire not found")
 		}
 		c.Logger().Errorf("failed to get questionnaire limit: %+v", err)
 		return res, echo.NewHTTPError(http.StatusInternalServerError, err)
 	}
 
+	// 運営が対象者の代わりに回答を入力する場合、回答者は対象者にして入力した運営を記録する
+	respondentID := userID
+	isProxy := params.Respondent != nil && *params.Respondent != userID
+	if isProxy {
+		err = q.checkProxyRespondent(c, questionnaireID, userID, *params.Respondent)
+		if err != nil {
+			return res, err
+		}
+		respondentID = *params.Respondent
+	}
+
 	// 回答期限を過ぎていたらエラー
 	if limit.Valid && limit.Time.Before(time.Now()) {
 		c.Logger().Info("expired questionnaire")
-		return res, echo.NewHTTPError(http.StatusUnprocessableEntity, errors.New("expired questionnaire"))
+		return res, newAPIError(http.StatusUnprocessableEntity, openapi.QuestionnaireExpired, "expired questionnaire")
+	}
+
+	if !isProxy {
+		err = q.checkQuizAnswerNotRevealed(c.Request().Context(), questionnaireID, respondentID)
+		if errors.Is(err, errQuizAnswerRevealed) {
+			c.Logger().Info("unable to respond after the quiz answer is revealed")
+			return res, newAPIError(http.StatusUnprocessableEntity, openapi.QuizAnswerRevealed, "unable to respond after the quiz answer is revealed")
+		}
+		if err != nil {
+			c.Logger().Errorf("failed to check quiz answer: %+v", err)
+			return res, echo.NewHTTPError(http.StatusInternalServerError, err)
+		}
 	}
 
 	questions, err := q.IQuestion.GetQuestions(c.Request().Context(), questionnaireID)
@@ -1247,7 +1814,7 @@ func (q *Questionnaire) PostQuestionnaireResponse(c echo.Context, questionnaireI
 	responseMetas, err := responseBody2ResponseMetas(params.Body, questions)
 	if err != nil {
 		c.Logger().Infof("invalid response body: %+v", err)
-		return res, echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid response body: %w", err))
+		return res, newAPIError(http.StatusBadRequest, openapi.InvalidResponseBody, fmt.Sprintf("invalid response body: %s", err))
 	}
 
 	// validationでチェック
@@ -1349,11 +1916,24 @@ func (q *Questionnaire) PostQuestionnaireResponse(c echo.Context, questionnaireI
 		for _, question := range questions {
 			if questionRequired[question.ID] {
 				c.Logger().Errorf("required question is not answered: %+v", question.ID)
-				return res, echo.NewHTTPError(http.StatusBadRequest, "required question is not answered")
+				return res, newAPIError(http.StatusBadRequest, openapi.RequiredQuestionNotAnswered, "required question is not answered")
 			}
 		}
 	}
 
+	// クイズの場合は提出された回答を採点する
+	score := null.Int{}
+	if !params.IsDraft {
+		isQuiz, err := q.GetQuestionnaireIsQuiz(c.Request().Context(), questionnaireID)
+		if err != nil {
+			c.Logger().Errorf("failed to get is_quiz: %+v", err)
+			return res, echo.NewHTTPError(http.StatusInternalServerError, err)
+		}
+		if isQuiz {
+			score = null.IntFrom(int64(scoreResponse(questions, options, validations, responseMetas)))
+		}
+	}
+
 	var submittedAt time.Time
 	//一時保存のときはnull
 	if params.IsDraft {
@@ -1365,11 +1945,25 @@ func (q *Questionnaire) PostQuestionnaireResponse(c echo.Context, questionnaireI
 	var responseID int
 	err = q.ITransaction.Do(c.Request().Context(), nil, func(ctx context.Context) error {
 		var err error
-		responseID, err = q.InsertRespondent(ctx, userID, questionnaireID, null.NewTime(submittedAt, !params.IsDraft))
+		responseID, err = q.InsertRespondent(ctx, respondentID, questionnaireID, null.NewTime(submittedAt, !params.IsDraft))
 		if err != nil {
 			c.Logger().Errorf("failed to insert respondant: %+v", err)
 			return echo.NewHTTPError(http.StatusInternalServerError, err)
 		}
+		if isProxy {
+			err = q.UpdateEnteredBy(ctx, responseID, userID)
+			if err != nil {
+				c.Logger().Errorf("failed to update entered_by: %+v", err)
+				return echo.NewHTTPError(http.StatusInternalServerError, err)
+			}
+		}
+		if score.Valid {
+			err = q.UpdateScore(ctx, responseID, score)
+			if err != nil {
+				c.Logger().Errorf("failed to update score: %+v", err)
+				return echo.NewHTTPError(http.StatusInternalServerError, err)
+			}
+		}
 
 		if len(responseMetas) > 0 {
 			err = q.InsertResponses(ctx, responseID, responseMetas)
@@ -1386,60 +1980,127 @@ func (q *Questionnaire) PostQuestionnaireResponse(c echo.Context, questionnaireI
 		return res, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to insert response: %w", err))
 	}
 
-	response, err := q.GetResponse(c, responseID)
+	response, err := q.GetResponse(c, responseID, userID)
 	if err != nil {
 		c.Logger().Errorf("failed to get response: %+v", err)
 		return res, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get response: %w", err))
 	}
 
+	if !params.IsDraft {
+		q.publishResponseEvent(c, model.WebhookEventResponseSubmitted, response)
+	}
+
 	return response, nil
 }
 
-func createQuestionnaireMessage(questionnaireID int, title string, description string, administrators []string, resTimeLimit null.Time, targets []string) []string {
-	var resTimeLimitText string
-	if resTimeLimit.Valid {
-		resTimeLimitText = resTimeLimit.Time.Local().Format("2006/01/02 15:04")
-	} else {
-		resTimeLimitText = "なし"
+// checkProxyRespondent userIDのユーザーがrespondentIDの対象者の代わりに回答を入力できるか
+// editor以上の権限が必要で、匿名のアンケートでは誰が回答したかを記録しないよう代理入力を受け付けない
+func (q *Questionnaire) checkProxyRespondent(c echo.Context, questionnaireID int, userID string, respondentID string) error {
+	isEditor, err := q.checkAdministratorRole(c, userID, questionnaireID, model.AdministratorRoleEditor)
+	if err != nil {
+		c.Logger().Errorf("failed to check administrator role: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check administrator role: %w", err))
+	}
+	if !isEditor {
+		c.Logger().Info("only editors can submit responses on behalf of targets")
+		return echo.NewHTTPError(http.StatusForbidden, "only editors can submit responses on behalf of targets")
+	}
+
+	isAnonymous, err := q.GetResponseIsAnonymousByQuestionnaireID(c.Request().Context(), questionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get questionnaire is anonymous: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get questionnaire is anonymous: %w", err))
+	}
+	if isAnonymous {
+		c.Logger().Info("unable to submit responses on behalf of targets to anonymous questionnaire")
+		return echo.NewHTTPError(http.StatusBadRequest, "unable to submit responses on behalf of targets to anonymous questionnaire")
+	}
+
+	isTarget, err := q.IsTargetingMe(c.Request().Context(), questionnaireID, respondentID)
+	if err != nil {
+		c.Logger().Errorf("failed to check target: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check target: %w", err))
+	}
+	if !isTarget {
+		c.Logger().Infof("respondent is not a target: %s", respondentID)
+		return echo.NewHTTPError(http.StatusBadRequest, "respondent is not a target of this questionnaire")
+	}
+
+	return nil
+}
+
+// updateLanguage アンケートのtraQに投稿するメッセージの言語を更新する
+// nilの場合は変更しない
+func (q *Questionnaire) updateLanguage(ctx context.Context, questionnaireID int, language *openapi.Language) error {
+	if language == nil {
+		return nil
+	}
+	err := q.UpdateQuestionnaireLanguage(ctx, questionnaireID, string(*language))
+	if err != nil && !errors.Is(err, model.ErrNoRecordUpdated) {
+		return fmt.Errorf("failed to update questionnaire language: %w", err)
 	}
 
-	prefix := fmt.Sprintf(
-		"### アンケート『[%s](https://anke-to.trap.jp/questionnaires/%d)』が作成されました\n#### 管理者\n%s\n#### 説明\n%s\n#### 回答期限\n%s",
-		title,
-		questionnaireID,
+	return nil
+}
+
+// questionnaireLanguage リクエストで指定された言語を返す。指定されていない場合はdefaultLanguageを返す
+func questionnaireLanguage(language *openapi.Language, defaultLanguage i18n.Language) i18n.Language {
+	if language == nil {
+		return defaultLanguage
+	}
+
+	return i18n.ParseLanguage(string(*language))
+}
+
+// createQuestionnaireDetailText アンケートの管理者、説明、回答期限をメッセージに載せる形にする
+func createQuestionnaireDetailText(language i18n.Language, description string, administrators []string, resTimeLimitText string) string {
+	return fmt.Sprintf(
+		"%s\n%s\n%s\n%s\n%s\n%s",
+		i18n.Message(language, i18n.KeyHeaderAdministrators),
 		strings.Join(administrators, ","),
+		i18n.Message(language, i18n.KeyHeaderDescription),
 		description,
+		i18n.Message(language, i18n.KeyHeaderDeadline),
 		resTimeLimitText,
 	)
-	suffix := fmt.Sprintf("\n#### 回答リンク\nhttps://anke-to.trap.jp/responses/new/%d", questionnaireID)
+}
 
-	return createMessagesFromTargets(prefix, suffix, targets, traq.MessageLimit)
+func createResponseLinkText(language i18n.Language, questionnaireID int) string {
+	return fmt.Sprintf("\n%s\n%s", i18n.Message(language, i18n.KeyHeaderResponseLink), responseFormURL(questionnaireID))
 }
 
-func createReminderMessage(questionnaireID int, title string, description string, administrators []string, resTimeLimit time.Time, targets []string, leftTimeText string) []string {
+func createQuestionnaireMessage(language i18n.Language, questionnaireID int, title string, description string, administrators []string, resTimeLimit null.Time, targets []string) []string {
+	var resTimeLimitText string
+	if resTimeLimit.Valid {
+		resTimeLimitText = resTimeLimit.Time.Local().Format("2006/01/02 15:04")
+	} else {
+		resTimeLimitText = i18n.Message(language, i18n.KeyNone)
+	}
+
+	prefix := i18n.Message(language, i18n.KeyQuestionnaireCreated, title, questionnaireURL(questionnaireID)) + "\n" +
+		createQuestionnaireDetailText(language, description, administrators, resTimeLimitText)
+	suffix := createResponseLinkText(language, questionnaireID)
+
+	return createMessagesFromTargets(language, prefix, suffix, targets, traq.MessageLimit)
+}
+
+func createReminderMessage(language i18n.Language, questionnaireID int, title string, description string, administrators []string, resTimeLimit time.Time, targets []string, leftTimeText string) []string {
 	resTimeLimitText := resTimeLimit.Local().Format("2006/01/02 15:04")
 
-	prefix := fmt.Sprintf(
-		"### アンケート『[%s](https://anke-to.trap.jp/questionnaires/%d)』の回答期限が迫っています!\n==残り%sです!==\n#### 管理者\n%s\n#### 説明\n%s\n#### 回答期限\n%s",
-		title,
-		questionnaireID,
-		leftTimeText,
-		strings.Join(administrators, ","),
-		description,
-		resTimeLimitText,
-	)
-	suffix := fmt.Sprintf("\n#### 回答リンク\nhttps://anke-to.trap.jp/responses/new/%d", questionnaireID)
+	prefix := i18n.Message(language, i18n.KeyReminder, title, questionnaireURL(questionnaireID), leftTimeText) + "\n" +
+		createQuestionnaireDetailText(language, description, administrators, resTimeLimitText)
+	suffix := createResponseLinkText(language, questionnaireID)
 
-	return createMessagesFromTargets(prefix, suffix, targets, traq.MessageLimit)
+	return createMessagesFromTargets(language, prefix, suffix, targets, traq.MessageLimit)
 }
 
 // createMessagesFromTargets は対象者リストをlimit文字以内に収まるよう分割し、
 // それぞれに完全なヘッダーとフッターを付けた複数のメッセージを返す。
-func createMessagesFromTargets(prefix, suffix string, targets []string, limit int) []string {
-	const targetsHeader = "\n#### 対象者\n"
+func createMessagesFromTargets(language i18n.Language, prefix, suffix string, targets []string, limit int) []string {
+	targetsHeader := "\n" + i18n.Message(language, i18n.KeyHeaderTargets) + "\n"
 
 	if len(targets) == 0 {
-		return []string{prefix + targetsHeader + "なし" + suffix}
+		return []string{prefix + targetsHeader + i18n.Message(language, i18n.KeyNone) + suffix}
 	}
 
 	allTargetsText := "@" + strings.Join(targets, " @")
@@ -21,8 +21,10 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/traPtitech/anke-to/i18n"
 	"github.com/traPtitech/anke-to/model"
 	"github.com/traPtitech/anke-to/openapi"
+	"github.com/traPtitech/anke-to/pubsub"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -57,14 +59,14 @@ type recordingWebhook struct {
 	messages []string
 }
 
-func (w *recordingWebhook) PostMessage(message string) error {
+func (w *recordingWebhook) PostMessage(_ context.Context, message string) error {
 	w.messages = append(w.messages, message)
 	return nil
 }
 
 func newTestQuestionnaireWithWebhook(webhook *recordingWebhook) *Questionnaire {
-	response := NewResponse(IQuestionnaire, IRespondent, IResponse, ITarget, IQuestion, IOption, IValidation, IScaleLabel, ITransaction)
-	return NewQuestionnaire(IQuestionnaire, ITarget, ITargetGroup, ITargetUser, IAdministrator, IAdministratorGroup, IAdministratorUser, IQuestion, IOption, IScaleLabel, IValidation, ITransaction, IRespondent, webhook, response, NewReminder())
+	response := NewResponse(IQuestionnaire, IRespondent, IResponse, ITarget, IQuestion, IOption, IValidation, IScaleLabel, ITransaction, NewOutgoingWebhook(model.NewQuestionnaireWebhook()), NewResponseStream(pubsub.NewMemory(), IQuestionnaire, IRespondent))
+	return NewQuestionnaire(IQuestionnaire, ITarget, ITargetGroup, ITargetUser, IAdministrator, IAdministratorGroup, IAdministratorUser, IQuestion, IOption, IScaleLabel, IValidation, ITransaction, IRespondent, ISearchIndex, ITag, ISystemAdmin, IResponseViewer, webhook, response, NewReminder())
 }
 
 func setupSampleQuestionnaire() {
@@ -723,7 +725,7 @@ func TestGetQuestionnaires(t *testing.T) {
 				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 				ctx = e.NewContext(req, rec)
 
-				questionnaireDetail, err := q.GetQuestionnaire(ctx, questionnaire.QuestionnaireId)
+				questionnaireDetail, err := q.GetQuestionnaire(ctx, questionnaire.QuestionnaireId, userOne)
 				require.NoError(t, err)
 
 				if testCase.args.params.OnlyTargetingMe != nil {
@@ -1312,7 +1314,7 @@ func TestQuestionnairePublicationNotification(t *testing.T) {
 		rec := httptest.NewRecorder()
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		ctx := e.NewContext(req, rec)
-		err = questionnaireController.EditQuestionnaire(ctx, detail.QuestionnaireId, editParams)
+		err = questionnaireController.EditQuestionnaire(ctx, detail.QuestionnaireId, editParams, detail.Admin.Users[0])
 		require.NoError(t, err)
 	}
 
@@ -1457,7 +1459,7 @@ func TestGetQuestionnaire(t *testing.T) {
 		rec := httptest.NewRecorder()
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		ctx := e.NewContext(req, rec)
-		questionnaireDetail, err := q.GetQuestionnaire(ctx, questionnaireID)
+		questionnaireDetail, err := q.GetQuestionnaire(ctx, questionnaireID, userOne)
 
 		if !testCase.expect.isErr {
 			assertion.NoError(err, testCase.description, "no error")
@@ -1528,14 +1530,14 @@ func TestGetQuestionnaireRespondentsWithAnonymous(t *testing.T) {
 	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/questionnaire/%d", questionnaireDetail.QuestionnaireId), nil)
 	rec = httptest.NewRecorder()
 	ctx = e.NewContext(req, rec)
-	questionnaireDetail, err = q.GetQuestionnaire(ctx, questionnaireDetail.QuestionnaireId)
+	questionnaireDetail, err = q.GetQuestionnaire(ctx, questionnaireDetail.QuestionnaireId, userOne)
 	require.NoError(t, err)
 	assertion.Equal([]string{userOne}, questionnaireDetail.Respondents, "non-anonymous questionnaire respondents")
 
 	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/questionnaire/%d", questionnaireAnonymousDetail.QuestionnaireId), nil)
 	rec = httptest.NewRecorder()
 	ctx = e.NewContext(req, rec)
-	questionnaireAnonymousDetail, err = q.GetQuestionnaire(ctx, questionnaireAnonymousDetail.QuestionnaireId)
+	questionnaireAnonymousDetail, err = q.GetQuestionnaire(ctx, questionnaireAnonymousDetail.QuestionnaireId, userOne)
 	require.NoError(t, err)
 	assertion.NotNil(questionnaireAnonymousDetail.Respondents, "anonymous questionnaire respondents should be an empty array")
 	assertion.Empty(questionnaireAnonymousDetail.Respondents, "anonymous questionnaire respondents")
@@ -1597,7 +1599,7 @@ func TestGetQuestionnaireRespondentCountAndResponseCount(t *testing.T) {
 	getDetail := func(questionnaireID int) openapi.QuestionnaireDetail {
 		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/questionnaire/%d", questionnaireID), nil)
 		rec := httptest.NewRecorder()
-		d, err := q.GetQuestionnaire(e.NewContext(req, rec), questionnaireID)
+		d, err := q.GetQuestionnaire(e.NewContext(req, rec), questionnaireID, userOne)
 		require.NoError(t, err)
 		return d
 	}
@@ -2215,7 +2217,7 @@ func TestEditQuestionnaire(t *testing.T) {
 		rec = httptest.NewRecorder()
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		ctx = e.NewContext(req, rec)
-		err = q.EditQuestionnaire(ctx, questionnaireID, params)
+		err = q.EditQuestionnaire(ctx, questionnaireID, params, userOne)
 
 		if !testCase.expect.isErr {
 			assertion.NoError(err, testCase.description, "no error")
@@ -2228,7 +2230,7 @@ func TestEditQuestionnaire(t *testing.T) {
 			continue
 		}
 
-		questionnaireDetailEdited, err := q.GetQuestionnaire(ctx, questionnaireID)
+		questionnaireDetailEdited, err := q.GetQuestionnaire(ctx, questionnaireID, userOne)
 		require.NoError(t, err)
 
 		assertion.Equal(questionnaireDetail.QuestionnaireId, questionnaireDetailEdited.QuestionnaireId, testCase.description, "questionnaireId")
@@ -2656,7 +2658,7 @@ func TestEditQuestionnaireMyRemindStatusPersistsExplicitSubscriptionAfterTargetR
 	req = httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/questionnaires/%d", questionnaireDetail.QuestionnaireId), nil)
 	rec = httptest.NewRecorder()
 	ctx = e.NewContext(req, rec)
-	err = q.EditQuestionnaire(ctx, questionnaireDetail.QuestionnaireId, editParams)
+	err = q.EditQuestionnaire(ctx, questionnaireDetail.QuestionnaireId, editParams, userOne)
 	require.NoError(t, err)
 
 	req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/questionnaires/%d/myRemindStatus", questionnaireDetail.QuestionnaireId), nil)
@@ -2796,6 +2798,8 @@ func TestGetQuestionnaireResponses(t *testing.T) {
 		isErr          bool
 		err            error
 		responseIDList *[]int
+		// 審査状況が見える回答のID
+		reviewedResponseIDList *[]int
 	}
 	type test struct {
 		description string
@@ -3010,6 +3014,24 @@ func TestGetQuestionnaireResponses(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "anonymous questionnaire returns review only for my response to non administrator",
+			args: args{
+				isAnonymousQuestionnaire: true,
+				userID:                   userTwo,
+				questionnaireID:          questionnaireAnonymousDetail.QuestionnaireId,
+				params:                   openapi.GetQuestionnaireResponsesParams{},
+			},
+			expect: expect{
+				responseIDList: &[]int{
+					response10.ResponseId,
+					response11.ResponseId,
+				},
+				reviewedResponseIDList: &[]int{
+					response11.ResponseId,
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -3106,6 +3128,16 @@ func TestGetQuestionnaireResponses(t *testing.T) {
 			assertion.Equal(*testCase.expect.responseIDList, responseIDList, testCase.description, "responseIDList")
 		}
 
+		if testCase.expect.reviewedResponseIDList != nil {
+			reviewedResponseIDList := []int{}
+			for _, response := range responseList {
+				if response.Review != nil {
+					reviewedResponseIDList = append(reviewedResponseIDList, response.ResponseId)
+				}
+			}
+			assertion.Equal(*testCase.expect.reviewedResponseIDList, reviewedResponseIDList, testCase.description, "reviewedResponseIDList")
+		}
+
 		if testCase.args.params.OnlyMyResponse != nil && *testCase.args.params.OnlyMyResponse {
 			for _, response := range responseList {
 				assertion.Equal(*response.Respondent, testCase.args.userID, testCase.description, "OnlyMyResponse")
@@ -3654,6 +3686,7 @@ func TestCreateQuestionnaireMessage(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
+		language        i18n.Language
 		questionnaireID int
 		title           string
 		description     string
@@ -3891,6 +3924,31 @@ description
 #### 対象者
 なし
 #### 回答リンク
+https://anke-to.trap.jp/responses/new/1`},
+			},
+		},
+		{
+			description: "英語でも問題なし",
+			args: args{
+				language:        i18n.LanguageEn,
+				questionnaireID: 1,
+				title:           "title",
+				description:     "description",
+				administrators:  []string{"administrator1"},
+				resTimeLimit:    null.NewTime(time.Time{}, false),
+				targets:         []string{},
+			},
+			expect: expect{
+				messages: []string{`### Questionnaire "[title](https://anke-to.trap.jp/questionnaires/1)" has been created
+#### Administrators
+administrator1
+#### Description
+description
+#### Deadline
+None
+#### Targets
+None
+#### Response link
 https://anke-to.trap.jp/responses/new/1`},
 			},
 		},
@@ -3899,6 +3957,7 @@ https://anke-to.trap.jp/responses/new/1`},
 	for _, testCase := range testCases {
 		t.Run(testCase.description, func(t *testing.T) {
 			messages := createQuestionnaireMessage(
+				testCase.args.language,
 				testCase.args.questionnaireID,
 				testCase.args.title,
 				testCase.args.description,
@@ -3915,6 +3974,7 @@ https://anke-to.trap.jp/responses/new/1`},
 func TestCreateReminderMessage(t *testing.T) {
 	t.Parallel()
 	type args struct {
+		language        i18n.Language
 		questionnaireID int
 		title           string
 		description     string
@@ -4119,6 +4179,33 @@ description
 #### 対象者
 @target1 @target2
 #### 回答リンク
+https://anke-to.trap.jp/responses/new/1`},
+			},
+		},
+		{
+			description: "英語でも問題なし",
+			args: args{
+				language:        i18n.LanguageEn,
+				questionnaireID: 1,
+				title:           "title",
+				description:     "description",
+				administrators:  []string{"administrator1"},
+				resTimeLimit:    tm,
+				targets:         []string{"target1"},
+				leftTimeText:    "5 minutes",
+			},
+			expect: expect{
+				messages: []string{`### The deadline for questionnaire "[title](https://anke-to.trap.jp/questionnaires/1)" is approaching!
+==5 minutes left!==
+#### Administrators
+administrator1
+#### Description
+description
+#### Deadline
+2021/10/01 09:06
+#### Targets
+@target1
+#### Response link
 https://anke-to.trap.jp/responses/new/1`},
 			},
 		},
@@ -4127,6 +4214,7 @@ https://anke-to.trap.jp/responses/new/1`},
 	for _, testCase := range testCases {
 		t.Run(testCase.description, func(t *testing.T) {
 			messages := createReminderMessage(
+				testCase.args.language,
 				testCase.args.questionnaireID,
 				testCase.args.title,
 				testCase.args.description,
@@ -4181,7 +4269,7 @@ func TestCreateMessagesFromTargets(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
-			msgs := createMessagesFromTargets(prefix, suffix, tt.targets, limit)
+			msgs := createMessagesFromTargets(i18n.LanguageJa, prefix, suffix, tt.targets, limit)
 			assert.Len(t, msgs, tt.wantLen)
 			for _, msg := range msgs {
 				assert.LessOrEqual(t, len([]rune(msg)), limit, "message exceeded limit")
@@ -0,0 +1,460 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/i18n"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/openapi"
+	"github.com/traPtitech/anke-to/traq"
+	"gopkg.in/guregu/null.v4"
+)
+
+// QuickPoll スタンプで回答するアンケートの構造体
+type QuickPoll struct {
+	model.IQuickPoll
+	model.IQuestionnaire
+	model.IQuestion
+	model.IOption
+	model.IRespondent
+	model.IResponse
+	model.ITransaction
+	traq.IBot
+	// スタンプでの回答の変更を、フォームからの回答と同じくWebhookとリアルタイム配信で通知するために使う
+	Response *Response
+	// traQのユーザーのUUIDからtraQ IDへのキャッシュ
+	userTraqIDs sync.Map
+}
+
+// NewQuickPoll QuickPollのコンストラクター
+func NewQuickPoll(
+	quickPoll model.IQuickPoll,
+	questionnaire model.IQuestionnaire,
+	question model.IQuestion,
+	option model.IOption,
+	respondent model.IRespondent,
+	response model.IResponse,
+	transaction model.ITransaction,
+	responseController *Response,
+	bot traq.IBot,
+) *QuickPoll {
+	return &QuickPoll{
+		IQuickPoll:     quickPoll,
+		IQuestionnaire: questionnaire,
+		IQuestion:      question,
+		IOption:        option,
+		IRespondent:    respondent,
+		IResponse:      response,
+		ITransaction:   transaction,
+		IBot:           bot,
+		Response:       responseController,
+	}
+}
+
+// GetQuestionnaireQuickPoll アンケートのスタンプでの回答の設定の取得
+func (qp *QuickPoll) GetQuestionnaireQuickPoll(c echo.Context, questionnaireID int) (openapi.QuickPoll, error) {
+	quickPoll, err := qp.GetQuickPoll(c.Request().Context(), questionnaireID)
+	if err != nil {
+		if errors.Is(err, model.ErrRecordNotFound) {
+			return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusNotFound, "quick poll not found")
+		}
+		c.Logger().Errorf("failed to get quick poll: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get quick poll: %w", err))
+	}
+
+	options, err := qp.GetOptions(c.Request().Context(), []int{quickPoll.QuestionID})
+	if err != nil {
+		c.Logger().Errorf("failed to get options: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get options: %w", err))
+	}
+
+	res, err := convertQuickPoll(quickPoll, options)
+	if err != nil {
+		c.Logger().Errorf("failed to convert quick poll: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to convert quick poll: %w", err))
+	}
+
+	return res, nil
+}
+
+// PostQuestionnaireQuickPoll アンケートをBOTでチャンネルに投稿し、スタンプで回答できるようにする
+func (qp *QuickPoll) PostQuestionnaireQuickPoll(c echo.Context, questionnaireID int, userID string, params openapi.PostQuestionnaireQuickPollJSONRequestBody) (openapi.QuickPoll, error) {
+	ctx := c.Request().Context()
+
+	_, err := qp.GetQuickPoll(ctx, questionnaireID)
+	if err == nil {
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusConflict, "quick poll already exists")
+	}
+	if !errors.Is(err, model.ErrRecordNotFound) {
+		c.Logger().Errorf("failed to get quick poll: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get quick poll: %w", err))
+	}
+
+	questionnaire, _, _, _, _, _, _, _, err := qp.GetQuestionnaireInfo(ctx, questionnaireID)
+	if err != nil {
+		if errors.Is(err, model.ErrRecordNotFound) {
+			return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusNotFound, "questionnaire not found")
+		}
+		c.Logger().Errorf("failed to get questionnaire info: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get questionnaire info: %w", err))
+	}
+	if !questionnaire.IsPublished {
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusBadRequest, "questionnaire is not published")
+	}
+	// traQのスタンプは誰が押したかわかるため、匿名のアンケートでは使えない
+	if questionnaire.IsAnonymous {
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusBadRequest, "quick poll is not available for anonymous questionnaire")
+	}
+	if questionnaire.ResTimeLimit.Valid && questionnaire.ResTimeLimit.Time.Before(time.Now()) {
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusBadRequest, "questionnaire is already closed")
+	}
+
+	questions, err := qp.GetQuestions(ctx, questionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get questions: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get questions: %w", err))
+	}
+	// スタンプ1つで回答が決まるよう、単一選択の質問1つだけのアンケートに限る
+	if len(questions) != 1 || questions[0].Type != "MultipleChoice" {
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusBadRequest, "quick poll must have exactly one single choice question")
+	}
+	question := questions[0]
+
+	options, err := qp.GetOptions(ctx, []int{question.ID})
+	if err != nil {
+		c.Logger().Errorf("failed to get options: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get options: %w", err))
+	}
+	if len(options) != len(params.Stamps) {
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusBadRequest, "the number of stamps must be equal to the number of options")
+	}
+
+	stamps, err := qp.GetStamps(ctx)
+	if err != nil {
+		c.Logger().Errorf("failed to get traq stamps: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get traq stamps: %w", err))
+	}
+	stampNames := make(map[string]string, len(stamps))
+	for _, stamp := range stamps {
+		stampNames[stamp.Id] = stamp.Name
+	}
+
+	quickPollStamps := make([]model.QuickPollStamps, 0, len(params.Stamps))
+	for i, stampID := range params.Stamps {
+		if _, ok := stampNames[stampID.String()]; !ok {
+			return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("stamp not found: %s", stampID.String()))
+		}
+		for _, quickPollStamp := range quickPollStamps {
+			if quickPollStamp.StampID == stampID.String() {
+				return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusBadRequest, "stamps must be unique")
+			}
+		}
+
+		quickPollStamps = append(quickPollStamps, model.QuickPollStamps{
+			StampID:   stampID.String(),
+			OptionNum: i + 1,
+		})
+	}
+
+	message := createQuickPollMessage(questionnaire, question, options, quickPollStamps, stampNames)
+	messageID, err := qp.PostChannelMessage(ctx, params.ChannelId.String(), message)
+	if err != nil {
+		c.Logger().Errorf("failed to post quick poll message: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to post quick poll message: %w", err))
+	}
+
+	err = qp.InsertQuickPoll(ctx, questionnaireID, question.ID, params.ChannelId.String(), messageID, quickPollStamps, userID)
+	if err != nil {
+		c.Logger().Errorf("failed to insert quick poll: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to insert quick poll: %w", err))
+	}
+
+	// 回答しやすいよう、BOTが選択肢のスタンプを先に押しておく
+	// BOTのスタンプは回答として記録しない
+	for _, quickPollStamp := range quickPollStamps {
+		err = qp.AddMessageStamp(ctx, messageID, quickPollStamp.StampID)
+		if err != nil {
+			c.Logger().Errorf("failed to add quick poll stamp: %+v", err)
+		}
+	}
+
+	quickPoll, err := qp.GetQuickPoll(ctx, questionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get quick poll: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get quick poll: %w", err))
+	}
+
+	res, err := convertQuickPoll(quickPoll, options)
+	if err != nil {
+		c.Logger().Errorf("failed to convert quick poll: %+v", err)
+		return openapi.QuickPoll{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to convert quick poll: %w", err))
+	}
+
+	return res, nil
+}
+
+// RecordQuickPollStamps BOTのメッセージに押されたスタンプを回答として記録する
+func (qp *QuickPoll) RecordQuickPollStamps(c echo.Context, event traq.BotMessageStampsUpdatedEvent) error {
+	ctx := c.Request().Context()
+
+	quickPoll, err := qp.GetQuickPollByMessageID(ctx, event.MessageID)
+	if errors.Is(err, model.ErrRecordNotFound) {
+		// スタンプで回答するアンケート以外のメッセージは無視する
+		return nil
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to get quick poll: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get quick poll: %w", err))
+	}
+
+	questionnaire, _, _, _, _, _, _, _, err := qp.GetQuestionnaireInfo(ctx, quickPoll.QuestionnaireID)
+	if errors.Is(err, model.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to get questionnaire info: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get questionnaire info: %w", err))
+	}
+	// 回答期限後に押されたスタンプは回答として扱わない
+	if questionnaire.ResTimeLimit.Valid && questionnaire.ResTimeLimit.Time.Before(event.EventTime) {
+		return nil
+	}
+	// 投稿後に匿名に変更されたアンケートでは、スタンプと回答を結びつけない
+	if questionnaire.IsAnonymous {
+		return nil
+	}
+
+	options, err := qp.GetOptions(ctx, []int{quickPoll.QuestionID})
+	if err != nil {
+		c.Logger().Errorf("failed to get options: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get options: %w", err))
+	}
+	optionBodies := make(map[int]string, len(options))
+	for _, option := range options {
+		optionBodies[option.OptionNum] = option.Body
+	}
+
+	userVotes, err := qp.getQuickPollUserVotes(ctx, quickPoll, event.Stamps)
+	if err != nil {
+		c.Logger().Errorf("failed to get quick poll votes: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get quick poll votes: %w", err))
+	}
+
+	// 回答の変更は、トランザクションが成功してから通知する
+	var (
+		submittedResponseIDs []int
+		editedResponseIDs    []int
+		deletedResponseIDs   []int
+	)
+	err = qp.ITransaction.Do(ctx, nil, func(ctx context.Context) error {
+		submittedResponseIDs = []int{}
+		editedResponseIDs = []int{}
+		deletedResponseIDs = []int{}
+
+		votes, err := qp.GetQuickPollVotes(ctx, quickPoll.QuestionnaireID)
+		if err != nil {
+			return fmt.Errorf("failed to get quick poll votes: %w", err)
+		}
+		currentVotes := make(map[string]model.QuickPollVotes, len(votes))
+		for _, vote := range votes {
+			currentVotes[vote.UserTraqid] = vote
+		}
+
+		for userID, optionNum := range userVotes {
+			optionBody, ok := optionBodies[optionNum]
+			if !ok {
+				continue
+			}
+			responseMetas := []*model.ResponseMeta{
+				{
+					QuestionID: quickPoll.QuestionID,
+					Data:       optionBody,
+				},
+			}
+
+			vote, ok := currentVotes[userID]
+			if ok && vote.OptionNum == optionNum {
+				continue
+			}
+
+			var responseID int
+			if ok {
+				responseID = vote.ResponseID
+				err = qp.IResponse.DeleteResponse(ctx, responseID)
+				if err != nil && !errors.Is(err, model.ErrNoRecordDeleted) {
+					return fmt.Errorf("failed to delete response: %w", err)
+				}
+				err = qp.UpdateModifiedAt(ctx, responseID)
+				if err != nil {
+					return fmt.Errorf("failed to update modified at: %w", err)
+				}
+				editedResponseIDs = append(editedResponseIDs, responseID)
+			} else {
+				// フォームからの回答と同じく、複数回答が許可されていないアンケートにすでに回答しているユーザーのスタンプは記録しない
+				if !questionnaire.IsDuplicateAnswerAllowed {
+					isRespondent, err := qp.CheckRespondent(ctx, userID, quickPoll.QuestionnaireID)
+					if err != nil {
+						return fmt.Errorf("failed to check respondent: %w", err)
+					}
+					if isRespondent {
+						continue
+					}
+				}
+
+				responseID, err = qp.InsertRespondent(ctx, userID, quickPoll.QuestionnaireID, null.TimeFrom(event.EventTime))
+				if err != nil {
+					return fmt.Errorf("failed to insert respondent: %w", err)
+				}
+				submittedResponseIDs = append(submittedResponseIDs, responseID)
+			}
+
+			err = qp.InsertResponses(ctx, responseID, responseMetas)
+			if err != nil {
+				return fmt.Errorf("failed to insert responses: %w", err)
+			}
+
+			err = qp.UpsertQuickPollVote(ctx, quickPoll.QuestionnaireID, userID, responseID, optionNum)
+			if err != nil {
+				return fmt.Errorf("failed to upsert quick poll vote: %w", err)
+			}
+		}
+
+		// スタンプが外された場合は回答も削除する
+		for userID, vote := range currentVotes {
+			if _, ok := userVotes[userID]; ok {
+				continue
+			}
+
+			err = qp.DeleteRespondent(ctx, vote.ResponseID)
+			if err != nil && !errors.Is(err, model.ErrNoRecordDeleted) {
+				return fmt.Errorf("failed to delete respondent: %w", err)
+			}
+			err = qp.DeleteQuickPollVote(ctx, quickPoll.QuestionnaireID, userID)
+			if err != nil {
+				return fmt.Errorf("failed to delete quick poll vote: %w", err)
+			}
+			deletedResponseIDs = append(deletedResponseIDs, vote.ResponseID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		c.Logger().Errorf("failed to record quick poll stamps: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to record quick poll stamps: %w", err))
+	}
+
+	for _, responseID := range submittedResponseIDs {
+		qp.publishQuickPollResponseEvent(c, model.WebhookEventResponseSubmitted, responseID)
+	}
+	for _, responseID := range editedResponseIDs {
+		qp.publishQuickPollResponseEvent(c, model.WebhookEventResponseEdited, responseID)
+	}
+	for _, responseID := range deletedResponseIDs {
+		qp.Response.PublishResponseDeletedEvent(c, quickPoll.QuestionnaireID, responseID)
+		qp.Response.PublishResponseStreamDeletedEvent(c, quickPoll.QuestionnaireID, responseID)
+	}
+
+	return nil
+}
+
+// publishQuickPollResponseEvent スタンプでの回答の提出・変更を通知する
+// 通知に失敗してもスタンプの記録は失敗させないため、エラーはログに残すのみ
+func (qp *QuickPoll) publishQuickPollResponseEvent(c echo.Context, event model.WebhookEvent, responseID int) {
+	respondentDetail, err := qp.GetRespondentDetail(c.Request().Context(), responseID)
+	if err != nil {
+		c.Logger().Errorf("failed to get respondent detail for notification: %+v", err)
+		return
+	}
+	// 匿名のアンケートのスタンプは記録しないため、回答者はそのまま含める
+	response, err := respondentDetail2ResponseWithMetadata(c, respondentDetail, &respondentDetail.TraqID, false)
+	if err != nil {
+		c.Logger().Errorf("failed to convert respondent detail into response: %+v", err)
+		return
+	}
+
+	qp.Response.publishResponseEvent(c, event, response)
+}
+
+// getQuickPollUserVotes スタンプからユーザーごとの選択肢を求める
+// 1人1回答なので、複数の選択肢のスタンプを押している場合は最後に押したものを回答とする
+func (qp *QuickPoll) getQuickPollUserVotes(ctx context.Context, quickPoll *model.QuickPolls, stamps []traq.BotMessageStamp) (map[string]int, error) {
+	stampOptions := make(map[string]int, len(quickPoll.Stamps))
+	for _, stamp := range quickPoll.Stamps {
+		stampOptions[stamp.StampID] = stamp.OptionNum
+	}
+
+	latestStamps := map[string]traq.BotMessageStamp{}
+	for _, stamp := range stamps {
+		if _, ok := stampOptions[stamp.StampID]; !ok {
+			continue
+		}
+		if latest, ok := latestStamps[stamp.UserID]; ok && !stamp.CreatedAt.After(latest.CreatedAt) {
+			continue
+		}
+		latestStamps[stamp.UserID] = stamp
+	}
+
+	userVotes := make(map[string]int, len(latestStamps))
+	for userUUID, stamp := range latestStamps {
+		userID, err := qp.getUserTraqID(ctx, userUUID)
+		if err != nil {
+			return nil, err
+		}
+		// traQのBOTのユーザー名はBOT_から始まる
+		if strings.HasPrefix(userID, "BOT_") {
+			continue
+		}
+
+		userVotes[userID] = stampOptions[stamp.StampID]
+	}
+
+	return userVotes, nil
+}
+
+func (qp *QuickPoll) getUserTraqID(ctx context.Context, userUUID string) (string, error) {
+	if userID, ok := qp.userTraqIDs.Load(userUUID); ok {
+		return userID.(string), nil
+	}
+
+	userID, err := qp.GetUserTraqID(ctx, userUUID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get traq id of %s: %w", userUUID, err)
+	}
+	qp.userTraqIDs.Store(userUUID, userID)
+
+	return userID, nil
+}
+
+// createQuickPollMessage スタンプで回答するアンケートのメッセージを、アンケートの言語で作る
+func createQuickPollMessage(questionnaire *model.Questionnaires, question model.Questions, options []model.Options, stamps []model.QuickPollStamps, stampNames map[string]string) string {
+	language := i18n.ParseLanguage(questionnaire.Language)
+
+	optionBodies := make(map[int]string, len(options))
+	for _, option := range options {
+		optionBodies[option.OptionNum] = option.Body
+	}
+
+	sb := strings.Builder{}
+	fmt.Fprintf(&sb, "%s\n", i18n.Message(language, i18n.KeyQuickPoll, questionnaire.Title, questionnaireURL(questionnaire.ID)))
+	if questionnaire.Description != "" {
+		sb.WriteString(questionnaire.Description)
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(&sb, "#### %s\n", question.Body)
+	for _, stamp := range stamps {
+		fmt.Fprintf(&sb, ":%s: %s\n", stampNames[stamp.StampID], optionBodies[stamp.OptionNum])
+	}
+	if questionnaire.ResTimeLimit.Valid {
+		fmt.Fprintf(&sb, "%s\n%s\n", i18n.Message(language, i18n.KeyHeaderDeadline), questionnaire.ResTimeLimit.Time.In(jst).Format("2006/01/02 15:04"))
+	}
+	sb.WriteString(i18n.Message(language, i18n.KeyQuickPollHowTo))
+
+	return sb.String()
+}
@@ -0,0 +1,599 @@
+package controller
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/model/mock_model"
+	"github.com/traPtitech/anke-to/openapi"
+	"github.com/traPtitech/anke-to/pubsub"
+	"github.com/traPtitech/anke-to/traq"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/guregu/null.v4"
+)
+
+var (
+	quickPollMessageID = "2d7ff3f5-c313-4f4a-a9bb-0b5f84d2b6f8"
+	quickPollChannelID = "9aba50da-f605-4cd0-a428-5e4558cb911e"
+	quickPollStampOne  = "0b9a1a9c-4d7e-4c1b-a8b3-6f5e1c2d3a4b"
+	quickPollStampTwo  = "5c6d7e8f-9a0b-4c1d-8e2f-3a4b5c6d7e8f"
+	quickPollUsers     = map[string]string{
+		"7c3d6b5e-0a8e-4f1e-9d3c-4c5e6f7a8b9c": "BOT_anke-to",
+		"dfdff0c9-5de0-46ee-9721-2525e8bb3d45": "mazrean",
+		"3e1f5a7b-9c2d-4e6f-8a0b-1c3d5e7f9a2b": "ryoha",
+		"6f8a0b2c-4d6e-4f1a-b3c5-d7e9f1a3b5c7": "cp20",
+	}
+	quickPollOptions = []model.Options{
+		{ID: 1, QuestionID: 5, OptionNum: 1, Body: "賛成"},
+		{ID: 2, QuestionID: 5, OptionNum: 2, Body: "反対"},
+	}
+)
+
+type quickPollMocks struct {
+	quickPoll     *mock_model.MockIQuickPoll
+	questionnaire *mock_model.MockIQuestionnaire
+	question      *mock_model.MockIQuestion
+	option        *mock_model.MockIOption
+	respondent    *mock_model.MockIRespondent
+	response      *mock_model.MockIResponse
+	transaction   *mock_model.MockITransaction
+	webhook       *mock_model.MockIQuestionnaireWebhook
+}
+
+func newQuickPollMocks(ctrl *gomock.Controller) quickPollMocks {
+	mocks := quickPollMocks{
+		quickPoll:     mock_model.NewMockIQuickPoll(ctrl),
+		questionnaire: mock_model.NewMockIQuestionnaire(ctrl),
+		question:      mock_model.NewMockIQuestion(ctrl),
+		option:        mock_model.NewMockIOption(ctrl),
+		respondent:    mock_model.NewMockIRespondent(ctrl),
+		response:      mock_model.NewMockIResponse(ctrl),
+		transaction:   mock_model.NewMockITransaction(ctrl),
+		webhook:       mock_model.NewMockIQuestionnaireWebhook(ctrl),
+	}
+	mocks.transaction.
+		EXPECT().
+		Do(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ *sql.TxOptions, f func(ctx context.Context) error) error {
+			return f(ctx)
+		}).
+		AnyTimes()
+
+	return mocks
+}
+
+func newQuickPollTestPoll() *model.QuickPolls {
+	return &model.QuickPolls{
+		QuestionnaireID: 1,
+		QuestionID:      5,
+		ChannelID:       quickPollChannelID,
+		MessageID:       quickPollMessageID,
+		CreatedBy:       "mazrean",
+		Stamps: []model.QuickPollStamps{
+			{QuestionnaireID: 1, StampID: quickPollStampOne, OptionNum: 1},
+			{QuestionnaireID: 1, StampID: quickPollStampTwo, OptionNum: 2},
+		},
+	}
+}
+
+func TestRecordQuickPollStamps(t *testing.T) {
+	t.Parallel()
+
+	eventTime := time.Date(2024, 5, 10, 9, 0, 3, 221391741, time.UTC)
+
+	type test struct {
+		description string
+		setup       func(mocks quickPollMocks)
+		// streamEvents リアルタイム配信されるイベント
+		streamEvents []string
+	}
+
+	// expectNotification 回答の変更をWebhookで通知するときに呼ばれるモック
+	expectNotification := func(mocks quickPollMocks, event model.WebhookEvent) {
+		mocks.webhook.
+			EXPECT().
+			GetQuestionnaireWebhooks(gomock.Any(), 1).
+			Return([]model.QuestionnaireWebhooks{
+				{
+					ID:              1,
+					QuestionnaireID: 1,
+					URL:             "https://example.com/hook",
+					Secret:          "secret",
+					Events:          "response.submitted response.edited response.deleted",
+				},
+			}, nil)
+		mocks.webhook.
+			EXPECT().
+			InsertWebhookDelivery(gomock.Any(), 1, event, gomock.Any()).
+			Return(1, nil)
+		mocks.respondent.
+			EXPECT().
+			GetRespondentCounts(gomock.Any(), 1).
+			Return(2, 2, nil)
+	}
+	// expectGetResponse 通知する回答を取得するときに呼ばれるモック
+	expectGetResponse := func(mocks quickPollMocks, responseID int, userID string) {
+		mocks.respondent.
+			EXPECT().
+			GetRespondentDetail(gomock.Any(), responseID).
+			Return(model.RespondentDetail{
+				ResponseID:      responseID,
+				TraqID:          userID,
+				QuestionnaireID: 1,
+				SubmittedAt:     null.TimeFrom(eventTime),
+				ModifiedAt:      eventTime,
+			}, nil)
+		mocks.question.
+			EXPECT().
+			GetQuestions(gomock.Any(), 1).
+			Return([]model.Questions{{ID: 5, QuestionnaireID: 1}}, nil)
+	}
+
+	testCases := []test{
+		{
+			description: "新しい回答の追加と、スタンプが外された回答の削除",
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollByMessageID(gomock.Any(), quickPollMessageID).
+					Return(newQuickPollTestPoll(), nil)
+				mocks.questionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, IsPublished: true, ResTimeLimit: null.TimeFrom(eventTime.Add(time.Hour))}, nil, nil, nil, nil, nil, nil, nil, nil)
+				mocks.option.
+					EXPECT().
+					GetOptions(gomock.Any(), []int{5}).
+					Return(quickPollOptions, nil)
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollVotes(gomock.Any(), 1).
+					Return([]model.QuickPollVotes{
+						{QuestionnaireID: 1, UserTraqid: "ryoha", ResponseID: 10, OptionNum: 1},
+						{QuestionnaireID: 1, UserTraqid: "xxarupakaxx", ResponseID: 11, OptionNum: 2},
+					}, nil)
+
+				// mazreanは最後に押した「賛成」のスタンプが回答になる
+				mocks.respondent.
+					EXPECT().
+					CheckRespondent(gomock.Any(), "mazrean", 1).
+					Return(false, nil)
+				mocks.respondent.
+					EXPECT().
+					InsertRespondent(gomock.Any(), "mazrean", 1, null.TimeFrom(eventTime)).
+					Return(12, nil)
+				mocks.response.
+					EXPECT().
+					InsertResponses(gomock.Any(), 12, []*model.ResponseMeta{{QuestionID: 5, Data: "賛成"}}).
+					Return(nil)
+				mocks.quickPoll.
+					EXPECT().
+					UpsertQuickPollVote(gomock.Any(), 1, "mazrean", 12, 1).
+					Return(nil)
+
+				// スタンプを外したxxarupakaxxの回答は削除する
+				mocks.respondent.
+					EXPECT().
+					DeleteRespondent(gomock.Any(), 11).
+					Return(nil)
+				mocks.quickPoll.
+					EXPECT().
+					DeleteQuickPollVote(gomock.Any(), 1, "xxarupakaxx").
+					Return(nil)
+
+				// フォームからの回答と同じく、提出と削除を通知する
+				expectGetResponse(mocks, 12, "mazrean")
+				expectNotification(mocks, model.WebhookEventResponseSubmitted)
+				expectNotification(mocks, model.WebhookEventResponseDeleted)
+			},
+			streamEvents: []string{string(model.WebhookEventResponseSubmitted), string(model.WebhookEventResponseDeleted)},
+		},
+		{
+			description: "選択肢を変えた場合は回答を更新する",
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollByMessageID(gomock.Any(), quickPollMessageID).
+					Return(newQuickPollTestPoll(), nil)
+				mocks.questionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, IsPublished: true}, nil, nil, nil, nil, nil, nil, nil, nil)
+				mocks.option.
+					EXPECT().
+					GetOptions(gomock.Any(), []int{5}).
+					Return(quickPollOptions, nil)
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollVotes(gomock.Any(), 1).
+					Return([]model.QuickPollVotes{
+						{QuestionnaireID: 1, UserTraqid: "mazrean", ResponseID: 12, OptionNum: 2},
+						{QuestionnaireID: 1, UserTraqid: "ryoha", ResponseID: 10, OptionNum: 1},
+					}, nil)
+
+				mocks.response.
+					EXPECT().
+					DeleteResponse(gomock.Any(), 12).
+					Return(nil)
+				mocks.respondent.
+					EXPECT().
+					UpdateModifiedAt(gomock.Any(), 12).
+					Return(nil)
+				mocks.response.
+					EXPECT().
+					InsertResponses(gomock.Any(), 12, []*model.ResponseMeta{{QuestionID: 5, Data: "賛成"}}).
+					Return(nil)
+				mocks.quickPoll.
+					EXPECT().
+					UpsertQuickPollVote(gomock.Any(), 1, "mazrean", 12, 1).
+					Return(nil)
+
+				expectGetResponse(mocks, 12, "mazrean")
+				expectNotification(mocks, model.WebhookEventResponseEdited)
+			},
+			streamEvents: []string{string(model.WebhookEventResponseEdited)},
+		},
+		{
+			description: "複数回答が許可されていないアンケートにフォームから回答済みのユーザーのスタンプは記録しない",
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollByMessageID(gomock.Any(), quickPollMessageID).
+					Return(newQuickPollTestPoll(), nil)
+				mocks.questionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, IsPublished: true}, nil, nil, nil, nil, nil, nil, nil, nil)
+				mocks.option.
+					EXPECT().
+					GetOptions(gomock.Any(), []int{5}).
+					Return(quickPollOptions, nil)
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollVotes(gomock.Any(), 1).
+					Return([]model.QuickPollVotes{
+						{QuestionnaireID: 1, UserTraqid: "ryoha", ResponseID: 10, OptionNum: 1},
+					}, nil)
+
+				mocks.respondent.
+					EXPECT().
+					CheckRespondent(gomock.Any(), "mazrean", 1).
+					Return(true, nil)
+			},
+		},
+		{
+			description: "複数回答が許可されているアンケートではフォームからの回答とは別に記録する",
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollByMessageID(gomock.Any(), quickPollMessageID).
+					Return(newQuickPollTestPoll(), nil)
+				mocks.questionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, IsPublished: true, IsDuplicateAnswerAllowed: true}, nil, nil, nil, nil, nil, nil, nil, nil)
+				mocks.option.
+					EXPECT().
+					GetOptions(gomock.Any(), []int{5}).
+					Return(quickPollOptions, nil)
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollVotes(gomock.Any(), 1).
+					Return([]model.QuickPollVotes{
+						{QuestionnaireID: 1, UserTraqid: "ryoha", ResponseID: 10, OptionNum: 1},
+					}, nil)
+
+				mocks.respondent.
+					EXPECT().
+					InsertRespondent(gomock.Any(), "mazrean", 1, null.TimeFrom(eventTime)).
+					Return(12, nil)
+				mocks.response.
+					EXPECT().
+					InsertResponses(gomock.Any(), 12, []*model.ResponseMeta{{QuestionID: 5, Data: "賛成"}}).
+					Return(nil)
+				mocks.quickPoll.
+					EXPECT().
+					UpsertQuickPollVote(gomock.Any(), 1, "mazrean", 12, 1).
+					Return(nil)
+
+				expectGetResponse(mocks, 12, "mazrean")
+				expectNotification(mocks, model.WebhookEventResponseSubmitted)
+			},
+			streamEvents: []string{string(model.WebhookEventResponseSubmitted)},
+		},
+		{
+			description: "回答期限後のスタンプは記録しない",
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollByMessageID(gomock.Any(), quickPollMessageID).
+					Return(newQuickPollTestPoll(), nil)
+				mocks.questionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, IsPublished: true, ResTimeLimit: null.TimeFrom(eventTime.Add(-time.Hour))}, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
+		},
+		{
+			description: "匿名のアンケートのスタンプは記録しない",
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollByMessageID(gomock.Any(), quickPollMessageID).
+					Return(newQuickPollTestPoll(), nil)
+				mocks.questionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, IsPublished: true, IsAnonymous: true}, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
+		},
+		{
+			description: "スタンプで回答するアンケート以外のメッセージは無視する",
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPollByMessageID(gomock.Any(), quickPollMessageID).
+					Return(nil, model.ErrRecordNotFound)
+			},
+		},
+	}
+
+	payload := readBotEvent(t, "bot_message_stamps_updated.json")
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+
+		mocks := newQuickPollMocks(ctrl)
+		testCase.setup(mocks)
+
+		broker := pubsub.NewMemory()
+		ctx, cancel := context.WithCancel(context.Background())
+		streamMessages, err := broker.Subscribe(ctx, responseStreamTopic(1))
+		if err != nil {
+			t.Fatalf("failed to subscribe: %v", err)
+		}
+
+		bot := &recordingBot{messages: []recordingBotMessage{}, users: quickPollUsers}
+		response := NewResponse(mocks.questionnaire, mocks.respondent, mocks.response, nil, mocks.question, mocks.option, nil, nil, mocks.transaction, NewOutgoingWebhook(mocks.webhook), NewResponseStream(broker, mocks.questionnaire, mocks.respondent))
+		quickPoll := NewQuickPoll(mocks.quickPoll, mocks.questionnaire, mocks.question, mocks.option, mocks.respondent, mocks.response, mocks.transaction, response, bot)
+		b := NewBot(nil, quickPoll, bot)
+		b.verificationToken = "token"
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/bot/events", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err = b.HandleBotEvent(c, traq.BotEventMessageStampsUpdated, payload)
+		assert.NoError(t, err, testCase.description, "no error")
+		assert.Empty(t, bot.messages, testCase.description, "messages")
+
+		streamEvents := []string{}
+	receive:
+		for {
+			select {
+			case message := <-streamMessages:
+				var event openapi.ResponseStreamEvent
+				err := json.Unmarshal(message, &event)
+				if assert.NoError(t, err, testCase.description, "unmarshal") {
+					streamEvents = append(streamEvents, event.Event)
+				}
+			default:
+				break receive
+			}
+		}
+		assert.ElementsMatch(t, testCase.streamEvents, streamEvents, testCase.description, "stream events")
+
+		cancel()
+		ctrl.Finish()
+	}
+}
+
+func TestPostQuestionnaireQuickPoll(t *testing.T) {
+	t.Parallel()
+
+	type expect struct {
+		isErr    bool
+		code     int
+		messages []recordingBotMessage
+		stamps   [][2]string
+	}
+	type test struct {
+		description string
+		params      openapi.PostQuestionnaireQuickPollJSONRequestBody
+		setup       func(mocks quickPollMocks)
+		expect
+	}
+
+	validParams := openapi.PostQuestionnaireQuickPollJSONRequestBody{
+		ChannelId: uuid.MustParse(quickPollChannelID),
+		Stamps:    []openapi_types.UUID{uuid.MustParse(quickPollStampOne), uuid.MustParse(quickPollStampTwo)},
+	}
+
+	testCases := []test{
+		{
+			description: "メッセージを投稿してスタンプを押す",
+			params:      validParams,
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPoll(gomock.Any(), 1).
+					Return(nil, model.ErrRecordNotFound)
+				mocks.questionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, Title: "懇親会の開催", Description: "開催の賛否を決めます", IsPublished: true}, nil, nil, nil, nil, nil, nil, nil, nil)
+				mocks.question.
+					EXPECT().
+					GetQuestions(gomock.Any(), 1).
+					Return([]model.Questions{{ID: 5, QuestionnaireID: 1, Type: "MultipleChoice", Body: "開催に賛成ですか?"}}, nil)
+				mocks.option.
+					EXPECT().
+					GetOptions(gomock.Any(), []int{5}).
+					Return(quickPollOptions, nil).
+					Times(1)
+				mocks.quickPoll.
+					EXPECT().
+					InsertQuickPoll(gomock.Any(), 1, 5, quickPollChannelID, quickPollMessageID, []model.QuickPollStamps{
+						{StampID: quickPollStampOne, OptionNum: 1},
+						{StampID: quickPollStampTwo, OptionNum: 2},
+					}, "mazrean").
+					Return(nil)
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPoll(gomock.Any(), 1).
+					Return(newQuickPollTestPoll(), nil)
+			},
+			expect: expect{
+				messages: []recordingBotMessage{
+					{
+						channelID: quickPollChannelID,
+						content: "### アンケート『[懇親会の開催](https://anke-to.trap.jp/questionnaires/1)』\n" +
+							"開催の賛否を決めます\n" +
+							"#### 開催に賛成ですか?\n" +
+							":thumbs_up: 賛成\n" +
+							":thumbs_down: 反対\n" +
+							"スタンプを押して回答してください。複数のスタンプを押した場合は、最後に押したスタンプが回答になります",
+					},
+				},
+				stamps: [][2]string{
+					{quickPollMessageID, quickPollStampOne},
+					{quickPollMessageID, quickPollStampTwo},
+				},
+			},
+		},
+		{
+			description: "すでに設定されているので409",
+			params:      validParams,
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPoll(gomock.Any(), 1).
+					Return(newQuickPollTestPoll(), nil)
+			},
+			expect: expect{
+				isErr: true,
+				code:  http.StatusConflict,
+			},
+		},
+		{
+			description: "匿名のアンケートなので400",
+			params:      validParams,
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPoll(gomock.Any(), 1).
+					Return(nil, model.ErrRecordNotFound)
+				mocks.questionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, IsPublished: true, IsAnonymous: true}, nil, nil, nil, nil, nil, nil, nil, nil)
+			},
+			expect: expect{
+				isErr: true,
+				code:  http.StatusBadRequest,
+			},
+		},
+		{
+			description: "単一選択の質問1つだけのアンケートでないので400",
+			params:      validParams,
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPoll(gomock.Any(), 1).
+					Return(nil, model.ErrRecordNotFound)
+				mocks.questionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, IsPublished: true}, nil, nil, nil, nil, nil, nil, nil, nil)
+				mocks.question.
+					EXPECT().
+					GetQuestions(gomock.Any(), 1).
+					Return([]model.Questions{
+						{ID: 5, QuestionnaireID: 1, Type: "MultipleChoice"},
+						{ID: 6, QuestionnaireID: 1, Type: "Text"},
+					}, nil)
+			},
+			expect: expect{
+				isErr: true,
+				code:  http.StatusBadRequest,
+			},
+		},
+		{
+			description: "スタンプの数が選択肢と違うので400",
+			params: openapi.PostQuestionnaireQuickPollJSONRequestBody{
+				ChannelId: uuid.MustParse(quickPollChannelID),
+				Stamps:    []openapi_types.UUID{uuid.MustParse(quickPollStampOne)},
+			},
+			setup: func(mocks quickPollMocks) {
+				mocks.quickPoll.
+					EXPECT().
+					GetQuickPoll(gomock.Any(), 1).
+					Return(nil, model.ErrRecordNotFound)
+				mocks.questionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(gomock.Any(), 1).
+					Return(&model.Questionnaires{ID: 1, IsPublished: true}, nil, nil, nil, nil, nil, nil, nil, nil)
+				mocks.question.
+					EXPECT().
+					GetQuestions(gomock.Any(), 1).
+					Return([]model.Questions{{ID: 5, QuestionnaireID: 1, Type: "MultipleChoice"}}, nil)
+				mocks.option.
+					EXPECT().
+					GetOptions(gomock.Any(), []int{5}).
+					Return(quickPollOptions, nil)
+			},
+			expect: expect{
+				isErr: true,
+				code:  http.StatusBadRequest,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+
+		mocks := newQuickPollMocks(ctrl)
+		testCase.setup(mocks)
+
+		bot := &recordingBot{messages: []recordingBotMessage{}}
+		quickPoll := NewQuickPoll(mocks.quickPoll, mocks.questionnaire, mocks.question, mocks.option, mocks.respondent, mocks.response, mocks.transaction, nil, bot)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/questionnaires/1/quickPoll", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		res, err := quickPoll.PostQuestionnaireQuickPoll(c, 1, "mazrean", testCase.params)
+		if testCase.expect.isErr {
+			var httpError *echo.HTTPError
+			if assert.True(t, errors.As(err, &httpError), testCase.description, "error type") {
+				assert.Equal(t, testCase.expect.code, httpError.Code, testCase.description, "status code")
+			}
+		} else if assert.NoError(t, err, testCase.description, "no error") {
+			assert.Equal(t, quickPollMessageID, res.MessageId.String(), testCase.description, "message id")
+			if assert.Len(t, res.Stamps, 2, testCase.description, "stamps") {
+				assert.Equal(t, "賛成", res.Stamps[0].Option, testCase.description, "option")
+			}
+		}
+		if testCase.expect.messages == nil {
+			assert.Empty(t, bot.messages, testCase.description, "messages")
+		} else {
+			assert.Equal(t, testCase.expect.messages, bot.messages, testCase.description, "messages")
+		}
+		assert.Equal(t, testCase.expect.stamps, bot.stamps, testCase.description, "stamps")
+
+		ctrl.Finish()
+	}
+}
@@ -0,0 +1,367 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/openapi"
+	"gopkg.in/guregu/null.v4"
+)
+
+// errInvalidQuizAnswer クイズの正解や配点の設定が不正
+var errInvalidQuizAnswer = errors.New("invalid quiz answer")
+
+// errQuizAnswerRevealed 回答者にクイズの得点と正解を見せている
+var errQuizAnswerRevealed = errors.New("quiz answer revealed")
+
+// quizQuestion クイズの正解を取り出せる質問
+// 追加する質問(openapi.NewQuestion)と編集する質問(openapi.Question)の両方を扱う
+type quizQuestion interface {
+	AsQuestionSettingsText() (openapi.QuestionSettingsText, error)
+	AsQuestionSettingsTextLong() (openapi.QuestionSettingsTextLong, error)
+	AsQuestionSettingsNumber() (openapi.QuestionSettingsNumber, error)
+	AsQuestionSettingsSingleChoice() (openapi.QuestionSettingsSingleChoice, error)
+	AsQuestionSettingsMultipleChoice() (openapi.QuestionSettingsMultipleChoice, error)
+}
+
+// isQuizAnswerReadable クイズの得点と正解を見せるか
+// 運営にはいつでも見せる。回答者には回答を提出した時点で見せるが、回答期限まで見せない設定の場合は回答期限を過ぎてから見せる
+func isQuizAnswerReadable(responseReadPrivilegeInfo *model.ResponseReadPrivilegeInfo) bool {
+	if responseReadPrivilegeInfo.IsAdministrator {
+		return true
+	}
+	if !responseReadPrivilegeInfo.IsQuiz || !responseReadPrivilegeInfo.IsRespondent {
+		return false
+	}
+	if responseReadPrivilegeInfo.IsQuizAnswerHiddenUntilDue {
+		resTimeLimit := responseReadPrivilegeInfo.ResTimeLimit
+		return resTimeLimit.Valid && resTimeLimit.Time.Before(time.Now())
+	}
+
+	return true
+}
+
+// checkQuizAnswerNotRevealed respondentIDのユーザーにクイズの得点と正解を見せている場合はエラーを返す
+// 正解を見てから回答を直して満点を取れないよう、正解を見られる回答者には回答の編集と提出をさせない
+func (r *Response) checkQuizAnswerNotRevealed(ctx context.Context, questionnaireID int, respondentID string) error {
+	responseReadPrivilegeInfo, err := r.IQuestionnaire.GetResponseReadPrivilegeInfoByQuestionnaireID(ctx, respondentID, questionnaireID)
+	if err != nil {
+		return fmt.Errorf("failed to get response read privilege info: %w", err)
+	}
+
+	// 運営はいつでも正解を見られるため、回答者として正解を見せているかで判断する
+	responseReadPrivilegeInfo.IsAdministrator = false
+	if isQuizAnswerReadable(responseReadPrivilegeInfo) {
+		return errQuizAnswerRevealed
+	}
+
+	return nil
+}
+
+// canReadQuizAnswer userIDのユーザーにクイズの得点と正解を見せるか
+func (r *Response) canReadQuizAnswer(ctx context.Context, questionnaireID int, userID string) (bool, error) {
+	if userID == "" {
+		return false, nil
+	}
+
+	responseReadPrivilegeInfo, err := r.IQuestionnaire.GetResponseReadPrivilegeInfoByQuestionnaireID(ctx, userID, questionnaireID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get response read privilege info: %w", err)
+	}
+
+	return isQuizAnswerReadable(responseReadPrivilegeInfo), nil
+}
+
+// updateQuizSettings アンケートのクイズの設定を更新する
+// nilの設定はbeforeのまま変更しない
+func (q *Questionnaire) updateQuizSettings(ctx context.Context, questionnaireID int, before model.Questionnaires, isQuiz *bool, isQuizAnswerHiddenUntilDue *bool) error {
+	if isQuiz == nil && isQuizAnswerHiddenUntilDue == nil {
+		return nil
+	}
+	if isQuiz == nil {
+		isQuiz = &before.IsQuiz
+	}
+	if isQuizAnswerHiddenUntilDue == nil {
+		isQuizAnswerHiddenUntilDue = &before.IsQuizAnswerHiddenUntilDue
+	}
+	err := q.UpdateQuestionnaireQuizSettings(ctx, questionnaireID, *isQuiz, *isQuizAnswerHiddenUntilDue)
+	if err != nil && !errors.Is(err, model.ErrNoRecordUpdated) {
+		return fmt.Errorf("failed to update questionnaire quiz settings: %w", err)
+	}
+
+	return nil
+}
+
+// updateQuizAnswer 質問の配点とクイズの正解を更新する
+// 正解が指定されていない場合は、正解を取り除く
+func (q *Questionnaire) updateQuizAnswer(ctx context.Context, questionID int, questionType string, points *int, question quizQuestion) error {
+	questionPoints := 1
+	if points != nil {
+		questionPoints = *points
+	}
+	if questionPoints < 0 {
+		return fmt.Errorf("negative points: %w", errInvalidQuizAnswer)
+	}
+	err := q.UpdateQuestionPoints(ctx, questionID, questionPoints)
+	if err != nil && !errors.Is(err, model.ErrNoRecordUpdated) {
+		return fmt.Errorf("failed to update points: %w", err)
+	}
+
+	switch questionType {
+	case "MultipleChoice":
+		settings, err := question.AsQuestionSettingsSingleChoice()
+		if err != nil {
+			return fmt.Errorf("failed to get question settings: %w", err)
+		}
+		return q.updateCorrectOptions(ctx, questionID, settings.Options, settings.CorrectOptions)
+	case "Checkbox":
+		settings, err := question.AsQuestionSettingsMultipleChoice()
+		if err != nil {
+			return fmt.Errorf("failed to get question settings: %w", err)
+		}
+		return q.updateCorrectOptions(ctx, questionID, settings.Options, settings.CorrectOptions)
+	case "Text":
+		settings, err := question.AsQuestionSettingsText()
+		if err != nil {
+			return fmt.Errorf("failed to get question settings: %w", err)
+		}
+		return q.updateCorrectAnswerPattern(ctx, questionID, settings.CorrectAnswerPattern)
+	case "TextArea":
+		settings, err := question.AsQuestionSettingsTextLong()
+		if err != nil {
+			return fmt.Errorf("failed to get question settings: %w", err)
+		}
+		return q.updateCorrectAnswerPattern(ctx, questionID, settings.CorrectAnswerPattern)
+	case "Number":
+		settings, err := question.AsQuestionSettingsNumber()
+		if err != nil {
+			return fmt.Errorf("failed to get question settings: %w", err)
+		}
+		correctMinValue := formatNumberBound(settings.CorrectMinValue)
+		correctMaxValue := formatNumberBound(settings.CorrectMaxValue)
+		err = q.IValidation.CheckNumberValid(correctMinValue, correctMaxValue)
+		if err != nil {
+			return fmt.Errorf("invalid correct number range (%w): %w", err, errInvalidQuizAnswer)
+		}
+		err = q.IValidation.UpdateValidationAnswer(ctx, questionID, model.Validations{
+			AnswerMinBound: correctMinValue,
+			AnswerMaxBound: correctMaxValue,
+		})
+		if err != nil && !errors.Is(err, model.ErrNoRecordUpdated) {
+			return fmt.Errorf("failed to update validation answer: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (q *Questionnaire) updateCorrectOptions(ctx context.Context, questionID int, options []string, correctOptions *[]string) error {
+	if correctOptions == nil {
+		correctOptions = &[]string{}
+	}
+
+	optionSet := make(map[string]struct{}, len(options))
+	for _, option := range options {
+		optionSet[option] = struct{}{}
+	}
+	for _, correctOption := range *correctOptions {
+		if _, ok := optionSet[correctOption]; !ok {
+			return fmt.Errorf("correct option %q is not in options: %w", correctOption, errInvalidQuizAnswer)
+		}
+	}
+
+	err := q.UpdateCorrectOptions(ctx, questionID, *correctOptions)
+	if err != nil {
+		return fmt.Errorf("failed to update correct options: %w", err)
+	}
+
+	return nil
+}
+
+func (q *Questionnaire) updateCorrectAnswerPattern(ctx context.Context, questionID int, correctAnswerPattern *string) error {
+	pattern := ""
+	if correctAnswerPattern != nil {
+		pattern = *correctAnswerPattern
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("invalid correct answer pattern (%w): %w", err, errInvalidQuizAnswer)
+	}
+
+	err := q.IValidation.UpdateValidationAnswer(ctx, questionID, model.Validations{
+		AnswerRegexPattern: pattern,
+	})
+	if err != nil && !errors.Is(err, model.ErrNoRecordUpdated) {
+		return fmt.Errorf("failed to update validation answer: %w", err)
+	}
+
+	return nil
+}
+
+// rescoreResponses アンケートの提出済みの回答をすべて採点し直す
+// クイズでなくなった場合は得点を取り除く
+func (q *Questionnaire) rescoreResponses(ctx context.Context, questionnaireID int, isQuiz bool) error {
+	isDraft := false
+	respondentDetails, _, err := q.GetRespondentDetails(ctx, questionnaireID, "", false, "", &isDraft, nil, 0, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get respondent details: %w", err)
+	}
+	if len(respondentDetails) == 0 {
+		return nil
+	}
+
+	if !isQuiz {
+		for _, respondentDetail := range respondentDetails {
+			err = q.UpdateScore(ctx, respondentDetail.ResponseID, null.Int{})
+			if err != nil {
+				return fmt.Errorf("failed to remove score: %w", err)
+			}
+		}
+		return nil
+	}
+
+	questions, options, validations, err := q.getQuizAnswers(ctx, questionnaireID)
+	if err != nil {
+		return err
+	}
+	for _, respondentDetail := range respondentDetails {
+		responseMetas := []*model.ResponseMeta{}
+		for _, responseBody := range respondentDetail.Responses {
+			switch responseBody.QuestionType {
+			case "MultipleChoice", "Checkbox":
+				for _, optionResponse := range responseBody.OptionResponse {
+					responseMetas = append(responseMetas, &model.ResponseMeta{
+						QuestionID: responseBody.QuestionID,
+						Data:       optionResponse,
+					})
+				}
+			default:
+				if responseBody.Body.Valid {
+					responseMetas = append(responseMetas, &model.ResponseMeta{
+						QuestionID: responseBody.QuestionID,
+						Data:       responseBody.Body.String,
+					})
+				}
+			}
+		}
+
+		score := scoreResponse(questions, options, validations, responseMetas)
+		err = q.UpdateScore(ctx, respondentDetail.ResponseID, null.IntFrom(int64(score)))
+		if err != nil {
+			return fmt.Errorf("failed to update score: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// getQuizAnswers 採点に使う質問と選択肢、validationを取得する
+func (r *Response) getQuizAnswers(ctx context.Context, questionnaireID int) ([]model.Questions, []model.Options, []model.Validations, error) {
+	questions, err := r.IQuestion.GetQuestions(ctx, questionnaireID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get questions: %w", err)
+	}
+	questionIDs := make([]int, 0, len(questions))
+	for _, question := range questions {
+		questionIDs = append(questionIDs, question.ID)
+	}
+	options, err := r.IOption.GetOptions(ctx, questionIDs)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get options: %w", err)
+	}
+	validations, err := r.IValidation.GetValidations(ctx, questionIDs)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get validations: %w", err)
+	}
+
+	return questions, options, validations, nil
+}
+
+// scoreResponse クイズの回答を採点する
+// 正解が設定されていない質問は、どう回答しても得点にならない
+func scoreResponse(questions []model.Questions, options []model.Options, validations []model.Validations, responseMetas []*model.ResponseMeta) int {
+	answers := make(map[int][]string, len(questions))
+	for _, responseMeta := range responseMetas {
+		answers[responseMeta.QuestionID] = append(answers[responseMeta.QuestionID], responseMeta.Data)
+	}
+	correctOptions := make(map[int]map[string]struct{}, len(questions))
+	for _, option := range options {
+		if !option.IsCorrect {
+			continue
+		}
+		if _, ok := correctOptions[option.QuestionID]; !ok {
+			correctOptions[option.QuestionID] = map[string]struct{}{}
+		}
+		correctOptions[option.QuestionID][option.Body] = struct{}{}
+	}
+	validationMap := make(map[int]model.Validations, len(validations))
+	for _, validation := range validations {
+		validationMap[validation.QuestionID] = validation
+	}
+
+	score := 0
+	for _, question := range questions {
+		if isCorrectAnswer(question.Type, correctOptions[question.ID], validationMap[question.ID], answers[question.ID]) {
+			score += question.Points
+		}
+	}
+
+	return score
+}
+
+func isCorrectAnswer(questionType string, correctOptions map[string]struct{}, validation model.Validations, answers []string) bool {
+	if len(answers) == 0 {
+		return false
+	}
+
+	switch questionType {
+	case "Text", "TextArea":
+		if validation.AnswerRegexPattern == "" {
+			return false
+		}
+		matched, err := regexp.MatchString(validation.AnswerRegexPattern, answers[0])
+		return err == nil && matched
+	case "Number":
+		if validation.AnswerMinBound == "" && validation.AnswerMaxBound == "" {
+			return false
+		}
+		value, err := strconv.ParseFloat(answers[0], 64)
+		if err != nil {
+			return false
+		}
+		if validation.AnswerMinBound != "" {
+			minBound, err := strconv.ParseFloat(validation.AnswerMinBound, 64)
+			if err != nil || value < minBound {
+				return false
+			}
+		}
+		if validation.AnswerMaxBound != "" {
+			maxBound, err := strconv.ParseFloat(validation.AnswerMaxBound, 64)
+			if err != nil || value > maxBound {
+				return false
+			}
+		}
+		return true
+	case "MultipleChoice":
+		_, ok := correctOptions[answers[0]]
+		return ok
+	case "Checkbox":
+		if len(correctOptions) == 0 {
+			return false
+		}
+		selected := make(map[string]struct{}, len(answers))
+		for _, answer := range answers {
+			if _, ok := correctOptions[answer]; !ok {
+				return false
+			}
+			selected[answer] = struct{}{}
+		}
+		return len(selected) == len(correctOptions)
+	}
+
+	return false
+}
@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/model"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestScoreResponse(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	questions := []model.Questions{
+		{ID: 1, Type: "Text", Points: 1},
+		{ID: 2, Type: "Number", Points: 2},
+		{ID: 3, Type: "MultipleChoice", Points: 3},
+		{ID: 4, Type: "Checkbox", Points: 4},
+		{ID: 5, Type: "TextArea", Points: 5},
+	}
+	options := []model.Options{
+		{QuestionID: 3, Body: "a", IsCorrect: true},
+		{QuestionID: 3, Body: "b"},
+		{QuestionID: 4, Body: "a", IsCorrect: true},
+		{QuestionID: 4, Body: "b", IsCorrect: true},
+		{QuestionID: 4, Body: "c"},
+	}
+	validations := []model.Validations{
+		{QuestionID: 1, AnswerRegexPattern: "^traP$"},
+		{QuestionID: 2, AnswerMinBound: "10", AnswerMaxBound: "20"},
+	}
+
+	type test struct {
+		description   string
+		responseMetas []*model.ResponseMeta
+		score         int
+	}
+
+	testCases := []test{
+		{
+			description: "すべて正解",
+			responseMetas: []*model.ResponseMeta{
+				{QuestionID: 1, Data: "traP"},
+				{QuestionID: 2, Data: "15"},
+				{QuestionID: 3, Data: "a"},
+				{QuestionID: 4, Data: "a"},
+				{QuestionID: 4, Data: "b"},
+				{QuestionID: 5, Data: "正解のない質問"},
+			},
+			score: 10,
+		},
+		{
+			description: "すべて不正解",
+			responseMetas: []*model.ResponseMeta{
+				{QuestionID: 1, Data: "traQ"},
+				{QuestionID: 2, Data: "21"},
+				{QuestionID: 3, Data: "b"},
+				{QuestionID: 4, Data: "a"},
+				{QuestionID: 4, Data: "c"},
+			},
+			score: 0,
+		},
+		{
+			description: "数値は範囲の境界も正解",
+			responseMetas: []*model.ResponseMeta{
+				{QuestionID: 2, Data: "10"},
+			},
+			score: 2,
+		},
+		{
+			description: "Checkboxは正解の選択肢が足りないと不正解",
+			responseMetas: []*model.ResponseMeta{
+				{QuestionID: 4, Data: "a"},
+			},
+			score: 0,
+		},
+		{
+			description:   "回答がなければ0点",
+			responseMetas: []*model.ResponseMeta{},
+			score:         0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		score := scoreResponse(questions, options, validations, testCase.responseMetas)
+		assertion.Equal(testCase.score, score, testCase.description)
+	}
+}
+
+func TestIsQuizAnswerReadable(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	past := null.NewTime(time.Now().Add(-time.Hour), true)
+	future := null.NewTime(time.Now().Add(time.Hour), true)
+
+	type test struct {
+		description string
+		info        model.ResponseReadPrivilegeInfo
+		readable    bool
+	}
+
+	testCases := []test{
+		{
+			description: "運営にはクイズでなくても見せる",
+			info:        model.ResponseReadPrivilegeInfo{IsAdministrator: true},
+			readable:    true,
+		},
+		{
+			description: "回答者にはクイズなら見せる",
+			info:        model.ResponseReadPrivilegeInfo{IsQuiz: true, IsRespondent: true},
+			readable:    true,
+		},
+		{
+			description: "クイズでなければ回答者にも見せない",
+			info:        model.ResponseReadPrivilegeInfo{IsRespondent: true},
+			readable:    false,
+		},
+		{
+			description: "回答していない人には見せない",
+			info:        model.ResponseReadPrivilegeInfo{IsQuiz: true, IsTarget: true},
+			readable:    false,
+		},
+		{
+			description: "回答期限まで見せない設定で回答期限前なら見せない",
+			info:        model.ResponseReadPrivilegeInfo{IsQuiz: true, IsQuizAnswerHiddenUntilDue: true, IsRespondent: true, ResTimeLimit: future},
+			readable:    false,
+		},
+		{
+			description: "回答期限まで見せない設定で回答期限後なら見せる",
+			info:        model.ResponseReadPrivilegeInfo{IsQuiz: true, IsQuizAnswerHiddenUntilDue: true, IsRespondent: true, ResTimeLimit: past},
+			readable:    true,
+		},
+		{
+			description: "回答期限まで見せない設定で回答期限がなければ見せない",
+			info:        model.ResponseReadPrivilegeInfo{IsQuiz: true, IsQuizAnswerHiddenUntilDue: true, IsRespondent: true},
+			readable:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assertion.Equal(testCase.readable, isQuizAnswerReadable(&testCase.info), testCase.description)
+	}
+}
@@ -5,11 +5,16 @@ import (
 	"log"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/btree"
+	"github.com/traPtitech/anke-to/i18n"
 	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/tracing"
 	"github.com/traPtitech/anke-to/traq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var jst = func() *time.Location {
@@ -33,6 +38,7 @@ type Reminder struct {
 	mu       sync.Mutex
 	Wg       sync.WaitGroup
 	wakeUpCh chan struct{}
+	running  atomic.Bool
 }
 
 func jobLess(a, b *Job) bool {
@@ -55,11 +61,6 @@ func NewReminder() *Reminder {
 	}
 }
 
-var (
-	reminderTimingMinutes = []int{10080, 7200, 4320, 1440, 720, 360, 60}
-	reminderTimingStrings = []string{"1週間", "5日", "3日", "1日", "12時間", "6時間", "1時間"}
-)
-
 func (re *Reminder) ReminderInit() {
 	questionnaires, err := model.NewQuestionnaire().GetQuestionnairesInfoForReminder(context.Background())
 	if err != nil {
@@ -73,11 +74,20 @@ func (re *Reminder) ReminderInit() {
 	}
 }
 
-func (re *Reminder) ReminderWorker() {
+// ReminderWorker 期限が来たリマインダーを実行する
+// ctxが終了すると新しいリマインダーの実行を止めて返る。実行中のリマインダーはWgで待つ
+func (re *Reminder) ReminderWorker(ctx context.Context) {
+	re.running.Store(true)
+	defer re.running.Store(false)
+
 	for {
 		job := re.peek()
 		if job == nil {
-			<-re.wakeUpCh
+			select {
+			case <-ctx.Done():
+				return
+			case <-re.wakeUpCh:
+			}
 			continue
 		}
 
@@ -85,6 +95,9 @@ func (re *Reminder) ReminderWorker() {
 		if wait > 0 {
 			timer := time.NewTimer(wait)
 			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
 			case <-timer.C:
 			case <-re.wakeUpCh:
 				if !timer.Stop() {
@@ -102,6 +115,7 @@ func (re *Reminder) ReminderWorker() {
 			continue
 		}
 
+		reminderJobsFired.Inc()
 		re.Wg.Add(1)
 		go func() {
 			defer re.Wg.Done()
@@ -110,10 +124,14 @@ func (re *Reminder) ReminderWorker() {
 	}
 }
 
+// IsRunning ReminderWorkerが動いているか
+func (re *Reminder) IsRunning() bool {
+	return re.running.Load()
+}
+
 func (re *Reminder) PushReminder(questionnaireID int, limit *time.Time) error {
 	for i := range reminderTimingMinutes {
 		timing := reminderTimingMinutes[i]
-		timingStrings := reminderTimingStrings[i]
 		remindTimeStamp := reminderTimestamp(*limit, timing)
 		if remindTimeStamp.After(time.Now()) {
 			re.push(&Job{
@@ -121,8 +139,9 @@ func (re *Reminder) PushReminder(questionnaireID int, limit *time.Time) error {
 				QuestionnaireID: questionnaireID,
 				TimingIndex:     i,
 				Action: func() {
-					err := reminderAction(questionnaireID, timingStrings)
+					err := reminderAction(questionnaireID, time.Duration(timing)*time.Minute)
 					if err != nil {
+						reminderJobsFailed.Inc()
 						log.Printf("Failed to execute reminderAction for questionnaireID %d: %v", questionnaireID, err)
 					}
 				},
@@ -163,6 +182,7 @@ func (re *Reminder) DeleteReminder(questionnaireID int) error {
 			re.tree.Delete(job)
 		}
 		delete(re.index, questionnaireID)
+		reminderQueueDepth.Set(float64(re.tree.Len()))
 	}
 	re.mu.Unlock()
 
@@ -184,7 +204,9 @@ func (re *Reminder) push(job *Job) {
 	re.mu.Lock()
 	re.tree.ReplaceOrInsert(job)
 	re.index[job.QuestionnaireID] = append(re.index[job.QuestionnaireID], job)
+	reminderQueueDepth.Set(float64(re.tree.Len()))
 	re.mu.Unlock()
+	reminderJobsScheduled.Inc()
 
 	re.notifyWorker()
 }
@@ -220,6 +242,7 @@ func (re *Reminder) popDue(now time.Time) *Job {
 	if len(re.index[earliest.QuestionnaireID]) == 0 {
 		delete(re.index, earliest.QuestionnaireID)
 	}
+	reminderQueueDepth.Set(float64(re.tree.Len()))
 	return earliest
 }
 
@@ -230,8 +253,17 @@ func (re *Reminder) notifyWorker() {
 	}
 }
 
-func reminderAction(questionnaireID int, leftTimeText string) error {
-	ctx := context.Background()
+// reminderAction 回答期限まで残りleftTimeのリマインダーを投稿する
+// メッセージはアンケートに設定された言語で投稿する
+func reminderAction(questionnaireID int, leftTime time.Duration) (err error) {
+	ctx, span := tracing.Start(context.Background(), "reminderAction", trace.WithAttributes(
+		attribute.Int("anke-to.questionnaire.id", questionnaireID),
+	))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
 	questionnaire, _, _, _, administrators, _, _, respondants, err := model.NewQuestionnaire().GetQuestionnaireInfo(ctx, questionnaireID)
 	if err != nil {
 		return err
@@ -241,11 +273,34 @@ func reminderAction(questionnaireID int, leftTimeText string) error {
 		return nil
 	}
 
-	reminderTargetOverrides, err := model.NewReminderTarget().GetReminderTargets(ctx, questionnaireID)
+	reminderTargets, err := getReminderTargets(ctx, questionnaire, respondants)
 	if err != nil {
 		return err
 	}
 
+	language := i18n.ParseLanguage(questionnaire.Language)
+	reminderMessages, err := createReminderMessages(language, questionnaire.ReminderTemplate.String, questionnaireID, questionnaire.Title, questionnaire.Description, administrators, questionnaire.ResTimeLimit.Time, reminderTargets, i18n.FormatDuration(language, leftTime))
+	if err != nil {
+		return err
+	}
+	wh := traq.NewWebhook()
+	for _, msg := range reminderMessages {
+		err = wh.PostMessage(ctx, msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getReminderTargets リマインダーでメンションする、まだ回答していない対象者を返す
+func getReminderTargets(ctx context.Context, questionnaire *model.Questionnaires, respondants []string) ([]string, error) {
+	reminderTargetOverrides, err := model.NewReminderTarget().GetReminderTargets(ctx, questionnaire.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	respondantSet := make(map[string]struct{}, len(respondants))
 	for _, respondent := range respondants {
 		respondantSet[respondent] = struct{}{}
@@ -272,14 +327,5 @@ func reminderAction(questionnaireID int, leftTimeText string) error {
 	}
 	sort.Strings(reminderTargets)
 
-	reminderMessages := createReminderMessage(questionnaireID, questionnaire.Title, questionnaire.Description, administrators, questionnaire.ResTimeLimit.Time, reminderTargets, leftTimeText)
-	wh := traq.NewWebhook()
-	for _, msg := range reminderMessages {
-		err = wh.PostMessage(msg)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return reminderTargets, nil
 }
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -362,7 +363,9 @@ func TestReminderWorkerReschedulesEarlierJob(t *testing.T) {
 		},
 	})
 
-	go re.ReminderWorker()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go re.ReminderWorker(ctx)
 
 	time.Sleep(100 * time.Millisecond)
 
@@ -390,6 +393,47 @@ func TestReminderWorkerReschedulesEarlierJob(t *testing.T) {
 	}
 }
 
+func TestReminderWorkerStopsOnContextDone(t *testing.T) {
+	re := NewReminder()
+
+	executedCh := make(chan struct{}, 1)
+	re.push(&Job{
+		Timestamp:       time.Now().Add(200 * time.Millisecond),
+		QuestionnaireID: 1,
+		Action: func() {
+			executedCh <- struct{}{}
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	doneCh := make(chan struct{})
+	go func() {
+		re.ReminderWorker(ctx)
+		close(doneCh)
+	}()
+
+	assert.Eventually(t, re.IsRunning, 1*time.Second, 10*time.Millisecond, "worker running")
+
+	cancel()
+
+	select {
+	case <-doneCh:
+	case <-time.After(1 * time.Second):
+		t.Fatal("reminder worker did not stop")
+	}
+	assert.False(t, re.IsRunning(), "worker stopped")
+
+	select {
+	case <-executedCh:
+		t.Fatal("reminder was executed after the worker stopped")
+	case <-time.After(400 * time.Millisecond):
+	}
+
+	status, err := re.CheckRemindStatus(1)
+	assert.NoError(t, err)
+	assert.True(t, status, "job remains")
+}
+
 func TestPush(t *testing.T) {
 	t.Parallel()
 
@@ -562,6 +606,6 @@ func TestReminderActionUnpublished(t *testing.T) {
 	detail, err := q.PostQuestionnaire(ctx, params)
 	require.NoError(t, err)
 
-	err = reminderAction(detail.QuestionnaireId, "5分")
+	err = reminderAction(detail.QuestionnaireId, 5*time.Minute)
 	assert.NoError(t, err, "reminderAction should return nil for unpublished questionnaire")
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/traPtitech/anke-to/model"
 	"github.com/traPtitech/anke-to/openapi"
+	"gopkg.in/guregu/null.v4"
 )
 
 // Response Responseの構造体
@@ -23,6 +24,8 @@ type Response struct {
 	model.IValidation
 	model.IScaleLabel
 	model.ITransaction
+	*OutgoingWebhook
+	*ResponseStream
 }
 
 func NewResponse(
@@ -35,17 +38,21 @@ func NewResponse(
 	validation model.IValidation,
 	scaleLabel model.IScaleLabel,
 	transaction model.ITransaction,
+	outgoingWebhook *OutgoingWebhook,
+	responseStream *ResponseStream,
 ) *Response {
 	return &Response{
-		IQuestionnaire: questionnaire,
-		IRespondent:    respondent,
-		IResponse:      response,
-		ITarget:        target,
-		IQuestion:      question,
-		IOption:        option,
-		IValidation:    validation,
-		IScaleLabel:    scaleLabel,
-		ITransaction:   transaction,
+		IQuestionnaire:  questionnaire,
+		IRespondent:     respondent,
+		IResponse:       response,
+		ITarget:         target,
+		IQuestion:       question,
+		IOption:         option,
+		IValidation:     validation,
+		IScaleLabel:     scaleLabel,
+		ITransaction:    transaction,
+		OutgoingWebhook: outgoingWebhook,
+		ResponseStream:  responseStream,
 	}
 }
 
@@ -70,9 +77,21 @@ func (r *Response) GetMyResponses(ctx echo.Context, params openapi.GetMyResponse
 		questionnaireIDs = *params.QuestionnaireIDs
 	}
 
-	responseGroups, pageMax, err := r.IRespondent.GetMyResponseGroups(ctx.Request().Context(), userID, questionnaireIDs, params.IsDraft, pageNum)
+	var limit int
+	if params.Limit == nil {
+		limit = model.DefaultPageLimit
+	} else {
+		limit = *params.Limit
+	}
+	cursor, err := parseCursor(params.Cursor)
+	if err != nil {
+		ctx.Logger().Infof("invalid cursor: %+v", err)
+		return openapi.ResponsesWithQuestionnaireInfo{}, newAPIError(http.StatusBadRequest, openapi.InvalidCursor, err.Error())
+	}
+
+	responseGroups, pageMax, nextCursor, err := r.IRespondent.GetMyResponseGroups(ctx.Request().Context(), userID, questionnaireIDs, params.IsDraft, pageNum, limit, cursor)
 	if err != nil {
-		if errors.Is(err, model.ErrTooLargePageNum) {
+		if errors.Is(err, model.ErrTooLargePageNum) || errors.Is(err, model.ErrInvalidCursor) {
 			ctx.Logger().Infof("invalid myResponses params: %+v", err)
 			return openapi.ResponsesWithQuestionnaireInfo{}, echo.NewHTTPError(http.StatusBadRequest, err)
 		}
@@ -80,6 +99,7 @@ func (r *Response) GetMyResponses(ctx echo.Context, params openapi.GetMyResponse
 		return openapi.ResponsesWithQuestionnaireInfo{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get questionnaire responses: %w", err))
 	}
 	res.PageMax = pageMax
+	res.NextCursor = setNextPageLink(ctx, nextCursor)
 
 	for _, responseGroup := range responseGroups {
 		var responseDueDateTime *time.Time
@@ -98,6 +118,12 @@ func (r *Response) GetMyResponses(ctx echo.Context, params openapi.GetMyResponse
 			},
 			Responses: []openapi.Response{},
 		}
+		canReadScore := isQuizAnswerReadable(&model.ResponseReadPrivilegeInfo{
+			ResTimeLimit:               responseGroup.QuestionnaireInfo.ResponseDueDateTime,
+			IsQuiz:                     responseGroup.QuestionnaireInfo.IsQuiz,
+			IsQuizAnswerHiddenUntilDue: responseGroup.QuestionnaireInfo.IsQuizAnswerHiddenUntilDue,
+			IsRespondent:               true,
+		})
 
 		for _, responseDetail := range responseGroup.Responses {
 			respondent := userID
@@ -107,6 +133,9 @@ func (r *Response) GetMyResponses(ctx echo.Context, params openapi.GetMyResponse
 				return openapi.ResponsesWithQuestionnaireInfo{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to convert respondent detail into response: %w", err))
 			}
 
+			if !canReadScore {
+				response.Score = nil
+			}
 			groupItem.Responses = append(groupItem.Responses, response)
 		}
 
@@ -116,12 +145,14 @@ func (r *Response) GetMyResponses(ctx echo.Context, params openapi.GetMyResponse
 	return res, nil
 }
 
-func (r *Response) GetResponse(ctx echo.Context, responseID openapi.ResponseIDInPath) (openapi.Response, error) {
+// GetResponse 回答を取得する
+// 回答者本人とアンケートのオーナー以外には、回答を見せない質問への回答を取り除いて返す
+func (r *Response) GetResponse(ctx echo.Context, responseID openapi.ResponseIDInPath, userID string) (openapi.Response, error) {
 	responseDetail, err := r.IRespondent.GetRespondentDetail(ctx.Request().Context(), responseID)
 	if err != nil {
 		if errors.Is(err, model.ErrRecordNotFound) {
 			ctx.Logger().Errorf("failed to find response by response ID: %+v", err)
-			return openapi.Response{}, echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("failed to find response by response ID: %w", err))
+			return openapi.Response{}, newAPIError(http.StatusNotFound, openapi.ResponseNotFound, "response not found")
 		}
 		ctx.Logger().Errorf("failed to get respondent detail: %+v", err)
 		return openapi.Response{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get respondent detail: %w", err))
@@ -133,7 +164,100 @@ func (r *Response) GetResponse(ctx echo.Context, responseID openapi.ResponseIDIn
 		return openapi.Response{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to convert respondent detail into response: %w", err))
 	}
 
-	return res, nil
+	respondent, err := r.IRespondent.GetRespondent(ctx.Request().Context(), responseID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get respondent: %+v", err)
+		return openapi.Response{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get respondent: %w", err))
+	}
+	if respondent.IsRespondedBy(userID) {
+		canReadScore, err := r.canReadQuizAnswer(ctx.Request().Context(), responseDetail.QuestionnaireID, userID)
+		if err != nil {
+			ctx.Logger().Errorf("failed to check quiz answer visibility: %+v", err)
+			return openapi.Response{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check quiz answer visibility: %w", err))
+		}
+		if !canReadScore {
+			res.Score = nil
+		}
+		return res, nil
+	}
+
+	isAdministrator, err := r.isQuestionnaireAdministrator(ctx.Request().Context(), responseDetail.QuestionnaireID, userID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to check administrator: %+v", err)
+		return openapi.Response{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check administrator: %w", err))
+	}
+	if !isAdministrator {
+		res.Review = nil
+		res.Score = nil
+	}
+
+	hiddenQuestionIDs, err := r.getHiddenQuestionIDs(ctx.Request().Context(), responseDetail.QuestionnaireID, userID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get hidden question ids: %+v", err)
+		return openapi.Response{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get hidden question ids: %w", err))
+	}
+
+	return hideResponseBodies(res, hiddenQuestionIDs), nil
+}
+
+// isQuestionnaireAdministrator userIDのユーザーがアンケートの運営か
+// 回答の審査状況は運営と回答者本人にのみ見せる
+func (r *Response) isQuestionnaireAdministrator(ctx context.Context, questionnaireID int, userID string) (bool, error) {
+	if userID == "" {
+		return false, nil
+	}
+
+	responseReadPrivilegeInfo, err := r.IQuestionnaire.GetResponseReadPrivilegeInfoByQuestionnaireID(ctx, userID, questionnaireID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get response read privilege info: %w", err)
+	}
+
+	return responseReadPrivilegeInfo.IsAdministrator, nil
+}
+
+// getHiddenQuestionIDs userIDのユーザーに回答を見せない質問のIDを返す
+// アンケートのオーナーにはすべての質問の回答を見せる。userIDが空の場合は回答を見せない質問をすべて返す
+func (r *Response) getHiddenQuestionIDs(ctx context.Context, questionnaireID int, userID string) (map[int]struct{}, error) {
+	questions, err := r.IQuestion.GetQuestions(ctx, questionnaireID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questions: %w", err)
+	}
+
+	hiddenQuestionIDs := map[int]struct{}{}
+	for _, question := range questions {
+		if question.IsResponseHidden {
+			hiddenQuestionIDs[question.ID] = struct{}{}
+		}
+	}
+	if len(hiddenQuestionIDs) == 0 || userID == "" {
+		return hiddenQuestionIDs, nil
+	}
+
+	responseReadPrivilegeInfo, err := r.IQuestionnaire.GetResponseReadPrivilegeInfoByQuestionnaireID(ctx, userID, questionnaireID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get response read privilege info: %w", err)
+	}
+	if responseReadPrivilegeInfo.AdministratorRole == model.AdministratorRoleOwner {
+		return map[int]struct{}{}, nil
+	}
+
+	return hiddenQuestionIDs, nil
+}
+
+// publishResponseEvent 回答の提出・編集をWebhookとリアルタイム配信で通知する
+// 通知先では誰が見るかわからないため、回答を見せない質問への回答は取り除く
+func (r *Response) publishResponseEvent(c echo.Context, event model.WebhookEvent, response openapi.Response) {
+	hiddenQuestionIDs, err := r.getHiddenQuestionIDs(c.Request().Context(), response.QuestionnaireId, "")
+	if err != nil {
+		c.Logger().Errorf("failed to get hidden question ids: %+v", err)
+		return
+	}
+	response = hideResponseBodies(response, hiddenQuestionIDs)
+	response.Review = nil
+	response.Score = nil
+
+	r.PublishResponseEvent(c, event, response)
+	r.PublishResponseStreamEvent(c, event, response)
 }
 
 func (r *Response) DeleteResponse(ctx echo.Context, responseID openapi.ResponseIDInPath) error {
@@ -141,7 +265,7 @@ func (r *Response) DeleteResponse(ctx echo.Context, responseID openapi.ResponseI
 	if err != nil {
 		if errors.Is(err, model.ErrRecordNotFound) {
 			ctx.Logger().Errorf("failed to find response by response ID: %+v", err)
-			return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("failed to find response by response ID: %w", err))
+			return newAPIError(http.StatusNotFound, openapi.ResponseNotFound, "response not found")
 		}
 		ctx.Logger().Errorf("failed to get questionnaire limit by response ID: %+v", err)
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get questionnaire limit by response ID: %w", err))
@@ -151,6 +275,16 @@ func (r *Response) DeleteResponse(ctx echo.Context, responseID openapi.ResponseI
 		return echo.NewHTTPError(http.StatusMethodNotAllowed, fmt.Errorf("unable delete the expired response"))
 	}
 
+	respondent, err := r.IRespondent.GetRespondent(ctx.Request().Context(), responseID)
+	if err != nil {
+		if errors.Is(err, model.ErrRecordNotFound) {
+			ctx.Logger().Infof("failed to find respondent by response ID: %+v", err)
+			return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("failed to find respondent by response ID: %w", err))
+		}
+		ctx.Logger().Errorf("failed to get respondent: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get respondent: %w", err))
+	}
+
 	err = r.ITransaction.Do(ctx.Request().Context(), nil, func(c context.Context) error {
 		err := r.IRespondent.DeleteRespondent(c, responseID)
 		if err != nil {
@@ -171,15 +305,21 @@ func (r *Response) DeleteResponse(ctx echo.Context, responseID openapi.ResponseI
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to delete response: %w", err))
 	}
 
+	// 一時保存の回答は管理者から見えないので通知しない
+	if respondent.SubmittedAt.Valid {
+		r.PublishResponseDeletedEvent(ctx, respondent.QuestionnaireID, responseID)
+		r.PublishResponseStreamDeletedEvent(ctx, respondent.QuestionnaireID, responseID)
+	}
+
 	return nil
 }
 
-func (r *Response) EditResponse(ctx echo.Context, responseID openapi.ResponseIDInPath, req openapi.EditResponseJSONRequestBody) error {
+func (r *Response) EditResponse(ctx echo.Context, responseID openapi.ResponseIDInPath, req openapi.EditResponseJSONRequestBody, userID string) error {
 	limit, err := r.IQuestionnaire.GetQuestionnaireLimitByResponseID(ctx.Request().Context(), responseID)
 	if err != nil {
 		if errors.Is(err, model.ErrRecordNotFound) {
 			ctx.Logger().Infof("failed to find response by response ID: %+v", err)
-			return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("failed to find response by response ID: %w", err))
+			return newAPIError(http.StatusNotFound, openapi.ResponseNotFound, "response not found")
 		}
 		ctx.Logger().Errorf("failed to get questionnaire limit by response ID: %+v", err)
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get questionnaire limit by response ID: %w", err))
@@ -196,6 +336,29 @@ func (r *Response) EditResponse(ctx echo.Context, responseID openapi.ResponseIDI
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get respondent detail: %w", err))
 	}
 
+	// 匿名のアンケートの回答はユーザーIDを保存していないが、回答者本人の変更のみを受け付けている
+	respondentID := respondentDetail.TraqID
+	if respondentID == "" {
+		respondentID = userID
+	}
+	if req.Respondent != nil && *req.Respondent != respondentID {
+		ctx.Logger().Info("unable to change the respondent")
+		return echo.NewHTTPError(http.StatusBadRequest, "unable to change the respondent")
+	}
+	// 運営が他の人の回答を変更した場合は、代理で入力した運営として記録する
+	isProxy := respondentID != userID
+	if !isProxy {
+		err = r.checkQuizAnswerNotRevealed(ctx.Request().Context(), respondentDetail.QuestionnaireID, respondentID)
+		if errors.Is(err, errQuizAnswerRevealed) {
+			ctx.Logger().Info("unable to edit the response after the quiz answer is revealed")
+			return newAPIError(http.StatusMethodNotAllowed, openapi.QuizAnswerRevealed, "unable to edit the response after the quiz answer is revealed")
+		}
+		if err != nil {
+			ctx.Logger().Errorf("failed to check quiz answer: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to check quiz answer: %w", err))
+		}
+	}
+
 	questions, err := r.IQuestion.GetQuestions(ctx.Request().Context(), respondentDetail.QuestionnaireID)
 	if err != nil {
 		ctx.Logger().Errorf("failed to get questions: %+v", err)
@@ -205,7 +368,7 @@ func (r *Response) EditResponse(ctx echo.Context, responseID openapi.ResponseIDI
 	responseMetas, err := responseBody2ResponseMetas(req.Body, questions)
 	if err != nil {
 		ctx.Logger().Infof("invalid response body: %+v", err)
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid response body: %w", err))
+		return newAPIError(http.StatusBadRequest, openapi.InvalidResponseBody, fmt.Sprintf("invalid response body: %s", err))
 	}
 
 	// validationでチェック
@@ -306,11 +469,24 @@ func (r *Response) EditResponse(ctx echo.Context, responseID openapi.ResponseIDI
 		for _, question := range questions {
 			if questionRequired[question.ID] {
 				ctx.Logger().Errorf("required question is not answered: %+v", question.ID)
-				return echo.NewHTTPError(http.StatusBadRequest, "required question is not answered")
+				return newAPIError(http.StatusBadRequest, openapi.RequiredQuestionNotAnswered, "required question is not answered")
 			}
 		}
 	}
 
+	// クイズの場合は提出された回答を採点する
+	score := null.Int{}
+	if !req.IsDraft {
+		isQuiz, err := r.IQuestionnaire.GetQuestionnaireIsQuiz(ctx.Request().Context(), respondentDetail.QuestionnaireID)
+		if err != nil {
+			ctx.Logger().Errorf("failed to get is_quiz: %+v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get is_quiz: %w", err))
+		}
+		if isQuiz {
+			score = null.IntFrom(int64(scoreResponse(questions, options, validations, responseMetas)))
+		}
+	}
+
 	err = r.ITransaction.Do(ctx.Request().Context(), nil, func(c context.Context) error {
 		err := r.IResponse.DeleteResponse(c, responseID)
 		if err != nil {
@@ -336,6 +512,20 @@ func (r *Response) EditResponse(ctx echo.Context, responseID openapi.ResponseIDI
 			ctx.Logger().Errorf("failed to update modified at: %+v", err)
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to update modified at: %w", err))
 		}
+		if isProxy {
+			err = r.IRespondent.UpdateEnteredBy(c, responseID, userID)
+			if err != nil {
+				ctx.Logger().Errorf("failed to update entered by: %+v", err)
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to update entered by: %w", err))
+			}
+		}
+		if score.Valid {
+			err = r.IRespondent.UpdateScore(c, responseID, score)
+			if err != nil {
+				ctx.Logger().Errorf("failed to update score: %+v", err)
+				return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to update score: %w", err))
+			}
+		}
 
 		if len(responseMetas) > 0 {
 			err = r.IResponse.InsertResponses(c, responseID, responseMetas)
@@ -352,5 +542,19 @@ func (r *Response) EditResponse(ctx echo.Context, responseID openapi.ResponseIDI
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to update response: %w", err))
 	}
 
+	if !req.IsDraft {
+		event := model.WebhookEventResponseEdited
+		if !respondentDetail.SubmittedAt.Valid {
+			event = model.WebhookEventResponseSubmitted
+		}
+
+		response, err := r.GetResponse(ctx, responseID, "")
+		if err != nil {
+			ctx.Logger().Errorf("failed to get response for notification: %+v", err)
+		} else {
+			r.publishResponseEvent(ctx, event, response)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/i18n"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/openapi"
+	"github.com/traPtitech/anke-to/traq"
+	"gopkg.in/guregu/null.v4"
+)
+
+// ResponseReview 運営による回答の審査の構造体
+type ResponseReview struct {
+	model.IRespondent
+	model.IQuestionnaire
+	traq.IBot
+}
+
+// NewResponseReview ResponseReviewのコンストラクター
+func NewResponseReview(respondent model.IRespondent, questionnaire model.IQuestionnaire, bot traq.IBot) *ResponseReview {
+	return &ResponseReview{
+		IRespondent:    respondent,
+		IQuestionnaire: questionnaire,
+		IBot:           bot,
+	}
+}
+
+// maxReviewCommentLength 審査のコメントの最大文字数
+const maxReviewCommentLength = 1000
+
+// reviewStatusKeys 通知のメッセージに使う審査状況の表記のキー
+var reviewStatusKeys = map[model.ReviewStatus]i18n.Key{
+	model.ReviewStatusPending:      i18n.KeyReviewPending,
+	model.ReviewStatusAccepted:     i18n.KeyReviewAccepted,
+	model.ReviewStatusRejected:     i18n.KeyReviewRejected,
+	model.ReviewStatusNeedsChanges: i18n.KeyReviewNeedsChanges,
+}
+
+// EditResponseReview 回答の審査状況を変更する
+// 通知が指定されていて審査状況が変わった場合は、回答者にtraQのDMで通知する
+func (rr *ResponseReview) EditResponseReview(c echo.Context, responseID int, userID string, params openapi.EditResponseReviewJSONRequestBody) (openapi.ResponseReview, error) {
+	ctx := c.Request().Context()
+
+	status := model.ReviewStatus(params.Status)
+	if !status.IsValid() {
+		c.Logger().Infof("invalid review status: %s", status)
+		return openapi.ResponseReview{}, newAPIError(http.StatusBadRequest, openapi.InvalidReviewStatus, "invalid review status")
+	}
+	comment := null.StringFromPtr(params.Comment)
+	if utf8.RuneCountInString(comment.String) > maxReviewCommentLength {
+		c.Logger().Infof("review comment is too long: %d", utf8.RuneCountInString(comment.String))
+		return openapi.ResponseReview{}, echo.NewHTTPError(http.StatusBadRequest, "review comment is too long")
+	}
+	if comment.Valid && comment.String == "" {
+		comment = null.String{}
+	}
+
+	before, err := rr.GetRespondentDetail(ctx, responseID)
+	if errors.Is(err, model.ErrRecordNotFound) {
+		c.Logger().Infof("response not found: %+v", err)
+		return openapi.ResponseReview{}, newAPIError(http.StatusNotFound, openapi.ResponseNotFound, "response not found")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to get respondent detail: %+v", err)
+		return openapi.ResponseReview{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get respondent detail: %w", err))
+	}
+
+	err = rr.UpdateReview(ctx, responseID, status, comment, userID)
+	if err != nil {
+		c.Logger().Errorf("failed to update review: %+v", err)
+		return openapi.ResponseReview{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to update review: %w", err))
+	}
+
+	after, err := rr.GetRespondentDetail(ctx, responseID)
+	if err != nil {
+		c.Logger().Errorf("failed to get respondent detail: %+v", err)
+		return openapi.ResponseReview{}, echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get respondent detail: %w", err))
+	}
+
+	// 匿名の回答は回答者がわからないので通知しない
+	if params.Notify != nil && *params.Notify && before.ReviewStatus != status && after.TraqID != "" {
+		rr.notifyReview(c, after)
+	}
+
+	return *respondentDetail2ResponseReview(after), nil
+}
+
+// notifyReview 審査状況が変わったことを回答者にDMで通知する
+// 通知に失敗しても審査状況の変更は失敗させないため、エラーはログに残すのみ
+func (rr *ResponseReview) notifyReview(c echo.Context, respondentDetail model.RespondentDetail) {
+	ctx := c.Request().Context()
+
+	questionnaire, _, _, _, _, _, _, _, err := rr.GetQuestionnaireInfo(ctx, respondentDetail.QuestionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get questionnaire info: %+v", err)
+		return
+	}
+
+	err = rr.PostDirectMessage(ctx, respondentDetail.TraqID, createReviewMessage(i18n.ParseLanguage(questionnaire.Language), questionnaire.ID, questionnaire.Title, respondentDetail))
+	if err != nil {
+		c.Logger().Errorf("failed to post review message (responseID: %d): %+v", respondentDetail.ResponseID, err)
+	}
+}
+
+func createReviewMessage(language i18n.Language, questionnaireID int, title string, respondentDetail model.RespondentDetail) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s\n", i18n.Message(language, i18n.KeyReviewChanged, title, questionnaireURL(questionnaireID)))
+	fmt.Fprintf(&sb, "%s\n%s\n", i18n.Message(language, i18n.KeyHeaderReviewStatus), i18n.Message(language, reviewStatusKeys[respondentDetail.ReviewStatus]))
+	if respondentDetail.ReviewComment.Valid {
+		fmt.Fprintf(&sb, "%s\n%s\n", i18n.Message(language, i18n.KeyHeaderReviewComment), respondentDetail.ReviewComment.String)
+	}
+	fmt.Fprintf(&sb, "%s\n%s", i18n.Message(language, i18n.KeyHeaderResponse), responseURL(respondentDetail.ResponseID))
+
+	return sb.String()
+}
@@ -0,0 +1,190 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/model/mock_model"
+	"github.com/traPtitech/anke-to/openapi"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestEditResponseReview(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRespondent := mock_model.NewMockIRespondent(ctrl)
+	mockQuestionnaire := mock_model.NewMockIQuestionnaire(ctrl)
+
+	comment := "回答を修正してください"
+	longComment := string(make([]rune, maxReviewCommentLength+1))
+	notify := true
+
+	type args struct {
+		params openapi.EditResponseReviewJSONRequestBody
+		before model.RespondentDetail
+	}
+	type expect struct {
+		isErr          bool
+		code           int
+		status         openapi.ReviewStatus
+		directMessages []string
+	}
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	testCases := []test{
+		{
+			description: "審査状況を変更して回答者に通知する",
+			args: args{
+				params: openapi.EditResponseReviewJSONRequestBody{
+					Status:  openapi.ReviewStatus(model.ReviewStatusNeedsChanges),
+					Comment: &comment,
+					Notify:  &notify,
+				},
+				before: model.RespondentDetail{ResponseID: 1, QuestionnaireID: 1, TraqID: userOne, ReviewStatus: model.ReviewStatusPending},
+			},
+			expect: expect{
+				status:         openapi.ReviewStatus(model.ReviewStatusNeedsChanges),
+				directMessages: []string{userOne},
+			},
+		},
+		{
+			description: "通知しない指定なら通知しない",
+			args: args{
+				params: openapi.EditResponseReviewJSONRequestBody{
+					Status: openapi.ReviewStatus(model.ReviewStatusAccepted),
+				},
+				before: model.RespondentDetail{ResponseID: 1, QuestionnaireID: 1, TraqID: userOne, ReviewStatus: model.ReviewStatusPending},
+			},
+			expect: expect{
+				status: openapi.ReviewStatus(model.ReviewStatusAccepted),
+			},
+		},
+		{
+			description: "審査状況が変わらなければ通知しない",
+			args: args{
+				params: openapi.EditResponseReviewJSONRequestBody{
+					Status:  openapi.ReviewStatus(model.ReviewStatusAccepted),
+					Comment: &comment,
+					Notify:  &notify,
+				},
+				before: model.RespondentDetail{ResponseID: 1, QuestionnaireID: 1, TraqID: userOne, ReviewStatus: model.ReviewStatusAccepted},
+			},
+			expect: expect{
+				status: openapi.ReviewStatus(model.ReviewStatusAccepted),
+			},
+		},
+		{
+			description: "匿名の回答には通知しない",
+			args: args{
+				params: openapi.EditResponseReviewJSONRequestBody{
+					Status: openapi.ReviewStatus(model.ReviewStatusRejected),
+					Notify: &notify,
+				},
+				before: model.RespondentDetail{ResponseID: 1, QuestionnaireID: 1, ReviewStatus: model.ReviewStatusPending},
+			},
+			expect: expect{
+				status: openapi.ReviewStatus(model.ReviewStatusRejected),
+			},
+		},
+		{
+			description: "不正な審査状況なので400",
+			args: args{
+				params: openapi.EditResponseReviewJSONRequestBody{
+					Status: "invalid",
+				},
+			},
+			expect: expect{
+				isErr: true,
+				code:  http.StatusBadRequest,
+			},
+		},
+		{
+			description: "コメントが長すぎるので400",
+			args: args{
+				params: openapi.EditResponseReviewJSONRequestBody{
+					Status:  openapi.ReviewStatus(model.ReviewStatusRejected),
+					Comment: &longComment,
+				},
+			},
+			expect: expect{
+				isErr: true,
+				code:  http.StatusBadRequest,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		bot := &recordingBot{}
+		responseReview := NewResponseReview(mockRespondent, mockQuestionnaire, bot)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/api/responses/1/review", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if !testCase.expect.isErr {
+			after := testCase.args.before
+			after.ReviewStatus = model.ReviewStatus(testCase.args.params.Status)
+			after.ReviewComment = null.StringFromPtr(testCase.args.params.Comment)
+			after.ReviewedBy = null.StringFrom("editor")
+
+			gomock.InOrder(
+				mockRespondent.
+					EXPECT().
+					GetRespondentDetail(c.Request().Context(), 1).
+					Return(testCase.args.before, nil),
+				mockRespondent.
+					EXPECT().
+					UpdateReview(c.Request().Context(), 1, after.ReviewStatus, after.ReviewComment, "editor").
+					Return(nil),
+				mockRespondent.
+					EXPECT().
+					GetRespondentDetail(c.Request().Context(), 1).
+					Return(after, nil),
+			)
+			if len(testCase.expect.directMessages) > 0 {
+				mockQuestionnaire.
+					EXPECT().
+					GetQuestionnaireInfo(c.Request().Context(), 1).
+					Return(&model.Questionnaires{ID: 1, Title: "第1回集会らん☆ぷろ募集アンケート"}, nil, nil, nil, nil, nil, nil, nil, nil)
+			}
+		}
+
+		review, err := responseReview.EditResponseReview(c, 1, "editor", testCase.args.params)
+
+		if testCase.expect.isErr {
+			httpErr, ok := err.(*echo.HTTPError)
+			if assertion.Truef(ok, testCase.description, "error type") {
+				assertion.Equalf(testCase.expect.code, httpErr.Code, testCase.description, "status code")
+			}
+			continue
+		}
+		if !assertion.NoErrorf(err, testCase.description, "no error") {
+			continue
+		}
+
+		assertion.Equalf(testCase.expect.status, review.Status, testCase.description, "status")
+		assertion.Equalf(testCase.args.params.Comment, review.Comment, testCase.description, "comment")
+
+		directMessages := make([]string, 0, len(bot.directMessages))
+		for _, message := range bot.directMessages {
+			directMessages = append(directMessages, message.channelID)
+			assertion.Containsf(message.content, "https://anke-to.trap.jp/responses/1", testCase.description, "message content")
+		}
+		assertion.ElementsMatchf(testCase.expect.directMessages, directMessages, testCase.description, "direct messages")
+	}
+}
@@ -0,0 +1,230 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/openapi"
+	"github.com/traPtitech/anke-to/pubsub"
+)
+
+// ResponseStream 回答の変更を閲覧者にリアルタイムで配信する構造体
+type ResponseStream struct {
+	pubsub.Broker
+	model.IQuestionnaire
+	model.IRespondent
+	heartbeatInterval time.Duration
+	closed            chan struct{}
+	closeOnce         sync.Once
+}
+
+func NewResponseStream(broker pubsub.Broker, questionnaire model.IQuestionnaire, respondent model.IRespondent) *ResponseStream {
+	return &ResponseStream{
+		Broker:            broker,
+		IQuestionnaire:    questionnaire,
+		IRespondent:       respondent,
+		heartbeatInterval: responseStreamHeartbeatInterval,
+		closed:            make(chan struct{}),
+	}
+}
+
+// Close 配信中のストリームをすべて終了させる
+// サーバーのシャットダウン時に、終わらないストリームを待ち続けないようにするために使う
+func (s *ResponseStream) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+}
+
+const (
+	// responseStreamHeartbeatInterval プロキシに接続を切られないよう、コメント行を送る間隔
+	responseStreamHeartbeatInterval = 30 * time.Second
+	// responseStreamEventCounts 接続時に送る、回答者数と回答数のみのイベント
+	responseStreamEventCounts = "counts"
+)
+
+// responseStreamTopic アンケートの回答の変更を配信するトピック
+func responseStreamTopic(questionnaireID int) string {
+	return fmt.Sprintf("questionnaires/%d/responses", questionnaireID)
+}
+
+// PublishResponseStreamEvent 回答の提出・編集を配信する
+func (s *ResponseStream) PublishResponseStreamEvent(c echo.Context, event model.WebhookEvent, response openapi.Response) {
+	responseID := response.ResponseId
+	s.publish(c, openapi.ResponseStreamEvent{
+		Event:           string(event),
+		QuestionnaireId: response.QuestionnaireId,
+		OccurredAt:      time.Now(),
+		ResponseId:      &responseID,
+		Response:        &response,
+	})
+}
+
+// PublishResponseStreamDeletedEvent 回答の削除を配信する
+func (s *ResponseStream) PublishResponseStreamDeletedEvent(c echo.Context, questionnaireID int, responseID int) {
+	s.publish(c, openapi.ResponseStreamEvent{
+		Event:           string(model.WebhookEventResponseDeleted),
+		QuestionnaireId: questionnaireID,
+		OccurredAt:      time.Now(),
+		ResponseId:      &responseID,
+	})
+}
+
+// publish 変更後の回答者数と回答数を付けてイベントを配信する
+// 配信に失敗しても元の操作は失敗させないため、エラーはログに残すのみ
+func (s *ResponseStream) publish(c echo.Context, event openapi.ResponseStreamEvent) {
+	ctx := c.Request().Context()
+
+	respondentCount, responseCount, err := s.GetRespondentCounts(ctx, event.QuestionnaireId)
+	if err != nil {
+		c.Logger().Errorf("failed to get respondent counts: %+v", err)
+		return
+	}
+	event.RespondentCount = respondentCount
+	event.ResponseCount = responseCount
+
+	message, err := json.Marshal(event)
+	if err != nil {
+		c.Logger().Errorf("failed to marshal response stream event: %+v", err)
+		return
+	}
+
+	err = s.Publish(ctx, responseStreamTopic(event.QuestionnaireId), message)
+	if err != nil {
+		c.Logger().Errorf("failed to publish response stream event: %+v", err)
+	}
+}
+
+// StreamQuestionnaireResponses アンケートの回答の変更をServer-Sent Eventsで配信する
+// クライアントが切断するか、受信が追いつかずに購読が終了するか、閲覧権限を失うか、Closeされるまで返らない
+func (s *ResponseStream) StreamQuestionnaireResponses(c echo.Context, questionnaireID int) error {
+	ctx := c.Request().Context()
+
+	userID, ok := c.Get(userIDKey).(string)
+	if !ok {
+		c.Logger().Error("failed to get userID")
+		return echo.NewHTTPError(http.StatusInternalServerError, errors.New("invalid context userID"))
+	}
+
+	// 接続時の件数の取得と購読開始の間の変更を取りこぼさないよう、先に購読する
+	messages, err := s.Subscribe(ctx, responseStreamTopic(questionnaireID))
+	if err != nil {
+		c.Logger().Errorf("failed to subscribe response stream: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to subscribe response stream: %w", err))
+	}
+
+	respondentCount, responseCount, err := s.GetRespondentCounts(ctx, questionnaireID)
+	if err != nil {
+		c.Logger().Errorf("failed to get respondent counts: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get respondent counts: %w", err))
+	}
+	counts, err := json.Marshal(openapi.ResponseStreamEvent{
+		Event:           responseStreamEventCounts,
+		QuestionnaireId: questionnaireID,
+		OccurredAt:      time.Now(),
+		RespondentCount: respondentCount,
+		ResponseCount:   responseCount,
+	})
+	if err != nil {
+		c.Logger().Errorf("failed to marshal response stream event: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to marshal response stream event: %w", err))
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	// nginxなどのリバースプロキシにイベントをバッファリングさせない
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	err = writeServerSentEvent(res, responseStreamEventCounts, counts)
+	if err != nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(s.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.closed:
+			// サーバーが終了するので、クライアントに再接続してもらう
+			return nil
+		case message, ok := <-messages:
+			if !ok {
+				// 購読が打ち切られたので、クライアントに再接続してもらう
+				return nil
+			}
+
+			var event openapi.ResponseStreamEvent
+			err = json.Unmarshal(message, &event)
+			if err != nil {
+				c.Logger().Errorf("failed to unmarshal response stream event: %+v", err)
+				continue
+			}
+
+			// 接続後に閲覧者から外されるなどした場合に回答を送らないよう、イベントごとに権限を確かめる
+			if !s.canReadResponses(c, userID, questionnaireID) {
+				return nil
+			}
+
+			err = writeServerSentEvent(res, event.Event, message)
+			if err != nil {
+				return nil
+			}
+		case <-ticker.C:
+			// 回答の変更がなくても、権限を失った接続を残し続けない
+			if !s.canReadResponses(c, userID, questionnaireID) {
+				return nil
+			}
+
+			_, err = res.Write([]byte(": keepalive\n\n"))
+			if err != nil {
+				return nil
+			}
+			res.Flush()
+		}
+	}
+}
+
+// canReadResponses 配信中のユーザーがまだアンケートの回答を閲覧できるか
+// ResultAuthenticateと同じ条件で確かめる
+// 閲覧できない場合やエラーの場合は接続を終了させ、再接続時のResultAuthenticateでクライアントに理由を返す
+func (s *ResponseStream) canReadResponses(c echo.Context, userID string, questionnaireID int) bool {
+	responseReadPrivilegeInfo, err := s.GetResponseReadPrivilegeInfoByQuestionnaireID(c.Request().Context(), userID, questionnaireID)
+	if errors.Is(err, model.ErrRecordNotFound) {
+		return false
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to get responseReadPrivilegeInfo: %+v", err)
+		return false
+	}
+
+	haveReadPrivilege, err := checkResponseReadPrivilege(responseReadPrivilegeInfo)
+	if err != nil {
+		c.Logger().Errorf("failed to check response read privilege: %+v", err)
+		return false
+	}
+
+	return haveReadPrivilege
+}
+
+// writeServerSentEvent Server-Sent Eventsのイベントを1つ書き込んで送信する
+// dataはJSONのため改行を含まない
+func writeServerSentEvent(res *echo.Response, event string, data []byte) error {
+	_, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event, data)
+	if err != nil {
+		return err
+	}
+	res.Flush()
+
+	return nil
+}
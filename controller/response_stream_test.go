@@ -0,0 +1,313 @@
+package controller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/model/mock_model"
+	"github.com/traPtitech/anke-to/openapi"
+	"github.com/traPtitech/anke-to/pubsub"
+	"go.uber.org/mock/gomock"
+)
+
+type receivedServerSentEvent struct {
+	event string
+	data  openapi.ResponseStreamEvent
+}
+
+// readServerSentEvent Server-Sent Eventsのイベントを1つ読み出す
+// コメント行は読み飛ばす
+func readServerSentEvent(t *testing.T, reader *bufio.Reader) receivedServerSentEvent {
+	t.Helper()
+
+	received := receivedServerSentEvent{}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("failed to read server-sent event: %v", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+
+		switch {
+		case line == "":
+			if received.event != "" {
+				return received
+			}
+		case strings.HasPrefix(line, "event: "):
+			received.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			err = json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &received.data)
+			if err != nil {
+				t.Fatalf("failed to unmarshal server-sent event: %v", err)
+			}
+		}
+	}
+}
+
+// newResponseStreamTestServer ResultAuthenticateを通過したmazreanとして、アンケート1の回答の変更を配信するサーバー
+func newResponseStreamTestServer(responseStream *ResponseStream) *httptest.Server {
+	e := echo.New()
+	e.GET("/api/questionnaires/:questionnaireID/responses/stream", func(c echo.Context) error {
+		c.Set(userIDKey, "mazrean")
+		return responseStream.StreamQuestionnaireResponses(c, 1)
+	})
+
+	return httptest.NewServer(e)
+}
+
+func TestStreamQuestionnaireResponses(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuestionnaire := mock_model.NewMockIQuestionnaire(ctrl)
+	mockRespondent := mock_model.NewMockIRespondent(ctrl)
+
+	responseStream := NewResponseStream(pubsub.NewMemory(), mockQuestionnaire, mockRespondent)
+
+	mockQuestionnaire.
+		EXPECT().
+		GetResponseReadPrivilegeInfoByQuestionnaireID(gomock.Any(), "mazrean", 1).
+		Return(&model.ResponseReadPrivilegeInfo{ResSharedTo: "public"}, nil).
+		AnyTimes()
+	responseStream.heartbeatInterval = 10 * time.Millisecond
+
+	gomock.InOrder(
+		mockRespondent.
+			EXPECT().
+			GetRespondentCounts(gomock.Any(), 1).
+			Return(3, 4, nil),
+		mockRespondent.
+			EXPECT().
+			GetRespondentCounts(gomock.Any(), 1).
+			Return(4, 5, nil),
+		mockRespondent.
+			EXPECT().
+			GetRespondentCounts(gomock.Any(), 1).
+			Return(3, 4, nil),
+	)
+
+	server := newResponseStreamTestServer(responseStream)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/questionnaires/1/responses/stream", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	res, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("failed to send request: %v", err)
+	}
+	defer res.Body.Close()
+
+	assertion.Equal(http.StatusOK, res.StatusCode)
+	assertion.Equal("text/event-stream", res.Header.Get(echo.HeaderContentType))
+
+	reader := bufio.NewReader(res.Body)
+
+	received := readServerSentEvent(t, reader)
+	assertion.Equal("counts", received.event)
+	assertion.Equal(1, received.data.QuestionnaireId)
+	assertion.Equal(3, received.data.RespondentCount)
+	assertion.Equal(4, received.data.ResponseCount)
+	assertion.Nil(received.data.ResponseId)
+
+	responseStream.PublishResponseStreamEvent(newWebhookTestContext(), model.WebhookEventResponseSubmitted, openapi.Response{
+		QuestionnaireId: 1,
+		ResponseId:      100,
+	})
+	received = readServerSentEvent(t, reader)
+	assertion.Equal("response.submitted", received.event)
+	assertion.Equal("response.submitted", received.data.Event)
+	if assertion.NotNil(received.data.ResponseId) {
+		assertion.Equal(100, *received.data.ResponseId)
+	}
+	if assertion.NotNil(received.data.Response) {
+		assertion.Equal(100, received.data.Response.ResponseId)
+	}
+	assertion.Equal(4, received.data.RespondentCount)
+	assertion.Equal(5, received.data.ResponseCount)
+
+	responseStream.PublishResponseStreamDeletedEvent(newWebhookTestContext(), 1, 100)
+	received = readServerSentEvent(t, reader)
+	assertion.Equal("response.deleted", received.event)
+	if assertion.NotNil(received.data.ResponseId) {
+		assertion.Equal(100, *received.data.ResponseId)
+	}
+	assertion.Nil(received.data.Response)
+	assertion.Equal(3, received.data.RespondentCount)
+	assertion.Equal(4, received.data.ResponseCount)
+}
+
+func TestStreamQuestionnaireResponsesClose(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuestionnaire := mock_model.NewMockIQuestionnaire(ctrl)
+	mockRespondent := mock_model.NewMockIRespondent(ctrl)
+
+	responseStream := NewResponseStream(pubsub.NewMemory(), mockQuestionnaire, mockRespondent)
+
+	mockQuestionnaire.
+		EXPECT().
+		GetResponseReadPrivilegeInfoByQuestionnaireID(gomock.Any(), "mazrean", 1).
+		Return(&model.ResponseReadPrivilegeInfo{ResSharedTo: "public"}, nil).
+		AnyTimes()
+
+	mockRespondent.
+		EXPECT().
+		GetRespondentCounts(gomock.Any(), 1).
+		Return(3, 4, nil)
+
+	server := newResponseStreamTestServer(responseStream)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/questionnaires/1/responses/stream", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	res, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("failed to send request: %v", err)
+	}
+	defer res.Body.Close()
+
+	reader := bufio.NewReader(res.Body)
+
+	received := readServerSentEvent(t, reader)
+	assertion.Equal("counts", received.event)
+
+	responseStream.Close()
+	// 2回呼んでもpanicしない
+	responseStream.Close()
+
+	_, err = io.ReadAll(reader)
+	assertion.NoError(err, "stream ends")
+}
+
+func TestStreamQuestionnaireResponsesPrivilegeRevoked(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type test struct {
+		description       string
+		heartbeatInterval time.Duration
+		publish           bool
+	}
+
+	testCases := []test{
+		{
+			description:       "閲覧権限を失った後のイベントは送らずに終了する",
+			heartbeatInterval: time.Hour,
+			publish:           true,
+		},
+		{
+			description:       "イベントがなくても閲覧権限を失ったら終了する",
+			heartbeatInterval: 10 * time.Millisecond,
+		},
+	}
+
+	for _, testCase := range testCases {
+		ctrl := gomock.NewController(t)
+
+		mockQuestionnaire := mock_model.NewMockIQuestionnaire(ctrl)
+		mockRespondent := mock_model.NewMockIRespondent(ctrl)
+
+		responseStream := NewResponseStream(pubsub.NewMemory(), mockQuestionnaire, mockRespondent)
+		responseStream.heartbeatInterval = testCase.heartbeatInterval
+
+		// 接続後に閲覧者から外された
+		mockQuestionnaire.
+			EXPECT().
+			GetResponseReadPrivilegeInfoByQuestionnaireID(gomock.Any(), "mazrean", 1).
+			Return(&model.ResponseReadPrivilegeInfo{ResSharedTo: "viewers", IsResponseViewer: false}, nil)
+		mockRespondent.
+			EXPECT().
+			GetRespondentCounts(gomock.Any(), 1).
+			Return(3, 4, nil).
+			AnyTimes()
+
+		server := newResponseStreamTestServer(responseStream)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/questionnaires/1/responses/stream", nil)
+		if err != nil {
+			t.Fatalf("failed to create request: %v", err)
+		}
+		res, err := server.Client().Do(req)
+		if err != nil {
+			t.Fatalf("failed to send request: %v", err)
+		}
+
+		reader := bufio.NewReader(res.Body)
+
+		received := readServerSentEvent(t, reader)
+		assertion.Equal("counts", received.event, testCase.description)
+
+		if testCase.publish {
+			responseStream.PublishResponseStreamDeletedEvent(newWebhookTestContext(), 1, 100)
+		}
+
+		rest, err := io.ReadAll(reader)
+		assertion.NoError(err, testCase.description, "stream ends")
+		assertion.NotContains(string(rest), "event:", testCase.description, "no event")
+
+		res.Body.Close()
+		cancel()
+		server.Close()
+		ctrl.Finish()
+	}
+}
+
+func TestStreamQuestionnaireResponsesOtherQuestionnaire(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQuestionnaire := mock_model.NewMockIQuestionnaire(ctrl)
+	mockRespondent := mock_model.NewMockIRespondent(ctrl)
+
+	broker := pubsub.NewMemory()
+	responseStream := NewResponseStream(broker, mockQuestionnaire, mockRespondent)
+
+	mockRespondent.
+		EXPECT().
+		GetRespondentCounts(gomock.Any(), 2).
+		Return(1, 1, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	messages, err := broker.Subscribe(ctx, responseStreamTopic(1))
+	if !assertion.NoError(err) {
+		return
+	}
+
+	responseStream.PublishResponseStreamDeletedEvent(newWebhookTestContext(), 2, 100)
+
+	assertion.Empty(messages, "other questionnaire")
+}
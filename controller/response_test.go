@@ -541,7 +541,7 @@ func TestGetResponse(t *testing.T) {
 		rec := httptest.NewRecorder()
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		ctx := e.NewContext(req, rec)
-		response, err := r.GetResponse(ctx, responseID)
+		response, err := r.GetResponse(ctx, responseID, userOne)
 
 		if !testCase.expect.isErr {
 			assertion.NoError(err, testCase.description, "no error")
@@ -1091,7 +1091,7 @@ func TestEditResponse(t *testing.T) {
 		rec = httptest.NewRecorder()
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		ctx = e.NewContext(req, rec)
-		err = r.EditResponse(ctx, responseID, responseEditPost)
+		err = r.EditResponse(ctx, responseID, responseEditPost, testCase.args.userID)
 
 		if !testCase.expect.isErr {
 			assertion.NoError(err, testCase.description, "no error")
@@ -1109,7 +1109,7 @@ func TestEditResponse(t *testing.T) {
 		rec := httptest.NewRecorder()
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		ctx := e.NewContext(req, rec)
-		responseEdited, err := r.GetResponse(ctx, responseID)
+		responseEdited, err := r.GetResponse(ctx, responseID, userOne)
 		require.NoError(t, err)
 
 		assertion.Equal(response.QuestionnaireId, responseEdited.QuestionnaireId, testCase.description, "questionnaireId")
@@ -1183,3 +1183,202 @@ func TestEditResponse(t *testing.T) {
 
 	}
 }
+
+func TestEditResponseAfterQuizAnswerRevealed(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	responseDueDateTimePlus := time.Now().Add(24 * time.Hour)
+
+	type args struct {
+		isQuizAnswerHiddenUntilDue bool
+	}
+	type expect struct {
+		canReadScore bool
+		isErr        bool
+	}
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	testCases := []test{
+		{
+			description: "提出した時点で正解を見せるクイズは、正解を見た後に回答を編集も提出もできない",
+			expect: expect{
+				canReadScore: true,
+				isErr:        true,
+			},
+		},
+		{
+			description: "回答期限まで正解を見せないクイズは、回答期限まで回答を編集できる",
+			args: args{
+				isQuizAnswerHiddenUntilDue: true,
+			},
+			expect: expect{
+				canReadScore: false,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		isQuiz := true
+		questionnaire := newSampleQuestionnaire()
+		questionnaire.ResponseDueDateTime = &responseDueDateTimePlus
+		questionnaire.IsQuiz = &isQuiz
+		questionnaire.IsQuizAnswerHiddenUntilDue = &testCase.args.isQuizAnswerHiddenUntilDue
+		e := echo.New()
+		body, err := json.Marshal(questionnaire)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/questionnaires", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		ctx := e.NewContext(req, rec)
+		questionnaireDetail, err := q.PostQuestionnaire(ctx, questionnaire)
+		require.NoError(t, err)
+
+		AddQuestionID2SampleResponseMutex.Lock()
+		AddQuestionID2SampleResponse(questionnaireDetail.QuestionnaireId)
+		newResponse := sampleResponse
+		AddQuestionID2SampleResponseMutex.Unlock()
+
+		// 回答を提出する
+		body, err = json.Marshal(newResponse)
+		require.NoError(t, err)
+		req = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/questionnaires/%d/responses", questionnaireDetail.QuestionnaireId), bytes.NewReader(body))
+		rec = httptest.NewRecorder()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		ctx = e.NewContext(req, rec)
+		response, err := q.PostQuestionnaireResponse(ctx, questionnaireDetail.QuestionnaireId, newResponse, userTwo)
+		require.NoError(t, err)
+
+		// 提出した回答を得点とともに読む
+		req = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/responses/%d", response.ResponseId), nil)
+		rec = httptest.NewRecorder()
+		ctx = e.NewContext(req, rec)
+		responseRead, err := r.GetResponse(ctx, response.ResponseId, userTwo)
+		require.NoError(t, err)
+		assertion.Equal(testCase.expect.canReadScore, responseRead.Score != nil, testCase.description, "score")
+
+		// 提出した回答を編集する
+		responseEdit := openapi.EditResponseJSONRequestBody{
+			Body:       newResponse.Body,
+			IsDraft:    false,
+			ResponseId: &response.ResponseId,
+		}
+		body, err = json.Marshal(responseEdit)
+		require.NoError(t, err)
+		req = httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/responses/%d", response.ResponseId), bytes.NewReader(body))
+		rec = httptest.NewRecorder()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		ctx = e.NewContext(req, rec)
+		err = r.EditResponse(ctx, response.ResponseId, responseEdit, userTwo)
+		if !testCase.expect.isErr {
+			assertion.NoError(err, testCase.description, "edit")
+			continue
+		}
+		var httpError *echo.HTTPError
+		if assertion.True(errors.As(err, &httpError), testCase.description, "edit error type") {
+			assertion.Equal(http.StatusMethodNotAllowed, httpError.Code, testCase.description, "edit status code")
+			assertion.Equal(&apiError{code: openapi.QuizAnswerRevealed, message: "unable to edit the response after the quiz answer is revealed"}, httpError.Message, testCase.description, "edit error code")
+		}
+
+		// 新しく回答を提出し直す
+		body, err = json.Marshal(newResponse)
+		require.NoError(t, err)
+		req = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/questionnaires/%d/responses", questionnaireDetail.QuestionnaireId), bytes.NewReader(body))
+		rec = httptest.NewRecorder()
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		ctx = e.NewContext(req, rec)
+		_, err = q.PostQuestionnaireResponse(ctx, questionnaireDetail.QuestionnaireId, newResponse, userTwo)
+		if assertion.True(errors.As(err, &httpError), testCase.description, "post error type") {
+			assertion.Equal(http.StatusUnprocessableEntity, httpError.Code, testCase.description, "post status code")
+		}
+	}
+}
+
+func TestRespondentDetails2ResponsesSummary(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	questions := []model.Questions{
+		{ID: 1, Type: "MultipleChoice"},
+		{ID: 2, Type: "Number"},
+		{ID: 3, Type: "Text"},
+		{ID: 4, Type: "Text"},
+	}
+	options := []model.Options{
+		{QuestionID: 1, OptionNum: 2, Body: "b"},
+		{QuestionID: 1, OptionNum: 1, Body: "a"},
+	}
+	respondentDetails := []model.RespondentDetail{
+		{
+			ResponseID: 1,
+			Responses: []model.ResponseBody{
+				{QuestionID: 1, QuestionType: "MultipleChoice", OptionResponse: []string{"a"}},
+				{QuestionID: 2, QuestionType: "Number", Body: null.StringFrom("1")},
+				{QuestionID: 3, QuestionType: "Text", Body: null.StringFrom("text")},
+				{QuestionID: 4, QuestionType: "Text", Body: null.StringFrom("hidden")},
+			},
+		},
+		{
+			ResponseID: 2,
+			Responses: []model.ResponseBody{
+				{QuestionID: 1, QuestionType: "MultipleChoice", OptionResponse: []string{"a"}},
+				{QuestionID: 2, QuestionType: "Number", Body: null.StringFrom("2")},
+				{QuestionID: 3, QuestionType: "Text", Body: null.StringFrom("")},
+			},
+		},
+	}
+
+	summary, err := respondentDetails2ResponsesSummary(1, questions, options, respondentDetails, map[int]struct{}{4: {}})
+	if !assertion.NoError(err) {
+		return
+	}
+
+	assertion.Equal(1, summary.QuestionnaireId)
+	assertion.Equal(2, summary.ResponseCount)
+	if !assertion.Len(summary.Questions, 3, "hidden question is excluded") {
+		return
+	}
+
+	assertion.Equal(2, summary.Questions[0].AnswerCount)
+	if assertion.NotNil(summary.Questions[0].OptionCounts) {
+		assertion.Equal([]openapi.OptionCount{
+			{Option: "a", Count: 2},
+			{Option: "b", Count: 0},
+		}, *summary.Questions[0].OptionCounts)
+	}
+
+	assertion.Equal(2, summary.Questions[1].AnswerCount)
+	if assertion.NotNil(summary.Questions[1].Average) {
+		assertion.InDelta(1.5, *summary.Questions[1].Average, 1e-9)
+	}
+
+	assertion.Equal(1, summary.Questions[2].AnswerCount, "empty answer is not counted")
+	assertion.Nil(summary.Questions[2].OptionCounts)
+	assertion.Nil(summary.Questions[2].Average)
+}
+
+func TestHideResponseBodies(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	response := openapi.Response{
+		ResponseId: 1,
+		Body: []openapi.ResponseBody{
+			{QuestionId: 1},
+			{QuestionId: 2},
+		},
+	}
+
+	hidden := hideResponseBodies(response, map[int]struct{}{2: {}})
+	if assertion.Len(hidden.Body, 1) {
+		assertion.Equal(1, hidden.Body[0].QuestionId)
+	}
+	assertion.Len(response.Body, 2, "original response is not modified")
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/openapi"
+)
+
+// SystemAdmin SystemAdminの構造体
+type SystemAdmin struct {
+	model.ISystemAdmin
+}
+
+func NewSystemAdmin(systemAdmin model.ISystemAdmin) *SystemAdmin {
+	return &SystemAdmin{
+		ISystemAdmin: systemAdmin,
+	}
+}
+
+const (
+	MaxTraqIDLength = 32
+)
+
+func (s *SystemAdmin) GetSystemAdmins(c echo.Context) ([]openapi.SystemAdmin, error) {
+	systemAdmins, err := s.ISystemAdmin.GetSystemAdmins(c.Request().Context())
+	if err != nil {
+		c.Logger().Errorf("failed to get system admins: %+v", err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get system admins")
+	}
+
+	res := make([]openapi.SystemAdmin, 0, len(systemAdmins))
+	for _, systemAdmin := range systemAdmins {
+		res = append(res, convertSystemAdmin(systemAdmin))
+	}
+
+	return res, nil
+}
+
+func (s *SystemAdmin) PostSystemAdmin(c echo.Context, userID string, params openapi.PostSystemAdminJSONRequestBody) (openapi.SystemAdmin, error) {
+	traqID := strings.TrimSpace(params.TraqId)
+	if len(traqID) == 0 || len(traqID) > MaxTraqIDLength {
+		c.Logger().Infof("invalid traQ ID: %+v", params.TraqId)
+		return openapi.SystemAdmin{}, echo.NewHTTPError(http.StatusBadRequest, "invalid traQ ID")
+	}
+
+	err := s.InsertSystemAdmin(c.Request().Context(), traqID, userID)
+	if errors.Is(err, model.ErrDuplicatedSystemAdmin) {
+		return openapi.SystemAdmin{}, echo.NewHTTPError(http.StatusConflict, "already a system admin")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to insert system admin: %+v", err)
+		return openapi.SystemAdmin{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to insert system admin")
+	}
+	c.Logger().Infof("system admin granted: user=%s granted_by=%s", traqID, userID)
+
+	systemAdmins, err := s.ISystemAdmin.GetSystemAdmins(c.Request().Context())
+	if err != nil {
+		c.Logger().Errorf("failed to get system admins: %+v", err)
+		return openapi.SystemAdmin{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get system admins")
+	}
+	for _, systemAdmin := range systemAdmins {
+		if systemAdmin.UserTraqid == traqID {
+			return convertSystemAdmin(systemAdmin), nil
+		}
+	}
+
+	c.Logger().Errorf("inserted system admin not found: %s", traqID)
+	return openapi.SystemAdmin{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to get system admin")
+}
+
+func (s *SystemAdmin) DeleteSystemAdmin(c echo.Context, userID string, traqID string) error {
+	err := s.ISystemAdmin.DeleteSystemAdmin(c.Request().Context(), traqID)
+	if errors.Is(err, model.ErrNoRecordDeleted) {
+		return echo.NewHTTPError(http.StatusNotFound, "system admin not found")
+	}
+	if errors.Is(err, model.ErrLastSystemAdmin) {
+		return echo.NewHTTPError(http.StatusConflict, "cannot revoke the last system admin")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to delete system admin: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete system admin")
+	}
+	c.Logger().Infof("system admin revoked: user=%s revoked_by=%s", traqID, userID)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/openapi"
+)
+
+// Tag Tagの構造体
+type Tag struct {
+	model.ITag
+	model.ISystemAdmin
+}
+
+func NewTag(tag model.ITag, systemAdmin model.ISystemAdmin) *Tag {
+	return &Tag{
+		ITag:         tag,
+		ISystemAdmin: systemAdmin,
+	}
+}
+
+const (
+	MaxTagNameLength = 64
+)
+
+func validateTagName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 || utf8.RuneCountInString(name) > MaxTagNameLength {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "invalid tag name")
+	}
+	return name, nil
+}
+
+func (t *Tag) GetTags(c echo.Context) ([]openapi.TagWithCount, error) {
+	tagCounts, err := t.ITag.GetTags(c.Request().Context())
+	if err != nil {
+		c.Logger().Errorf("failed to get tags: %+v", err)
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get tags")
+	}
+
+	return convertTagCounts(tagCounts), nil
+}
+
+func (t *Tag) PostTag(c echo.Context, userID string, params openapi.PostTagJSONRequestBody) (openapi.Tag, error) {
+	name, err := validateTagName(params.Name)
+	if err != nil {
+		c.Logger().Infof("invalid tag name: %+v", params.Name)
+		return openapi.Tag{}, err
+	}
+
+	tagID, err := t.InsertTag(c.Request().Context(), name, userID)
+	if errors.Is(err, model.ErrDuplicatedTagName) {
+		return openapi.Tag{}, echo.NewHTTPError(http.StatusConflict, "duplicated tag name")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to insert tag: %+v", err)
+		return openapi.Tag{}, echo.NewHTTPError(http.StatusInternalServerError, "failed to insert tag")
+	}
+
+	return openapi.Tag{
+		TagId: tagID,
+		Name:  name,
+	}, nil
+}
+
+func (t *Tag) EditTag(c echo.Context, tagID int, userID string, params openapi.EditTagJSONRequestBody) error {
+	name, err := validateTagName(params.Name)
+	if err != nil {
+		c.Logger().Infof("invalid tag name: %+v", params.Name)
+		return err
+	}
+
+	err = t.checkTagEditable(c, tagID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = t.UpdateTag(c.Request().Context(), tagID, name)
+	if errors.Is(err, model.ErrDuplicatedTagName) {
+		return echo.NewHTTPError(http.StatusConflict, "duplicated tag name")
+	}
+	// 名前が変わらない場合もRowsAffectedが0になる
+	if err != nil && !errors.Is(err, model.ErrNoRecordUpdated) {
+		c.Logger().Errorf("failed to update tag: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to update tag")
+	}
+
+	return nil
+}
+
+func (t *Tag) DeleteTag(c echo.Context, tagID int, userID string) error {
+	err := t.checkTagEditable(c, tagID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = t.ITag.DeleteTag(c.Request().Context(), tagID)
+	if err != nil {
+		c.Logger().Errorf("failed to delete tag: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to delete tag")
+	}
+
+	return nil
+}
+
+// checkTagEditable タグを変更・削除できるのはタグを作成したユーザーとシステム管理者のみ
+func (t *Tag) checkTagEditable(c echo.Context, tagID int, userID string) error {
+	tag, err := t.GetTag(c.Request().Context(), tagID)
+	if errors.Is(err, model.ErrRecordNotFound) {
+		return echo.NewHTTPError(http.StatusNotFound, "tag not found")
+	}
+	if err != nil {
+		c.Logger().Errorf("failed to get tag: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get tag")
+	}
+
+	if tag.CreatedBy == userID {
+		return nil
+	}
+
+	isSystemAdmin, err := t.CheckSystemAdmin(c.Request().Context(), userID)
+	if err != nil {
+		c.Logger().Errorf("failed to check system admin: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to check system admin")
+	}
+	if !isSystemAdmin {
+		return echo.NewHTTPError(http.StatusForbidden, "you are not the creator of this tag")
+	}
+	logSystemAdminAction(c, userID)
+
+	return nil
+}
@@ -2,12 +2,18 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"slices"
 
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
 	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/tracing"
 	"github.com/traPtitech/anke-to/traq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func isAllTargetsReponded(targets []model.Targets, respondents []model.Respondents) bool {
@@ -24,8 +30,28 @@ func isAllTargetsReponded(targets []model.Targets, respondents []model.Responden
 	return true
 }
 
-func rollOutUsersAndGroups(users []string, groups []uuid.UUID) ([]string, error) {
-	ctx := context.Background()
+// startSpan コントローラーの処理のスパンを開始する
+// 以降のc.Request().Context()を使った処理がこのスパンの子になるよう、リクエストのcontextを差し替える
+func startSpan(c echo.Context, name string) trace.Span {
+	ctx, span := tracing.Start(c.Request().Context(), name)
+	c.SetRequest(c.Request().WithContext(ctx))
+
+	return span
+}
+
+// rollOutUsersAndGroups ユーザーとグループのメンバーをまとめたユーザーのtraQ IDを返す
+// グループのメンバーごとにtraQのAPIを呼ぶため、スパンで時間を記録する
+func rollOutUsersAndGroups(ctx context.Context, users []string, groups []uuid.UUID) (_ []string, err error) {
+	ctx, span := tracing.Start(ctx, "rollOutUsersAndGroups")
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+	span.SetAttributes(
+		attribute.Int("anke-to.users.count", len(users)),
+		attribute.Int("anke-to.groups.count", len(groups)),
+	)
+
 	client := traq.NewTraqAPIClient()
 	userSet := mapset.NewSet[string]()
 	for _, user := range users {
@@ -47,8 +73,7 @@ func rollOutUsersAndGroups(users []string, groups []uuid.UUID) ([]string, error)
 	return userSet.ToSlice(), nil
 }
 
-func uuid2GroupNames(groups []uuid.UUID) ([]string, error) {
-	ctx := context.Background()
+func uuid2GroupNames(ctx context.Context, groups []uuid.UUID) ([]string, error) {
 	client := traq.NewTraqAPIClient()
 	groupNames := []string{}
 	for _, group := range groups {
@@ -60,3 +85,31 @@ func uuid2GroupNames(groups []uuid.UUID) ([]string, error) {
 	}
 	return groupNames, nil
 }
+
+// setNextPageLink 次のページが存在する場合にLinkヘッダー(rel="next")を付与し、カーソルの文字列を返す
+func setNextPageLink(c echo.Context, nextCursor *model.Cursor) *string {
+	if nextCursor == nil {
+		return nil
+	}
+	cursor := nextCursor.Encode()
+
+	query := c.Request().URL.Query()
+	query.Del("page")
+	query.Set("cursor", cursor)
+	nextURL := url.URL{
+		Path:     c.Request().URL.Path,
+		RawQuery: query.Encode(),
+	}
+	c.Response().Header().Add("Link", fmt.Sprintf(`<%s>; rel="next"`, nextURL.String()))
+
+	return &cursor
+}
+
+// parseCursor クエリパラメータのカーソルを解釈する
+func parseCursor(cursor *string) (*model.Cursor, error) {
+	if cursor == nil {
+		return nil, nil
+	}
+
+	return model.DecodeCursor(*cursor)
+}
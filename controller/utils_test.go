@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/tracing"
+	"github.com/traPtitech/anke-to/tracing/tracingtest"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestStartSpan(t *testing.T) {
+	assertion := assert.New(t)
+
+	exporter := tracingtest.Setup(t)
+
+	ctx, parent := tracing.Start(context.Background(), "request")
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+
+	span := startSpan(c, "controller")
+	_, err := rollOutUsersAndGroups(c.Request().Context(), []string{"mazrean", "ryoha"}, []uuid.UUID{})
+	assertion.NoError(err)
+	span.End()
+	parent.End()
+
+	controllerSpans := tracingtest.SpansByName(exporter, "controller")
+	if !assertion.Len(controllerSpans, 1) {
+		return
+	}
+	assertion.Equal(parent.SpanContext().SpanID(), controllerSpans[0].Parent.SpanID(), "child of request span")
+
+	rollOutSpans := tracingtest.SpansByName(exporter, "rollOutUsersAndGroups")
+	if !assertion.Len(rollOutSpans, 1) {
+		return
+	}
+	assertion.Equal(controllerSpans[0].SpanContext.SpanID(), rollOutSpans[0].Parent.SpanID(), "child of controller span")
+	assertion.Contains(rollOutSpans[0].Attributes, attribute.Int("anke-to.users.count", 2))
+	assertion.Contains(rollOutSpans[0].Attributes, attribute.Int("anke-to.groups.count", 0))
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/openapi"
+)
+
+// (GET /accessTokens)
+func (h Handler) GetAccessTokens(ctx echo.Context) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	res, err := h.AccessToken.GetAccessTokens(ctx, userID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get access tokens: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(200, res)
+}
+
+// (POST /accessTokens)
+func (h Handler) PostAccessToken(ctx echo.Context) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	params := openapi.PostAccessTokenJSONRequestBody{}
+	if err := ctx.Bind(&params); err != nil {
+		ctx.Logger().Errorf("failed to bind request body: %+v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to bind request body: %w", err))
+	}
+
+	res, err := h.AccessToken.PostAccessToken(ctx, userID, params)
+	if err != nil {
+		ctx.Logger().Errorf("failed to post access token: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(201, res)
+}
+
+// (DELETE /accessTokens/{accessTokenID})
+func (h Handler) DeleteAccessToken(ctx echo.Context, accessTokenID openapi.AccessTokenIDInPath) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	err = h.AccessToken.DeleteAccessToken(ctx, userID, accessTokenID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to delete access token: %+v", err)
+		return err
+	}
+
+	return ctx.NoContent(200)
+}
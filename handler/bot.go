@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	traqAPI "github.com/traPtitech/anke-to/traq"
+)
+
+// maxBotEventSize traQのBOTのイベントの本文の最大バイト数
+const maxBotEventSize = 1 << 20
+
+// (POST /bot/events)
+func (h Handler) PostBotEvent(ctx echo.Context) error {
+	// 認証されていないリクエストの本文は読まない
+	err := h.Bot.VerifyBotToken(ctx, ctx.Request().Header.Get(traqAPI.BotTokenHeader))
+	if err != nil {
+		return err
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(ctx.Response(), ctx.Request().Body, maxBotEventSize))
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			ctx.Logger().Infof("too large bot event: %+v", err)
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Errorf("too large bot event: %w", err))
+		}
+		ctx.Logger().Infof("failed to read bot event: %+v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to read bot event: %w", err))
+	}
+
+	err = h.Bot.HandleBotEvent(
+		ctx,
+		traqAPI.BotEventType(ctx.Request().Header.Get(traqAPI.BotEventHeader)),
+		payload,
+	)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -6,25 +6,52 @@ import (
 )
 
 type Handler struct {
-	Questionnaire *controller.Questionnaire
-	Response      *controller.Response
-	Reminder      *controller.Reminder
-	Middleware    *controller.Middleware
-	TraqClient    *traqAPI.APIClient
+	Questionnaire   *controller.Questionnaire
+	Response        *controller.Response
+	Reminder        *controller.Reminder
+	Tag             *controller.Tag
+	SystemAdmin     *controller.SystemAdmin
+	AccessToken     *controller.AccessToken
+	OutgoingWebhook *controller.OutgoingWebhook
+	Bot             *controller.Bot
+	QuickPoll       *controller.QuickPoll
+	ResponseStream  *controller.ResponseStream
+	ResponseReview  *controller.ResponseReview
+	Middleware      *controller.Middleware
+	Health          *controller.Health
+	TraqClient      *traqAPI.APIClient
 }
 
 func NewHandler(questionnaire *controller.Questionnaire,
 	response *controller.Response,
 	reminder *controller.Reminder,
+	tag *controller.Tag,
+	systemAdmin *controller.SystemAdmin,
+	accessToken *controller.AccessToken,
+	outgoingWebhook *controller.OutgoingWebhook,
+	bot *controller.Bot,
+	quickPoll *controller.QuickPoll,
+	responseStream *controller.ResponseStream,
+	responseReview *controller.ResponseReview,
 	middleware *controller.Middleware,
+	health *controller.Health,
 	traqClient *traqAPI.APIClient,
 ) *Handler {
 	reminder.ReminderInit()
 	return &Handler{
-		Questionnaire: questionnaire,
-		Response:      response,
-		Reminder:      reminder,
-		Middleware:    middleware,
-		TraqClient:    traqClient,
+		Questionnaire:   questionnaire,
+		Response:        response,
+		Reminder:        reminder,
+		Tag:             tag,
+		SystemAdmin:     systemAdmin,
+		AccessToken:     accessToken,
+		OutgoingWebhook: outgoingWebhook,
+		Bot:             bot,
+		QuickPoll:       quickPoll,
+		ResponseStream:  responseStream,
+		ResponseReview:  responseReview,
+		Middleware:      middleware,
+		Health:          health,
+		TraqClient:      traqClient,
 	}
 }
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// (GET /healthz)
+func (h Handler) GetHealthz(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, h.Health.Liveness())
+}
+
+// (GET /readyz)
+func (h Handler) GetReadyz(ctx echo.Context) error {
+	status := h.Health.Readiness(ctx.Request().Context())
+	if !status.IsOK() {
+		ctx.Logger().Warnf("not ready: %+v", status.Checks)
+		return ctx.JSON(http.StatusServiceUnavailable, status)
+	}
+
+	return ctx.JSON(http.StatusOK, status)
+}
@@ -19,6 +19,10 @@ func (h Handler) GetQuestionnaires(ctx echo.Context, params openapi.GetQuestionn
 	}
 
 	res, err := h.Questionnaire.GetQuestionnaires(ctx, userID, params)
+	if errors.Is(err, model.ErrInvalidCursor) || errors.Is(err, model.ErrTooLargePageNum) {
+		ctx.Logger().Infof("invalid questionnaires params: %+v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("invalid questionnaires params: %w", err))
+	}
 	if err != nil {
 		ctx.Logger().Errorf("failed to get questionnaires: %+v", err)
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get questionnaires: %w", err))
@@ -46,7 +50,13 @@ func (h Handler) PostQuestionnaire(ctx echo.Context) error {
 
 // (GET /questionnaires/{questionnaireID})
 func (h Handler) GetQuestionnaire(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
-	res, err := h.Questionnaire.GetQuestionnaire(ctx, questionnaireID)
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	res, err := h.Questionnaire.GetQuestionnaire(ctx, questionnaireID, userID)
 	if err != nil {
 		if errors.Is(err, model.ErrRecordNotFound) {
 			return echo.NewHTTPError(http.StatusNotFound, fmt.Errorf("questionnaire not found: %w", err))
@@ -59,13 +69,19 @@ func (h Handler) GetQuestionnaire(ctx echo.Context, questionnaireID openapi.Ques
 
 // (PATCH /questionnaires/{questionnaireID})
 func (h Handler) EditQuestionnaire(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
 	params := openapi.EditQuestionnaireJSONRequestBody{}
 	if err := ctx.Bind(&params); err != nil {
 		ctx.Logger().Errorf("failed to bind request body: %+v", err)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to bind request body: %w", err))
 	}
 
-	err := h.Questionnaire.EditQuestionnaire(ctx, questionnaireID, params)
+	err = h.Questionnaire.EditQuestionnaire(ctx, questionnaireID, params, userID)
 	if err != nil {
 		ctx.Logger().Errorf("failed to edit questionnaire: %+v", err)
 		return err
@@ -84,6 +100,23 @@ func (h Handler) CloseQuestionnaire(ctx echo.Context, questionnaireID openapi.Qu
 	return ctx.NoContent(200)
 }
 
+// (POST /questionnaires/{questionnaireID}/messagePreview)
+func (h Handler) PreviewQuestionnaireMessage(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
+	params := openapi.PreviewQuestionnaireMessageJSONRequestBody{}
+	if err := ctx.Bind(&params); err != nil {
+		ctx.Logger().Errorf("failed to bind request body: %+v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to bind request body: %w", err))
+	}
+
+	res, err := h.Questionnaire.PreviewQuestionnaireMessage(ctx, questionnaireID, params)
+	if err != nil {
+		ctx.Logger().Errorf("failed to preview questionnaire message: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(200, res)
+}
+
 // (DELETE /questionnaires/{questionnaireID})
 func (h Handler) DeleteQuestionnaire(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
 	err := h.Questionnaire.DeleteQuestionnaire(ctx, questionnaireID)
@@ -152,6 +185,33 @@ func (h Handler) GetQuestionnaireResponses(ctx echo.Context, questionnaireID ope
 	return ctx.JSON(200, res)
 }
 
+// (GET /questionnaires/{questionnaireID}/responses/summary)
+func (h Handler) GetQuestionnaireResponsesSummary(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+	res, err := h.Questionnaire.GetQuestionnaireResponsesSummary(ctx, questionnaireID, userID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get questionnaire responses summary: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(200, res)
+}
+
+// (GET /questionnaires/{questionnaireID}/responses/stream)
+func (h Handler) GetQuestionnaireResponsesStream(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
+	err := h.ResponseStream.StreamQuestionnaireResponses(ctx, questionnaireID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to stream questionnaire responses: %+v", err)
+		return err
+	}
+
+	return nil
+}
+
 // (POST /questionnaires/{questionnaireID}/responses)
 func (h Handler) PostQuestionnaireResponse(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
 	userID, err := h.Middleware.GetUserID(ctx)
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/openapi"
+)
+
+// (GET /questionnaires/{questionnaireID}/quickPoll)
+func (h Handler) GetQuestionnaireQuickPoll(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
+	res, err := h.QuickPoll.GetQuestionnaireQuickPoll(ctx, questionnaireID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get quick poll: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(200, res)
+}
+
+// (POST /questionnaires/{questionnaireID}/quickPoll)
+func (h Handler) PostQuestionnaireQuickPoll(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	params := openapi.PostQuestionnaireQuickPollJSONRequestBody{}
+	if err := ctx.Bind(&params); err != nil {
+		ctx.Logger().Errorf("failed to bind request body: %+v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to bind request body: %w", err))
+	}
+
+	res, err := h.QuickPoll.PostQuestionnaireQuickPoll(ctx, questionnaireID, userID, params)
+	if err != nil {
+		ctx.Logger().Errorf("failed to post quick poll: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(201, res)
+}
@@ -37,7 +37,13 @@ func (h Handler) DeleteResponse(ctx echo.Context, responseID openapi.ResponseIDI
 
 // (GET /responses/{responseID})
 func (h Handler) GetResponse(ctx echo.Context, responseID openapi.ResponseIDInPath) error {
-	res, err := h.Response.GetResponse(ctx, responseID)
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	res, err := h.Response.GetResponse(ctx, responseID, userID)
 	if err != nil {
 		ctx.Logger().Errorf("failed to get response: %+v", err)
 		return err
@@ -46,14 +52,42 @@ func (h Handler) GetResponse(ctx echo.Context, responseID openapi.ResponseIDInPa
 }
 
 // (PATCH /responses/{responseID})
+// (PUT /responses/{responseID}/review)
+func (h Handler) EditResponseReview(ctx echo.Context, responseID openapi.ResponseIDInPath) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	params := openapi.EditResponseReviewJSONRequestBody{}
+	if err := ctx.Bind(&params); err != nil {
+		ctx.Logger().Errorf("failed to bind request body: %+v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to bind request body: %w", err))
+	}
+	res, err := h.ResponseReview.EditResponseReview(ctx, responseID, userID, params)
+	if err != nil {
+		ctx.Logger().Errorf("failed to edit response review: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(200, res)
+}
+
 func (h Handler) EditResponse(ctx echo.Context, responseID openapi.ResponseIDInPath) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
 	req := openapi.EditResponseJSONRequestBody{}
 	if err := ctx.Bind(&req); err != nil {
 		ctx.Logger().Errorf("failed to bind Responses: %+v", err)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to bind Responses: %w", err))
 	}
 
-	err := h.Response.EditResponse(ctx, responseID, req)
+	err = h.Response.EditResponse(ctx, responseID, req, userID)
 	if err != nil {
 		ctx.Logger().Errorf("failed to edit response: %+v", err)
 		return err
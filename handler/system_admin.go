@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/openapi"
+)
+
+// (GET /systemAdmins)
+func (h Handler) GetSystemAdmins(ctx echo.Context) error {
+	res, err := h.SystemAdmin.GetSystemAdmins(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get system admins: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(200, res)
+}
+
+// (POST /systemAdmins)
+func (h Handler) PostSystemAdmin(ctx echo.Context) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	params := openapi.PostSystemAdminJSONRequestBody{}
+	if err := ctx.Bind(&params); err != nil {
+		ctx.Logger().Errorf("failed to bind request body: %+v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to bind request body: %w", err))
+	}
+
+	res, err := h.SystemAdmin.PostSystemAdmin(ctx, userID, params)
+	if err != nil {
+		ctx.Logger().Errorf("failed to post system admin: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(201, res)
+}
+
+// (DELETE /systemAdmins/{traqID})
+func (h Handler) DeleteSystemAdmin(ctx echo.Context, traqID openapi.TraqIDInPath) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	err = h.SystemAdmin.DeleteSystemAdmin(ctx, userID, traqID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to delete system admin: %+v", err)
+		return err
+	}
+
+	return ctx.NoContent(200)
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/openapi"
+)
+
+// (GET /tags)
+func (h Handler) GetTags(ctx echo.Context) error {
+	res, err := h.Tag.GetTags(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get tags: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(200, res)
+}
+
+// (POST /tags)
+func (h Handler) PostTag(ctx echo.Context) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	params := openapi.PostTagJSONRequestBody{}
+	if err := ctx.Bind(&params); err != nil {
+		ctx.Logger().Errorf("failed to bind request body: %+v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to bind request body: %w", err))
+	}
+
+	res, err := h.Tag.PostTag(ctx, userID, params)
+	if err != nil {
+		ctx.Logger().Errorf("failed to post tag: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(201, res)
+}
+
+// (PATCH /tags/{tagID})
+func (h Handler) EditTag(ctx echo.Context, tagID openapi.TagIDInPath) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	params := openapi.EditTagJSONRequestBody{}
+	if err := ctx.Bind(&params); err != nil {
+		ctx.Logger().Errorf("failed to bind request body: %+v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to bind request body: %w", err))
+	}
+
+	err = h.Tag.EditTag(ctx, tagID, userID, params)
+	if err != nil {
+		ctx.Logger().Errorf("failed to edit tag: %+v", err)
+		return err
+	}
+
+	return ctx.NoContent(200)
+}
+
+// (DELETE /tags/{tagID})
+func (h Handler) DeleteTag(ctx echo.Context, tagID openapi.TagIDInPath) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	err = h.Tag.DeleteTag(ctx, tagID, userID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to delete tag: %+v", err)
+		return err
+	}
+
+	return ctx.NoContent(200)
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traPtitech/anke-to/openapi"
+)
+
+// (GET /questionnaires/{questionnaireID}/webhooks)
+func (h Handler) GetQuestionnaireWebhooks(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
+	res, err := h.OutgoingWebhook.GetQuestionnaireWebhooks(ctx, questionnaireID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get questionnaire webhooks: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(200, res)
+}
+
+// (POST /questionnaires/{questionnaireID}/webhooks)
+func (h Handler) PostQuestionnaireWebhook(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath) error {
+	userID, err := h.Middleware.GetUserID(ctx)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get userID: %+v", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("failed to get userID: %w", err))
+	}
+
+	params := openapi.PostQuestionnaireWebhookJSONRequestBody{}
+	if err := ctx.Bind(&params); err != nil {
+		ctx.Logger().Errorf("failed to bind request body: %+v", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Errorf("failed to bind request body: %w", err))
+	}
+
+	res, err := h.OutgoingWebhook.PostQuestionnaireWebhook(ctx, questionnaireID, userID, params)
+	if err != nil {
+		ctx.Logger().Errorf("failed to post questionnaire webhook: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(201, res)
+}
+
+// (DELETE /questionnaires/{questionnaireID}/webhooks/{webhookID})
+func (h Handler) DeleteQuestionnaireWebhook(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath, webhookID openapi.WebhookIDInPath) error {
+	err := h.OutgoingWebhook.DeleteQuestionnaireWebhook(ctx, questionnaireID, webhookID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to delete questionnaire webhook: %+v", err)
+		return err
+	}
+
+	return ctx.NoContent(200)
+}
+
+// (GET /questionnaires/{questionnaireID}/webhooks/{webhookID}/deliveries)
+func (h Handler) GetQuestionnaireWebhookDeliveries(ctx echo.Context, questionnaireID openapi.QuestionnaireIDInPath, webhookID openapi.WebhookIDInPath) error {
+	res, err := h.OutgoingWebhook.GetQuestionnaireWebhookDeliveries(ctx, questionnaireID, webhookID)
+	if err != nil {
+		ctx.Logger().Errorf("failed to get questionnaire webhook deliveries: %+v", err)
+		return err
+	}
+
+	return ctx.JSON(200, res)
+}
@@ -0,0 +1,152 @@
+package i18n
+
+// Key メッセージカタログのキー
+type Key string
+
+const (
+	KeyQuestionnaireCreated Key = "questionnaire_created"
+	KeyReminder             Key = "reminder"
+	KeyHeaderAdministrators Key = "header_administrators"
+	KeyHeaderDescription    Key = "header_description"
+	KeyHeaderDeadline       Key = "header_deadline"
+	KeyHeaderTargets        Key = "header_targets"
+	KeyHeaderResponseLink   Key = "header_response_link"
+	KeyNone                 Key = "none"
+	KeyReviewChanged        Key = "review_changed"
+	KeyHeaderReviewStatus   Key = "header_review_status"
+	KeyHeaderReviewComment  Key = "header_review_comment"
+	KeyHeaderResponse       Key = "header_response"
+	KeyReviewPending        Key = "review_pending"
+	KeyReviewAccepted       Key = "review_accepted"
+	KeyReviewRejected       Key = "review_rejected"
+	KeyReviewNeedsChanges   Key = "review_needs_changes"
+	KeyDurationWeek         Key = "duration_week"
+	KeyDurationWeeks        Key = "duration_weeks"
+	KeyDurationDay          Key = "duration_day"
+	KeyDurationDays         Key = "duration_days"
+	KeyDurationHour         Key = "duration_hour"
+	KeyDurationHours        Key = "duration_hours"
+	KeyDurationMinute       Key = "duration_minute"
+	KeyDurationMinutes      Key = "duration_minutes"
+	KeyQuickPoll            Key = "quick_poll"
+	KeyQuickPollHowTo       Key = "quick_poll_how_to"
+	KeyBotHelp              Key = "bot_help"
+	KeyBotInvalidID         Key = "bot_invalid_id"
+	KeyBotNotFound          Key = "bot_not_found"
+	KeyBotListFailed        Key = "bot_list_failed"
+	KeyBotListHeader        Key = "bot_list_header"
+	KeyBotListItem          Key = "bot_list_item"
+	KeyBotListEmpty         Key = "bot_list_empty"
+	KeyBotRemindOn          Key = "bot_remind_on"
+	KeyBotRemindOff         Key = "bot_remind_off"
+	KeyBotRemindFailed      Key = "bot_remind_failed"
+	KeyBotStatusDMOnly      Key = "bot_status_dm_only"
+	KeyBotStatusFailed      Key = "bot_status_failed"
+	KeyBotStatusForbidden   Key = "bot_status_forbidden"
+	KeyBotStatusHeader      Key = "bot_status_header"
+	KeyBotStatusTargets     Key = "bot_status_targets"
+	KeyBotStatusCounts      Key = "bot_status_counts"
+	KeyBotStatusUnanswered  Key = "bot_status_unanswered"
+)
+
+// catalog 言語ごとのメッセージ
+// 値はfmt.Sprintfの書式で、同じキーのメッセージは同じ順番で引数を受け取る
+// リンクのURLは設定で変わるため、カタログには含めず引数で受け取る
+var catalog = map[Language]map[Key]string{
+	LanguageJa: {
+		KeyQuestionnaireCreated: "### アンケート『[%s](%s)』が作成されました",
+		KeyReminder:             "### アンケート『[%s](%s)』の回答期限が迫っています!\n==残り%sです!==",
+		KeyHeaderAdministrators: "#### 管理者",
+		KeyHeaderDescription:    "#### 説明",
+		KeyHeaderDeadline:       "#### 回答期限",
+		KeyHeaderTargets:        "#### 対象者",
+		KeyHeaderResponseLink:   "#### 回答リンク",
+		KeyNone:                 "なし",
+		KeyReviewChanged:        "### アンケート『[%s](%s)』の回答の審査状況が変わりました",
+		KeyHeaderReviewStatus:   "#### 審査状況",
+		KeyHeaderReviewComment:  "#### コメント",
+		KeyHeaderResponse:       "#### 回答",
+		KeyReviewPending:        "未審査",
+		KeyReviewAccepted:       "承認",
+		KeyReviewRejected:       "却下",
+		KeyReviewNeedsChanges:   "要修正",
+		KeyDurationWeek:         "%d週間",
+		KeyDurationWeeks:        "%d週間",
+		KeyDurationDay:          "%d日",
+		KeyDurationDays:         "%d日",
+		KeyDurationHour:         "%d時間",
+		KeyDurationHours:        "%d時間",
+		KeyDurationMinute:       "%d分",
+		KeyDurationMinutes:      "%d分",
+		KeyQuickPoll:            "### アンケート『[%s](%s)』",
+		KeyQuickPollHowTo:       "スタンプを押して回答してください。複数のスタンプを押した場合は、最後に押したスタンプが回答になります",
+		KeyBotHelp: "### anke-to BOTの使い方\n" +
+			"- `list`: 未回答のアンケートの一覧を表示します\n" +
+			"- `remind on <アンケートID>`, `remind off <アンケートID>`: アンケートのリマインドを設定します\n" +
+			"- `status <アンケートID>`: アンケートの回答状況を表示します (管理者のみ、DMでのみ使えます)",
+		KeyBotInvalidID:        "アンケートID `%s` は不正です",
+		KeyBotNotFound:         "アンケート %d は見つかりませんでした",
+		KeyBotListFailed:       "アンケートの取得に失敗しました",
+		KeyBotListHeader:       "### 未回答のアンケート",
+		KeyBotListItem:         "- [%s](%s) (回答期限: %s)",
+		KeyBotListEmpty:        "未回答のアンケートはありません",
+		KeyBotRemindOn:         "アンケート %d のリマインドをオンにしました",
+		KeyBotRemindOff:        "アンケート %d のリマインドをオフにしました",
+		KeyBotRemindFailed:     "リマインドの設定に失敗しました",
+		KeyBotStatusDMOnly:     "`status` は未回答の対象者を表示するため、BOTへのDMでのみ使えます",
+		KeyBotStatusFailed:     "アンケートの回答状況の取得に失敗しました",
+		KeyBotStatusForbidden:  "アンケート %d は見つからないか、回答状況を見る権限がありません",
+		KeyBotStatusHeader:     "### アンケート『[%s](%s)』の回答状況",
+		KeyBotStatusTargets:    "回答済みの対象者: %d/%d人 (%d%%)",
+		KeyBotStatusCounts:     "回答者: %d人\n回答数: %d",
+		KeyBotStatusUnanswered: "#### 未回答の対象者",
+	},
+	LanguageEn: {
+		KeyQuestionnaireCreated: "### Questionnaire \"[%s](%s)\" has been created",
+		KeyReminder:             "### The deadline for questionnaire \"[%s](%s)\" is approaching!\n==%s left!==",
+		KeyHeaderAdministrators: "#### Administrators",
+		KeyHeaderDescription:    "#### Description",
+		KeyHeaderDeadline:       "#### Deadline",
+		KeyHeaderTargets:        "#### Targets",
+		KeyHeaderResponseLink:   "#### Response link",
+		KeyNone:                 "None",
+		KeyReviewChanged:        "### The review status of your response to questionnaire \"[%s](%s)\" has changed",
+		KeyHeaderReviewStatus:   "#### Review status",
+		KeyHeaderReviewComment:  "#### Comment",
+		KeyHeaderResponse:       "#### Response",
+		KeyReviewPending:        "Pending",
+		KeyReviewAccepted:       "Accepted",
+		KeyReviewRejected:       "Rejected",
+		KeyReviewNeedsChanges:   "Needs changes",
+		KeyDurationWeek:         "%d week",
+		KeyDurationWeeks:        "%d weeks",
+		KeyDurationDay:          "%d day",
+		KeyDurationDays:         "%d days",
+		KeyDurationHour:         "%d hour",
+		KeyDurationHours:        "%d hours",
+		KeyDurationMinute:       "%d minute",
+		KeyDurationMinutes:      "%d minutes",
+		KeyQuickPoll:            "### Questionnaire \"[%s](%s)\"",
+		KeyQuickPollHowTo:       "Add a stamp to answer. If you add more than one stamp, the last one is your answer",
+		KeyBotHelp: "### How to use the anke-to BOT\n" +
+			"- `list`: Shows the questionnaires you have not answered\n" +
+			"- `remind on <questionnaire ID>`, `remind off <questionnaire ID>`: Turns reminders for the questionnaire on or off\n" +
+			"- `status <questionnaire ID>`: Shows the response status of the questionnaire (administrators only, in DMs only)",
+		KeyBotInvalidID:        "Questionnaire ID `%s` is invalid",
+		KeyBotNotFound:         "Questionnaire %d was not found",
+		KeyBotListFailed:       "Failed to get questionnaires",
+		KeyBotListHeader:       "### Unanswered questionnaires",
+		KeyBotListItem:         "- [%s](%s) (Deadline: %s)",
+		KeyBotListEmpty:        "There are no unanswered questionnaires",
+		KeyBotRemindOn:         "Turned on reminders for questionnaire %d",
+		KeyBotRemindOff:        "Turned off reminders for questionnaire %d",
+		KeyBotRemindFailed:     "Failed to set reminders",
+		KeyBotStatusDMOnly:     "`status` shows targets who have not answered, so it can only be used in DMs to the BOT",
+		KeyBotStatusFailed:     "Failed to get the response status of the questionnaire",
+		KeyBotStatusForbidden:  "Questionnaire %d was not found or you do not have permission to see its response status",
+		KeyBotStatusHeader:     "### Response status of questionnaire \"[%s](%s)\"",
+		KeyBotStatusTargets:    "Targets answered: %d/%d (%d%%)",
+		KeyBotStatusCounts:     "Respondents: %d\nResponses: %d",
+		KeyBotStatusUnanswered: "#### Targets who have not answered",
+	},
+}
@@ -0,0 +1,79 @@
+package i18n
+
+import (
+	"fmt"
+	"time"
+)
+
+// Language traQに投稿するメッセージの言語
+type Language string
+
+const (
+	// LanguageJa 日本語
+	LanguageJa Language = "ja"
+	// LanguageEn 英語
+	LanguageEn Language = "en"
+)
+
+// DefaultLanguage 言語が指定されていないときに使う言語
+const DefaultLanguage = LanguageJa
+
+// IsValid カタログに含まれている言語か
+func (l Language) IsValid() bool {
+	_, ok := catalog[l]
+	return ok
+}
+
+// ParseLanguage 文字列を言語に変換する
+// カタログに含まれていない言語の場合はDefaultLanguageを返す
+func ParseLanguage(s string) Language {
+	language := Language(s)
+	if !language.IsValid() {
+		return DefaultLanguage
+	}
+
+	return language
+}
+
+// Message 言語に対応するメッセージをargsで埋めて返す
+// 言語のカタログにメッセージがない場合はDefaultLanguageのメッセージを使う
+func Message(language Language, key Key, args ...any) string {
+	format, ok := catalog[language][key]
+	if !ok {
+		format = catalog[DefaultLanguage][key]
+	}
+	if len(args) == 0 {
+		return format
+	}
+
+	return fmt.Sprintf(format, args...)
+}
+
+// durationUnits FormatDurationで使う単位。大きい順に並べる
+var durationUnits = []struct {
+	duration time.Duration
+	singular Key
+	plural   Key
+}{
+	{7 * 24 * time.Hour, KeyDurationWeek, KeyDurationWeeks},
+	{24 * time.Hour, KeyDurationDay, KeyDurationDays},
+	{time.Hour, KeyDurationHour, KeyDurationHours},
+	{time.Minute, KeyDurationMinute, KeyDurationMinutes},
+}
+
+// FormatDuration 期間を言語に合わせた表記にする
+// 期間を割り切れる最も大きい単位を使う。1分未満は切り捨てる
+func FormatDuration(language Language, d time.Duration) string {
+	for _, unit := range durationUnits {
+		if d < unit.duration || d%unit.duration != 0 {
+			continue
+		}
+		count := int(d / unit.duration)
+		if count == 1 {
+			return Message(language, unit.singular, count)
+		}
+		return Message(language, unit.plural, count)
+	}
+
+	return Message(language, KeyDurationMinutes, int(d/time.Minute))
+}
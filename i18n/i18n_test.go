@@ -0,0 +1,139 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	for key := range catalog[DefaultLanguage] {
+		for language, messages := range catalog {
+			_, ok := messages[key]
+			assertion.True(ok, "message %s is missing in %s", key, language)
+		}
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type test struct {
+		description string
+		language    string
+		expect      Language
+	}
+
+	testCases := []test{
+		{
+			description: "日本語",
+			language:    "ja",
+			expect:      LanguageJa,
+		},
+		{
+			description: "英語",
+			language:    "en",
+			expect:      LanguageEn,
+		},
+		{
+			description: "空ならデフォルトの言語",
+			language:    "",
+			expect:      DefaultLanguage,
+		},
+		{
+			description: "カタログにない言語ならデフォルトの言語",
+			language:    "fr",
+			expect:      DefaultLanguage,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assertion.Equal(testCase.expect, ParseLanguage(testCase.language), testCase.description)
+	}
+}
+
+func TestMessage(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	assertion.Equal("#### 対象者", Message(LanguageJa, KeyHeaderTargets))
+	assertion.Equal("#### Targets", Message(LanguageEn, KeyHeaderTargets))
+	assertion.Equal("#### 対象者", Message(Language("fr"), KeyHeaderTargets), "fallback to default language")
+	assertion.Equal("3 days", Message(LanguageEn, KeyDurationDays, 3))
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	type test struct {
+		description string
+		language    Language
+		duration    time.Duration
+		expect      string
+	}
+
+	testCases := []test{
+		{
+			description: "1週間",
+			language:    LanguageJa,
+			duration:    7 * 24 * time.Hour,
+			expect:      "1週間",
+		},
+		{
+			description: "5日",
+			language:    LanguageJa,
+			duration:    5 * 24 * time.Hour,
+			expect:      "5日",
+		},
+		{
+			description: "12時間",
+			language:    LanguageJa,
+			duration:    12 * time.Hour,
+			expect:      "12時間",
+		},
+		{
+			description: "1週間を英語で",
+			language:    LanguageEn,
+			duration:    7 * 24 * time.Hour,
+			expect:      "1 week",
+		},
+		{
+			description: "1日を英語で",
+			language:    LanguageEn,
+			duration:    24 * time.Hour,
+			expect:      "1 day",
+		},
+		{
+			description: "6時間を英語で",
+			language:    LanguageEn,
+			duration:    6 * time.Hour,
+			expect:      "6 hours",
+		},
+		{
+			description: "割り切れない場合は小さい単位",
+			language:    LanguageEn,
+			duration:    90 * time.Minute,
+			expect:      "90 minutes",
+		},
+		{
+			description: "1分未満",
+			language:    LanguageEn,
+			duration:    30 * time.Second,
+			expect:      "0 minutes",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assertion.Equal(testCase.expect, FormatDuration(testCase.language, testCase.duration), testCase.description)
+	}
+}
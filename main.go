@@ -1,88 +1,289 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"log"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
-	"strings"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 	_ "time/tzdata"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	gommonLog "github.com/labstack/gommon/log"
 	oapiMiddleware "github.com/oapi-codegen/echo-middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/traPtitech/anke-to/auth"
+	"github.com/traPtitech/anke-to/config"
+	"github.com/traPtitech/anke-to/controller"
 	"github.com/traPtitech/anke-to/model"
 	"github.com/traPtitech/anke-to/openapi"
+	"github.com/traPtitech/anke-to/pubsub"
+	"github.com/traPtitech/anke-to/tracing"
+	"github.com/traPtitech/anke-to/traq"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
 )
 
+// botEventPath traQのBOTのイベントを受け取るパス
+const botEventPath = "/bot/events"
+
+// ヘルスチェックのパス
+const (
+	healthzPath = "/healthz"
+	readyzPath  = "/readyz"
+)
+
+// metricsPath Prometheusのメトリクスのパス
+const metricsPath = "/metrics"
+
+// metricsReadHeaderTimeout メトリクスのサーバーがリクエストのヘッダーを読む時間の上限
+const metricsReadHeaderTimeout = 10 * time.Second
+
+// shutdownTimeout 終了のシグナルを受け取ってから、処理中のリクエスト・リマインダー・Webhookの完了を待つ時間
+const shutdownTimeout = 20 * time.Second
+
 func main() {
-	env, ok := os.LookupEnv("ENV")
-	if !ok {
-		panic("no ENV")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
 	}
+	traq.Configure(cfg.TraQ)
+	controller.Configure(cfg)
 
-	err := model.EstablishConnection(env)
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
 	if err != nil {
-		panic(err)
+		log.Fatalf("failed to setup tracing: %v", err)
+	}
+
+	err = model.EstablishConnection(cfg)
+	if err != nil {
+		log.Fatalf("failed to establish connection: %v", err)
 	}
 
 	_, err = model.Migrate()
 	if err != nil {
-		panic(err)
+		log.Fatalf("failed to migrate: %v", err)
 	}
 
-	port, ok := os.LookupEnv("PORT")
-	if !ok {
-		panic("no PORT")
+	// システム管理者が1人もいない場合に備えて、初期のシステム管理者を設定する
+	err = model.SetupSystemAdmins(context.Background(), cfg.InitialSystemAdmins)
+	if err != nil {
+		log.Fatalf("failed to setup system admins: %v", err)
 	}
-	traqBotToken, ok := os.LookupEnv("TRAQ_BOT_TOKEN")
-	if !ok || strings.TrimSpace(traqBotToken) == "" {
-		panic("no TRAQ_BOT_TOKEN")
+
+	authenticator, err := auth.NewAuthenticator(cfg.Auth, cfg.Env)
+	if err != nil {
+		log.Fatalf("failed to create authenticator: %v", err)
 	}
 
-	e := echo.New()
-	api := InjectAPIServer()
+	broker, err := pubsub.NewBroker(ctx, cfg.PubSub)
+	if err != nil {
+		log.Fatalf("failed to create pubsub broker: %v", err)
+	}
 
-	api.Reminder.Wg.Add(1)
-	go func() {
-		e.Use(middleware.RequestLogger())
-		e.Use(middleware.Recover())
+	e := echo.New()
+	e.Logger.SetLevel(echoLogLevel(cfg.Log.Level))
+	e.HTTPErrorHandler = controller.HTTPErrorHandler
+	api := InjectAPIServer(authenticator, broker)
 
-		swagger, err := openapi.GetSwagger()
-		if err != nil {
-			panic(err)
+	e.Use(otelecho.Middleware(tracing.ServiceName, otelecho.WithSkipper(func(c echo.Context) bool {
+		// ヘルスチェックは頻繁に呼ばれるため記録しない
+		switch c.Path() {
+		case healthzPath, readyzPath:
+			return true
+		default:
+			return false
 		}
-		e.Use(oapiMiddleware.OapiRequestValidator(swagger))
+	})))
+	e.Use(controller.MetricsMiddleware)
+	e.Use(middleware.RequestLogger())
+	e.Use(middleware.Recover())
 
-		e.Use(api.Middleware.SetUserIDMiddleware)
+	swagger, err := openapi.GetSwagger()
+	if err != nil {
+		log.Fatalf("failed to get swagger: %v", err)
+	}
+	e.Use(oapiMiddleware.OapiRequestValidatorWithOptions(swagger, &oapiMiddleware.Options{
+		// traQのBOTのイベントとヘルスチェックはOpenAPIの定義に含まれないため検証しない
+		Skipper: func(c echo.Context) bool {
+			switch c.Path() {
+			case botEventPath, healthzPath, readyzPath:
+				return true
+			default:
+				return false
+			}
+		},
+	}))
 
-		mws := NewMiddlewareSwitcher()
-		mws.AddGroupConfig("/api", api.Middleware.TraPMemberAuthenticate)
+	e.Use(api.Middleware.AccessTokenMiddleware)
+	e.Use(api.Middleware.SetUserIDMiddleware)
 
-		mws.AddRouteConfig("/api/questionnaires", http.MethodGet, api.Middleware.TrapRateLimitMiddlewareFunc())
-		mws.AddRouteConfig("/api/questionnaires/:questionnaireID", http.MethodGet, api.Middleware.QuestionnaireReadAuthenticate)
-		mws.AddRouteConfig("/api/questionnaires/:questionnaireID", http.MethodPatch, api.Middleware.QuestionnaireAdministratorAuthenticate)
-		mws.AddRouteConfig("/api/questionnaires/:questionnaireID", http.MethodDelete, api.Middleware.QuestionnaireAdministratorAuthenticate)
-		mws.AddRouteConfig("/api/questionnaires/:questionnaireID/close", http.MethodPost, api.Middleware.QuestionnaireAdministratorAuthenticate)
-		mws.AddRouteConfig("/api/questionnaires/:questionnaireID/responses", http.MethodPost, api.Middleware.QuestionnaireReadAuthenticate)
-		mws.AddRouteConfig("/api/questionnaires/:questionnaireID/responses", http.MethodGet, api.Middleware.ResultOrMyResponseAuthenticate)
+	mws := NewMiddlewareSwitcher()
+	mws.AddGroupConfig("/api", api.Middleware.TraPMemberAuthenticate, api.Middleware.AccessTokenScopeAuthenticate)
 
-		mws.AddRouteConfig("/api/responses/:responseID", http.MethodGet, api.Middleware.ResponseReadAuthenticate)
-		mws.AddRouteConfig("/api/responses/:responseID", http.MethodPatch, api.Middleware.RespondentAuthenticate)
-		mws.AddRouteConfig("/api/responses/:responseID", http.MethodDelete, api.Middleware.RespondentAuthenticate)
-		e.Use(mws.ApplyMiddlewares)
+	mws.AddRouteConfig("/api/questionnaires", http.MethodGet, api.Middleware.TrapRateLimitMiddlewareFunc())
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID", http.MethodGet, api.Middleware.QuestionnaireReadAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID", http.MethodPatch, api.Middleware.QuestionnaireEditorAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID", http.MethodDelete, api.Middleware.QuestionnaireOwnerAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/close", http.MethodPost, api.Middleware.QuestionnaireEditorAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/messagePreview", http.MethodPost, api.Middleware.QuestionnaireEditorAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/responses", http.MethodPost, api.Middleware.QuestionnaireReadAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/responses", http.MethodGet, api.Middleware.ResultOrMyResponseAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/responses/stream", http.MethodGet, api.Middleware.ResultAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/responses/summary", http.MethodGet, api.Middleware.ResultSummaryAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/quickPoll", http.MethodGet, api.Middleware.QuestionnaireViewerAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/quickPoll", http.MethodPost, api.Middleware.QuestionnaireEditorAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/webhooks", http.MethodGet, api.Middleware.QuestionnaireOwnerAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/webhooks", http.MethodPost, api.Middleware.QuestionnaireOwnerAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/webhooks/:webhookID", http.MethodDelete, api.Middleware.QuestionnaireOwnerAuthenticate)
+	mws.AddRouteConfig("/api/questionnaires/:questionnaireID/webhooks/:webhookID/deliveries", http.MethodGet, api.Middleware.QuestionnaireOwnerAuthenticate)
 
-		openapi.RegisterHandlersWithBaseURL(e, api, "/api")
+	mws.AddRouteConfig("/api/responses/:responseID", http.MethodGet, api.Middleware.ResponseReadAuthenticate)
+	mws.AddRouteConfig("/api/responses/:responseID", http.MethodPatch, api.Middleware.RespondentOrEditorAuthenticate)
+	mws.AddRouteConfig("/api/responses/:responseID", http.MethodDelete, api.Middleware.RespondentAuthenticate)
+	mws.AddRouteConfig("/api/responses/:responseID/review", http.MethodPut, api.Middleware.ResponseEditorAuthenticate)
 
-		e.Logger.Fatal(e.Start(port))
+	mws.AddRouteConfig("/api/systemAdmins", http.MethodGet, api.Middleware.SystemAdminAuthenticate)
+	mws.AddRouteConfig("/api/systemAdmins", http.MethodPost, api.Middleware.SystemAdminAuthenticate)
+	mws.AddRouteConfig("/api/systemAdmins/:traqID", http.MethodDelete, api.Middleware.SystemAdminAuthenticate)
+	e.Use(mws.ApplyMiddlewares)
+
+	openapi.RegisterHandlersWithBaseURL(e, api, "/api")
+	if api.Bot.IsBotEnabled() {
+		e.POST(botEventPath, api.PostBotEvent)
+	}
+	// ヘルスチェックは/apiの外に置き、ログインしていなくても使えるようにする
+	e.GET(healthzPath, api.GetHealthz)
+	e.GET(readyzPath, api.GetReadyz)
 
-		api.Reminder.Wg.Done()
+	// 配信中のストリームは終わらないため、シャットダウンの開始時に終了させてクライアントに再接続してもらう
+	e.Server.RegisterOnShutdown(api.ResponseStream.Close)
+
+	go func() {
+		err := e.Start(cfg.Port)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			e.Logger.Fatal(err)
+		}
 	}()
 
-	api.Reminder.Wg.Add(1)
+	// メトリクスは認証せずに返すため、APIサーバーとは別の外部に公開しないポートで返す
+	var metricsServer *http.Server
+	if cfg.Metrics.Port != "" {
+		metricsServer = newMetricsServer(cfg.Metrics.Port)
+		go func() {
+			err := metricsServer.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("failed to serve metrics: %v", err)
+			}
+		}()
+	}
+
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	workerDone := make(chan struct{})
+	go func() {
+		api.Reminder.ReminderWorker(workerCtx)
+		close(workerDone)
+	}()
+	webhookWorkerDone := make(chan struct{})
 	go func() {
-		api.Reminder.ReminderWorker()
-		api.Reminder.Wg.Done()
+		api.OutgoingWebhook.WebhookWorker(workerCtx)
+		close(webhookWorkerDone)
 	}()
 
-	api.Reminder.Wg.Wait()
+	<-ctx.Done()
+	stop()
+	log.Println("shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// 新しいリクエストの受け付けを止め、処理中のリクエストの完了を待つ
+	err = e.Shutdown(shutdownCtx)
+	if err != nil {
+		log.Printf("failed to shutdown server: %v", err)
+	}
+
+	// 新しいリマインダーとWebhookの実行を止め、投稿中のリマインダーと送信中のWebhookの完了を待つ
+	// DBの接続を閉じる前に待たないと、送信の結果を記録できない
+	// 時間内に送信できなかったWebhookは、次の起動時に送信する
+	stopWorker()
+	<-workerDone
+	<-webhookWorkerDone
+	if !waitWithContext(shutdownCtx, &api.Reminder.Wg) {
+		log.Printf("failed to wait for reminders: %v", shutdownCtx.Err())
+	}
+	if !waitWithContext(shutdownCtx, &api.OutgoingWebhook.Wg) {
+		log.Printf("failed to wait for webhook deliveries: %v", shutdownCtx.Err())
+	}
+
+	if metricsServer != nil {
+		err = metricsServer.Shutdown(shutdownCtx)
+		if err != nil {
+			log.Printf("failed to shutdown metrics server: %v", err)
+		}
+	}
+
+	err = model.CloseConnection()
+	if err != nil {
+		log.Printf("failed to close connection: %v", err)
+	}
+
+	// 記録したトレースを送りきってから終了する
+	err = shutdownTracing(shutdownCtx)
+	if err != nil {
+		log.Printf("failed to shutdown tracing: %v", err)
+	}
+}
+
+// newMetricsServer Prometheusのメトリクスを返すサーバーを作成する
+func newMetricsServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.Handler())
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: metricsReadHeaderTimeout,
+	}
+}
+
+// waitWithContext wgが完了するか、ctxが終了するまで待つ
+// wgが完了した場合はtrueを返す
+func waitWithContext(ctx context.Context, wg *sync.WaitGroup) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// echoLogLevel 設定のログレベルをechoのログレベルにする
+func echoLogLevel(level config.LogLevel) gommonLog.Lvl {
+	switch level {
+	case config.LogLevelDebug:
+		return gommonLog.DEBUG
+	case config.LogLevelInfo:
+		return gommonLog.INFO
+	case config.LogLevelWarn:
+		return gommonLog.WARN
+	case config.LogLevelError:
+		return gommonLog.ERROR
+	default:
+		return gommonLog.OFF
+	}
 }
@@ -0,0 +1,19 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// IAccessToken AccessTokenのRepository
+type IAccessToken interface {
+	InsertAccessToken(ctx context.Context, userID string, name string, tokenHash string, scopes []AccessTokenScope, rateLimit int, expiresAt null.Time) (int, error)
+	GetAccessTokens(ctx context.Context, userID string) ([]AccessTokens, error)
+	GetAccessTokenByHash(ctx context.Context, tokenHash string) (*AccessTokens, error)
+	DeleteAccessToken(ctx context.Context, userID string, accessTokenID int) error
+	UpdateAccessTokenLastUsedAt(ctx context.Context, accessTokenID int, lastUsedAt time.Time) error
+}
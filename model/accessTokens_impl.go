@@ -0,0 +1,202 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+// AccessToken AccessTokenRepositoryの実装
+type AccessToken struct{}
+
+// NewAccessToken AccessTokenのコンストラクター
+func NewAccessToken() *AccessToken {
+	return new(AccessToken)
+}
+
+// AccessTokenScope アクセストークンで操作できる範囲
+type AccessTokenScope string
+
+const (
+	// AccessTokenScopeQuestionnairesRead アンケートとタグの閲覧
+	AccessTokenScopeQuestionnairesRead AccessTokenScope = "questionnaires:read"
+	// AccessTokenScopeQuestionnairesWrite アンケートとタグの作成・編集・削除
+	AccessTokenScopeQuestionnairesWrite AccessTokenScope = "questionnaires:write"
+	// AccessTokenScopeResponsesRead 回答の閲覧
+	AccessTokenScopeResponsesRead AccessTokenScope = "responses:read"
+	// AccessTokenScopeResponsesWrite 回答の作成・編集・削除
+	AccessTokenScopeResponsesWrite AccessTokenScope = "responses:write"
+)
+
+// AccessTokenScopes 有効なスコープの一覧
+var AccessTokenScopes = []AccessTokenScope{
+	AccessTokenScopeQuestionnairesRead,
+	AccessTokenScopeQuestionnairesWrite,
+	AccessTokenScopeResponsesRead,
+	AccessTokenScopeResponsesWrite,
+}
+
+// IsValid 有効なスコープか
+func (scope AccessTokenScope) IsValid() bool {
+	return slices.Contains(AccessTokenScopes, scope)
+}
+
+// AccessTokens access_tokensテーブルの構造体
+type AccessTokens struct {
+	ID         int       `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	UserTraqid string    `gorm:"type:varchar(32);size:32;not null;index"`
+	Name       string    `gorm:"type:varchar(50);size:50;not null"`
+	TokenHash  string    `gorm:"type:char(64);size:64;not null;uniqueIndex"`
+	Scopes     string    `gorm:"type:varchar(255);size:255;not null"`
+	RateLimit  int       `gorm:"type:int(11);not null"`
+	ExpiresAt  null.Time `gorm:"type:TIMESTAMP NULL;default:NULL;"`
+	LastUsedAt null.Time `gorm:"type:TIMESTAMP NULL;default:NULL;"`
+	CreatedAt  time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// BeforeCreate insert時に自動でcreated_atを現在時刻に
+func (accessToken *AccessTokens) BeforeCreate(_ *gorm.DB) error {
+	accessToken.CreatedAt = time.Now()
+
+	return nil
+}
+
+// GetScopes スコープの一覧を取得
+// scopesカラムにはスペース区切りで保存している
+func (accessToken *AccessTokens) GetScopes() []AccessTokenScope {
+	scopes := []AccessTokenScope{}
+	for _, scope := range strings.Fields(accessToken.Scopes) {
+		scopes = append(scopes, AccessTokenScope(scope))
+	}
+
+	return scopes
+}
+
+// HasScope スコープを持っているか
+func (accessToken *AccessTokens) HasScope(scope AccessTokenScope) bool {
+	return slices.Contains(accessToken.GetScopes(), scope)
+}
+
+// IsExpired 有効期限が切れているか
+func (accessToken *AccessTokens) IsExpired(now time.Time) bool {
+	return accessToken.ExpiresAt.Valid && !now.Before(accessToken.ExpiresAt.Time)
+}
+
+// InsertAccessToken アクセストークンの追加
+func (*AccessToken) InsertAccessToken(ctx context.Context, userID string, name string, tokenHash string, scopes []AccessTokenScope, rateLimit int, expiresAt null.Time) (int, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	strScopes := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return 0, ErrInvalidAccessTokenScope
+		}
+		if !slices.Contains(strScopes, string(scope)) {
+			strScopes = append(strScopes, string(scope))
+		}
+	}
+
+	accessToken := AccessTokens{
+		UserTraqid: userID,
+		Name:       name,
+		TokenHash:  tokenHash,
+		Scopes:     strings.Join(strScopes, " "),
+		RateLimit:  rateLimit,
+		ExpiresAt:  expiresAt,
+	}
+	err = db.Create(&accessToken).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert access token: %w", err)
+	}
+
+	return accessToken.ID, nil
+}
+
+// GetAccessTokens ユーザーのアクセストークン一覧の取得
+func (*AccessToken) GetAccessTokens(ctx context.Context, userID string) ([]AccessTokens, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	accessTokens := []AccessTokens{}
+	err = db.
+		Where("user_traqid = ?", userID).
+		Order("created_at, id").
+		Find(&accessTokens).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access tokens: %w", err)
+	}
+
+	return accessTokens, nil
+}
+
+// GetAccessTokenByHash トークンのハッシュからアクセストークンを取得
+func (*AccessToken) GetAccessTokenByHash(ctx context.Context, tokenHash string) (*AccessTokens, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	var accessToken AccessTokens
+	err = db.
+		Where("token_hash = ?", tokenHash).
+		First(&accessToken).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access token: %w", err)
+	}
+
+	return &accessToken, nil
+}
+
+// DeleteAccessToken アクセストークンの削除
+// 他のユーザーのアクセストークンは削除できない
+func (*AccessToken) DeleteAccessToken(ctx context.Context, userID string, accessTokenID int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	result := db.
+		Where("id = ? AND user_traqid = ?", accessTokenID, userID).
+		Delete(&AccessTokens{})
+	err = result.Error
+	if err != nil {
+		return fmt.Errorf("failed to delete access token: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNoRecordDeleted
+	}
+
+	return nil
+}
+
+// UpdateAccessTokenLastUsedAt アクセストークンの最終使用日時の更新
+func (*AccessToken) UpdateAccessTokenLastUsedAt(ctx context.Context, accessTokenID int, lastUsedAt time.Time) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = db.
+		Model(&AccessTokens{}).
+		Where("id = ?", accessTokenID).
+		Update("last_used_at", lastUsedAt).Error
+	if err != nil {
+		return fmt.Errorf("failed to update last_used_at: %w", err)
+	}
+
+	return nil
+}
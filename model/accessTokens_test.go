@@ -0,0 +1,92 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestAccessTokens(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+	accessTokenImpl := NewAccessToken()
+
+	tokenHash := fmt.Sprintf("%064d", time.Now().UnixNano())
+	expiresAt := null.NewTime(time.Now().Add(time.Hour).Truncate(time.Second), true)
+
+	_, err := accessTokenImpl.InsertAccessToken(ctx, userOne, "invalid", tokenHash, []AccessTokenScope{"admin"}, 60, null.Time{})
+	if !errors.Is(err, ErrInvalidAccessTokenScope) {
+		t.Errorf("invalid error(invalid scope): expected: %+v, actual: %+v", ErrInvalidAccessTokenScope, err)
+	}
+
+	accessTokenID, err := accessTokenImpl.InsertAccessToken(ctx, userOne, "bot", tokenHash, []AccessTokenScope{
+		AccessTokenScopeQuestionnairesRead,
+		AccessTokenScopeResponsesRead,
+		AccessTokenScopeQuestionnairesRead,
+	}, 30, expiresAt)
+	if !assertion.NoError(err) {
+		return
+	}
+
+	accessToken, err := accessTokenImpl.GetAccessTokenByHash(ctx, tokenHash)
+	if assertion.NoError(err) {
+		assertion.Equal(accessTokenID, accessToken.ID)
+		assertion.Equal(userOne, accessToken.UserTraqid)
+		assertion.Equal("bot", accessToken.Name)
+		assertion.Equal([]AccessTokenScope{AccessTokenScopeQuestionnairesRead, AccessTokenScopeResponsesRead}, accessToken.GetScopes())
+		assertion.True(accessToken.HasScope(AccessTokenScopeResponsesRead))
+		assertion.False(accessToken.HasScope(AccessTokenScopeResponsesWrite))
+		assertion.Equal(30, accessToken.RateLimit)
+		assertion.WithinDuration(expiresAt.Time, accessToken.ExpiresAt.Time, time.Second)
+		assertion.False(accessToken.LastUsedAt.Valid)
+		assertion.False(accessToken.IsExpired(time.Now()))
+		assertion.True(accessToken.IsExpired(expiresAt.Time))
+	}
+
+	_, err = accessTokenImpl.GetAccessTokenByHash(ctx, fmt.Sprintf("%064d", 0))
+	if !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("invalid error(not found): expected: %+v, actual: %+v", ErrRecordNotFound, err)
+	}
+
+	lastUsedAt := time.Now().Truncate(time.Second)
+	err = accessTokenImpl.UpdateAccessTokenLastUsedAt(ctx, accessTokenID, lastUsedAt)
+	assertion.NoError(err)
+
+	accessTokens, err := accessTokenImpl.GetAccessTokens(ctx, userOne)
+	if assertion.NoError(err) {
+		found := false
+		for _, accessToken := range accessTokens {
+			assertion.Equal(userOne, accessToken.UserTraqid)
+			if accessToken.ID == accessTokenID {
+				found = true
+				assertion.WithinDuration(lastUsedAt, accessToken.LastUsedAt.Time, time.Second)
+			}
+		}
+		assertion.True(found)
+	}
+
+	accessTokens, err = accessTokenImpl.GetAccessTokens(ctx, userTwo)
+	if assertion.NoError(err) {
+		for _, accessToken := range accessTokens {
+			assertion.NotEqual(accessTokenID, accessToken.ID)
+		}
+	}
+
+	err = accessTokenImpl.DeleteAccessToken(ctx, userTwo, accessTokenID)
+	if !errors.Is(err, ErrNoRecordDeleted) {
+		t.Errorf("invalid error(other user): expected: %+v, actual: %+v", ErrNoRecordDeleted, err)
+	}
+
+	err = accessTokenImpl.DeleteAccessToken(ctx, userOne, accessTokenID)
+	assertion.NoError(err)
+
+	_, err = accessTokenImpl.GetAccessTokenByHash(ctx, tokenHash)
+	if !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("invalid error(deleted): expected: %+v, actual: %+v", ErrRecordNotFound, err)
+	}
+}
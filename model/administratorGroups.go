@@ -10,7 +10,7 @@ import (
 
 // IAdministratorGroup AdministratorGroupのRepository
 type IAdministratorGroup interface {
-	InsertAdministratorGroups(ctx context.Context, questionnaireID int, groupID []uuid.UUID) error
+	InsertAdministratorGroups(ctx context.Context, questionnaireID int, groupID []uuid.UUID, role AdministratorRole) error
 	DeleteAdministratorGroups(ctx context.Context, questionnaireID int) error
 	GetAdministratorGroups(ctx context.Context, questionnaireIDs []int) ([]AdministratorGroups, error)
 }
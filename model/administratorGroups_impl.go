@@ -16,12 +16,13 @@ func NewAdministratorGroup() *AdministratorGroup {
 }
 
 type AdministratorGroups struct {
-	QuestionnaireID int       `gorm:"type:int(11);not null;primaryKey"`
-	GroupID         uuid.UUID `gorm:"type:varchar(36);size:36;not null;primaryKey"`
+	QuestionnaireID int               `gorm:"type:int(11);not null;primaryKey"`
+	GroupID         uuid.UUID         `gorm:"type:varchar(36);size:36;not null;primaryKey"`
+	Role            AdministratorRole `gorm:"type:varchar(16);size:16;not null;default:owner"`
 }
 
 // InsertAdministratorGroups 選択したアンケート管理者（グループ）を追加
-func (*AdministratorGroup) InsertAdministratorGroups(ctx context.Context, questionnaireID int, groupID []uuid.UUID) error {
+func (*AdministratorGroup) InsertAdministratorGroups(ctx context.Context, questionnaireID int, groupID []uuid.UUID, role AdministratorRole) error {
 	db, err := getTx(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get transaction: %w", err)
@@ -36,6 +37,7 @@ func (*AdministratorGroup) InsertAdministratorGroups(ctx context.Context, questi
 		dbAdministratorGroups = append(dbAdministratorGroups, AdministratorGroups{
 			QuestionnaireID: questionnaireID,
 			GroupID:         administratorGroup,
+			Role:            role,
 		})
 	}
 
@@ -59,7 +59,7 @@ func TestInsertAdministratorGroups(t *testing.T) {
 		questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 		require.NoError(t, err)
 
-		err = administratorGroupImpl.InsertAdministratorGroups(ctx, questionnaireID, testCase.args.adminGroups)
+		err = administratorGroupImpl.InsertAdministratorGroups(ctx, questionnaireID, testCase.args.adminGroups, AdministratorRoleOwner)
 
 		if !testCase.expect.isErr {
 			assertion.NoError(err, testCase.description, "no error")
@@ -129,7 +129,7 @@ func TestDeleteAdministratorGroups(t *testing.T) {
 		questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 		require.NoError(t, err)
 
-		err = administratorGroupImpl.InsertAdministratorGroups(ctx, questionnaireID, testCase.args.adminGroups)
+		err = administratorGroupImpl.InsertAdministratorGroups(ctx, questionnaireID, testCase.args.adminGroups, AdministratorRoleOwner)
 		require.NoError(t, err)
 
 		err = administratorGroupImpl.DeleteAdministratorGroups(ctx, questionnaireID)
@@ -223,14 +223,14 @@ func TestGetAdministratorGroups(t *testing.T) {
 	for _, testCase := range testCases {
 		questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 		require.NoError(t, err)
-		err = administratorGroupImpl.InsertAdministratorGroups(ctx, questionnaireID, testCase.args.adminGroups)
+		err = administratorGroupImpl.InsertAdministratorGroups(ctx, questionnaireID, testCase.args.adminGroups, AdministratorRoleOwner)
 		require.NoError(t, err)
 
 		var questionnaireID2 int
 		if testCase.dualQuestionnaire {
 			questionnaireID2, err = questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 			require.NoError(t, err)
-			err = administratorGroupImpl.InsertAdministratorGroups(ctx, questionnaireID2, testCase.args.adminGroups2)
+			err = administratorGroupImpl.InsertAdministratorGroups(ctx, questionnaireID2, testCase.args.adminGroups2, AdministratorRoleOwner)
 			require.NoError(t, err)
 		}
 
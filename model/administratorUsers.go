@@ -6,7 +6,7 @@ import "context"
 
 // IAdministratorUser AdministratorUserのRepository
 type IAdministratorUser interface {
-	InsertAdministratorUsers(ctx context.Context, questionnaireID int, traqID []string) error
+	InsertAdministratorUsers(ctx context.Context, questionnaireID int, traqID []string, role AdministratorRole) error
 	DeleteAdministratorUsers(ctx context.Context, questionnaireID int) error
 	GetAdministratorUsers(ctx context.Context, questionnaireIDs []int) ([]AdministratorUsers, error)
 }
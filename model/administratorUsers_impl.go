@@ -14,12 +14,13 @@ func NewAdministratorUser() *AdministratorUser {
 }
 
 type AdministratorUsers struct {
-	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
-	UserTraqid      string `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+	QuestionnaireID int               `gorm:"type:int(11);not null;primaryKey"`
+	UserTraqid      string            `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+	Role            AdministratorRole `gorm:"type:varchar(16);size:16;not null;default:owner"`
 }
 
 // InsertAdministratorUsers 選択したアンケート管理者（ユーザー）を追加
-func (*AdministratorUser) InsertAdministratorUsers(ctx context.Context, questionnaireID int, UserTraqid []string) error {
+func (*AdministratorUser) InsertAdministratorUsers(ctx context.Context, questionnaireID int, UserTraqid []string, role AdministratorRole) error {
 	db, err := getTx(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get transaction: %w", err)
@@ -34,6 +35,7 @@ func (*AdministratorUser) InsertAdministratorUsers(ctx context.Context, question
 		dbAdministratorUsers = append(dbAdministratorUsers, AdministratorUsers{
 			QuestionnaireID: questionnaireID,
 			UserTraqid:      administratorUser,
+			Role:            role,
 		})
 	}
 
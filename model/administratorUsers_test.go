@@ -58,7 +58,7 @@ func TestInsertAdministratorUsers(t *testing.T) {
 		questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 		require.NoError(t, err)
 
-		err = administratorUserImpl.InsertAdministratorUsers(ctx, questionnaireID, testCase.args.adminUsers)
+		err = administratorUserImpl.InsertAdministratorUsers(ctx, questionnaireID, testCase.args.adminUsers, AdministratorRoleOwner)
 
 		if !testCase.expect.isErr {
 			assertion.NoError(err, testCase.description, "no error")
@@ -126,7 +126,7 @@ func TestDeleteAdministratorUsers(t *testing.T) {
 		questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 		require.NoError(t, err)
 
-		err = administratorUserImpl.InsertAdministratorUsers(ctx, questionnaireID, testCase.args.adminUsers)
+		err = administratorUserImpl.InsertAdministratorUsers(ctx, questionnaireID, testCase.args.adminUsers, AdministratorRoleOwner)
 		require.NoError(t, err)
 
 		err = administratorUserImpl.DeleteAdministratorUsers(ctx, questionnaireID)
@@ -220,14 +220,14 @@ func TestGetAdministratorUsers(t *testing.T) {
 	for _, testCase := range testCases {
 		questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 		require.NoError(t, err)
-		err = administratorUserImpl.InsertAdministratorUsers(ctx, questionnaireID, testCase.args.adminUsers)
+		err = administratorUserImpl.InsertAdministratorUsers(ctx, questionnaireID, testCase.args.adminUsers, AdministratorRoleOwner)
 		require.NoError(t, err)
 
 		var questionnaireID2 int
 		if testCase.dualQuestionnaire {
 			questionnaireID2, err = questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 			require.NoError(t, err)
-			err = administratorUserImpl.InsertAdministratorUsers(ctx, questionnaireID2, testCase.args.adminUsers2)
+			err = administratorUserImpl.InsertAdministratorUsers(ctx, questionnaireID2, testCase.args.adminUsers2, AdministratorRoleOwner)
 			require.NoError(t, err)
 		}
 
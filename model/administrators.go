@@ -6,8 +6,9 @@ import "context"
 
 // IAdministrator AdministratorのRepository
 type IAdministrator interface {
-	InsertAdministrators(ctx context.Context, questionnaireID int, administrators []string) error
+	InsertAdministrators(ctx context.Context, questionnaireID int, administrators []string, role AdministratorRole) error
 	DeleteAdministrators(ctx context.Context, questionnaireID int) error
 	GetAdministrators(ctx context.Context, questionnaireIDs []int) ([]Administrators, error)
 	CheckQuestionnaireAdmin(ctx context.Context, userID string, questionnaireID int) (bool, error)
+	GetAdministratorRole(ctx context.Context, userID string, questionnaireID int) (AdministratorRole, error)
 }
@@ -16,14 +16,57 @@ func NewAdministrator() *Administrator {
 	return new(Administrator)
 }
 
+// AdministratorRole アンケート管理者の権限
+type AdministratorRole string
+
+const (
+	// AdministratorRoleOwner アンケートの編集・結果の閲覧に加え、削除や管理者の変更ができる
+	AdministratorRoleOwner AdministratorRole = "owner"
+	// AdministratorRoleEditor アンケートの編集と結果の閲覧ができる
+	AdministratorRoleEditor AdministratorRole = "editor"
+	// AdministratorRoleViewer アンケートの結果の閲覧のみができる
+	AdministratorRoleViewer AdministratorRole = "viewer"
+)
+
+// AdministratorRoles 権限の強い順に並べた管理者の権限の一覧
+var AdministratorRoles = []AdministratorRole{
+	AdministratorRoleOwner,
+	AdministratorRoleEditor,
+	AdministratorRoleViewer,
+}
+
+func (r AdministratorRole) level() int {
+	switch r {
+	case AdministratorRoleOwner:
+		return 3
+	case AdministratorRoleEditor:
+		return 2
+	case AdministratorRoleViewer:
+		return 1
+	}
+
+	return 0
+}
+
+// IsValid 有効な権限か判定
+func (r AdministratorRole) IsValid() bool {
+	return r.level() > 0
+}
+
+// Includes 権限rが権限otherでできることをすべてできるか判定
+func (r AdministratorRole) Includes(other AdministratorRole) bool {
+	return other.IsValid() && r.level() >= other.level()
+}
+
 // Administrators administratorsテーブルの構造体
 type Administrators struct {
-	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
-	UserTraqid      string `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+	QuestionnaireID int               `gorm:"type:int(11);not null;primaryKey"`
+	UserTraqid      string            `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+	Role            AdministratorRole `gorm:"type:varchar(16);size:16;not null;default:owner"`
 }
 
 // InsertAdministrators アンケートの管理者を追加
-func (*Administrator) InsertAdministrators(ctx context.Context, questionnaireID int, administrators []string) error {
+func (*Administrator) InsertAdministrators(ctx context.Context, questionnaireID int, administrators []string, role AdministratorRole) error {
 	db, err := getTx(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get transaction: %w", err)
@@ -39,6 +82,7 @@ func (*Administrator) InsertAdministrators(ctx context.Context, questionnaireID
 		dbAdministrators = append(dbAdministrators, Administrators{
 			QuestionnaireID: questionnaireID,
 			UserTraqid:      v,
+			Role:            role,
 		})
 	}
 
@@ -104,3 +148,25 @@ func (*Administrator) CheckQuestionnaireAdmin(ctx context.Context, userID string
 
 	return true, nil
 }
+
+// GetAdministratorRole アンケートでの自分の管理者の権限を取得
+func (*Administrator) GetAdministratorRole(ctx context.Context, userID string, questionnaireID int) (AdministratorRole, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	administrator := Administrators{}
+	err = db.
+		Where("user_traqid = ? AND questionnaire_id = ?", userID, questionnaireID).
+		Select("role").
+		First(&administrator).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", ErrRecordNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get a administrator: %w", err)
+	}
+
+	return administrator.Role, nil
+}
@@ -32,6 +32,7 @@ func TestAministrators(t *testing.T) {
 	t.Run("DeleteAdministrators", deleteAdministratorsTest)
 	t.Run("GetAdministrators", getAdministratorsTest)
 	t.Run("CheckQuestionnaireAdmin", checkQuestionnaireAdminTest)
+	t.Run("GetAdministratorRole", getAdministratorRoleTest)
 }
 
 func setupAdministratorTest(t *testing.T) {
@@ -179,7 +180,7 @@ func insertAdministratorsTest(t *testing.T) {
 			t.Errorf("failed to create questionnaire(%+v): %v", testCase.args.questionnaire, err)
 		}
 
-		err = administratorImpl.InsertAdministrators(ctx, testCase.args.questionnaire.ID, testCase.args.administrators)
+		err = administratorImpl.InsertAdministrators(ctx, testCase.args.questionnaire.ID, testCase.args.administrators, AdministratorRoleOwner)
 
 		if !testCase.expect.isErr {
 			assertion.NoError(err, testCase.description, "no error")
@@ -348,6 +349,7 @@ func getAdministratorsTest(t *testing.T) {
 					{
 						QuestionnaireID: administratorTestQuestionnaireDatas[0].questionnaire.ID,
 						UserTraqid:      administratorsTestUserIDs[0],
+						Role:            AdministratorRoleOwner,
 					},
 				},
 			},
@@ -362,6 +364,7 @@ func getAdministratorsTest(t *testing.T) {
 					{
 						QuestionnaireID: administratorTestQuestionnaireDatas[0].questionnaire.ID,
 						UserTraqid:      administratorsTestUserIDs[0],
+						Role:            AdministratorRoleOwner,
 					},
 				},
 			},
@@ -489,3 +492,109 @@ func checkQuestionnaireAdminTest(t *testing.T) {
 		assertion.Equal(testCase.expect.isAdmin, actualIsAdmin, testCase.description, "isAdmin")
 	}
 }
+
+func getAdministratorRoleTest(t *testing.T) {
+	t.Helper()
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaire := Questionnaires{
+		Title:       "第1回集会らん☆ぷろ募集アンケート",
+		Description: "第1回集会らん☆ぷろ参加者募集",
+	}
+	err := db.
+		Session(&gorm.Session{NewDB: true}).
+		Create(&questionnaire).Error
+	if err != nil {
+		t.Fatalf("failed to create questionnaire(%+v): %v", questionnaire, err)
+	}
+
+	err = administratorImpl.InsertAdministrators(ctx, questionnaire.ID, []string{administratorsTestUserIDs[0]}, AdministratorRoleOwner)
+	if err != nil {
+		t.Fatalf("failed to insert owner: %v", err)
+	}
+	err = administratorImpl.InsertAdministrators(ctx, questionnaire.ID, []string{administratorsTestUserIDs[1]}, AdministratorRoleViewer)
+	if err != nil {
+		t.Fatalf("failed to insert viewer: %v", err)
+	}
+
+	type args struct {
+		userID          string
+		questionnaireID int
+	}
+	type expect struct {
+		role  AdministratorRole
+		isErr bool
+		err   error
+	}
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	testCases := []test{
+		{
+			description: "owner",
+			args: args{
+				userID:          administratorsTestUserIDs[0],
+				questionnaireID: questionnaire.ID,
+			},
+			expect: expect{
+				role: AdministratorRoleOwner,
+			},
+		},
+		{
+			description: "viewer",
+			args: args{
+				userID:          administratorsTestUserIDs[1],
+				questionnaireID: questionnaire.ID,
+			},
+			expect: expect{
+				role: AdministratorRoleViewer,
+			},
+		},
+		{
+			description: "not administrator",
+			args: args{
+				userID:          invalidAdministratorTestUserID,
+				questionnaireID: questionnaire.ID,
+			},
+			expect: expect{
+				isErr: true,
+				err:   ErrRecordNotFound,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		actualRole, err := administratorImpl.GetAdministratorRole(ctx, testCase.args.userID, testCase.args.questionnaireID)
+
+		if !testCase.expect.isErr {
+			assertion.NoError(err, testCase.description, "no error")
+		} else if testCase.expect.err != nil {
+			assertion.Equal(testCase.expect.err, err, testCase.description, "error")
+		}
+		if err != nil {
+			continue
+		}
+
+		assertion.Equal(testCase.expect.role, actualRole, testCase.description, "role")
+	}
+}
+
+func TestAdministratorRoleIncludes(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	assertion.True(AdministratorRoleOwner.Includes(AdministratorRoleOwner))
+	assertion.True(AdministratorRoleOwner.Includes(AdministratorRoleViewer))
+	assertion.True(AdministratorRoleEditor.Includes(AdministratorRoleViewer))
+	assertion.False(AdministratorRoleEditor.Includes(AdministratorRoleOwner))
+	assertion.False(AdministratorRoleViewer.Includes(AdministratorRoleEditor))
+	assertion.False(AdministratorRole("").Includes(AdministratorRoleViewer))
+	assertion.False(AdministratorRoleOwner.Includes(AdministratorRole("invalid")))
+}
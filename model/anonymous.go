@@ -0,0 +1,119 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// devAnonymousResponseSecret 開発・テスト環境で鍵が設定されていない時に使う鍵
+const devAnonymousResponseSecret = "anke-to-dev-anonymous-response-secret"
+
+// anonymousResponseSecret 匿名回答の回答者を識別するハッシュの鍵
+// DBの管理者が回答者を特定できないよう、DBには保存しない
+var anonymousResponseSecret []byte
+
+// setupAnonymousResponseSecret 匿名回答のハッシュの鍵を設定する
+// 開発・テスト環境以外で鍵が空でないことは、設定の読み込み時に確かめている
+func setupAnonymousResponseSecret(secret string) {
+	if secret == "" {
+		secret = devAnonymousResponseSecret
+	}
+
+	anonymousResponseSecret = []byte(secret)
+}
+
+// AnonymousRespondentKey 匿名のアンケートで回答者を識別するハッシュ
+// アンケートごとに異なる値になるため、複数のアンケートの回答を同じ人のものと結びつけることもできない
+func AnonymousRespondentKey(questionnaireID int, userID string) string {
+	mac := hmac.New(sha256.New, anonymousResponseSecret)
+	fmt.Fprintf(mac, "%d:%s", questionnaireID, userID)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// anonymousRespondentKeys userIDのユーザーが匿名のアンケートに回答した時のハッシュの一覧
+// questionnaireIDsがnilの場合はすべての匿名のアンケートについて求める
+func anonymousRespondentKeys(db *gorm.DB, userID string, questionnaireIDs []int) ([]string, error) {
+	anonymousQuestionnaireIDs := []int{}
+	query := db.
+		Session(&gorm.Session{NewDB: true}).
+		Unscoped().
+		Model(&Questionnaires{}).
+		Where("is_anonymous IS TRUE")
+	if questionnaireIDs != nil {
+		query = query.Where("id IN (?)", questionnaireIDs)
+	}
+	err := query.Pluck("id", &anonymousQuestionnaireIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get anonymous questionnaire ids: %w", err)
+	}
+
+	keys := make([]string, 0, len(anonymousQuestionnaireIDs))
+	for _, questionnaireID := range anonymousQuestionnaireIDs {
+		keys = append(keys, AnonymousRespondentKey(questionnaireID, userID))
+	}
+
+	return keys, nil
+}
+
+// myRespondentCondition tableの回答のうち、userIDのユーザーの回答に絞り込む条件
+// 匿名のアンケートの回答はユーザーIDを保存していないため、ハッシュで照合する
+func myRespondentCondition(db *gorm.DB, table string, userID string, questionnaireIDs []int) (string, []interface{}, error) {
+	keys, err := anonymousRespondentKeys(db, userID, questionnaireIDs)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(keys) == 0 {
+		return fmt.Sprintf("%s.user_traqid = ?", table), []interface{}{userID}, nil
+	}
+
+	return fmt.Sprintf("(%s.user_traqid = ? OR %s.anonymous_key IN (?))", table, table), []interface{}{userID, keys}, nil
+}
+
+// resolveAnonymousRespondents 匿名のアンケートのハッシュから、対象者とリマインドの設定をしたユーザーの中で回答した人を求める
+// 誰が回答したかはリマインドや回答状況の確認に使うが、どの回答が誰のものかはわからない
+// 候補に含まれない回答者はハッシュのまま返す
+func resolveAnonymousRespondents(db *gorm.DB, questionnaireID int, keys []string) (map[string]string, error) {
+	resolved := make(map[string]string, len(keys))
+	if len(keys) == 0 {
+		return resolved, nil
+	}
+
+	candidates := []string{}
+	err := db.
+		Session(&gorm.Session{NewDB: true}).
+		Table("targets").
+		Where("questionnaire_id = ?", questionnaireID).
+		Pluck("user_traqid", &candidates).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get targets: %w", err)
+	}
+	reminderTargets := []string{}
+	err = db.
+		Session(&gorm.Session{NewDB: true}).
+		Table("reminder_targets").
+		Where("questionnaire_id = ?", questionnaireID).
+		Pluck("user_traqid", &reminderTargets).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminder targets: %w", err)
+	}
+	candidates = append(candidates, reminderTargets...)
+
+	keySet := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		keySet[key] = struct{}{}
+		resolved[key] = key
+	}
+	for _, candidate := range candidates {
+		key := AnonymousRespondentKey(questionnaireID, candidate)
+		if _, ok := keySet[key]; ok {
+			resolved[key] = candidate
+		}
+	}
+
+	return resolved, nil
+}
@@ -9,6 +9,22 @@ func Migrations() []*gormigrate.Migration {
 	return []*gormigrate.Migration{
 		v3(),
 		v3_1(),
+		v3_2(),
+		v3_3(),
+		v3_4(),
+		v3_5(),
+		v3_6(),
+		v3_7(),
+		v3_8(),
+		v3_9(),
+		v3_10(),
+		v3_11(),
+		v3_12(),
+		v3_13(),
+		v3_14(),
+		v3_15(),
+		v3_16(),
+		v3_17(),
 	}
 }
 
@@ -28,5 +44,19 @@ func AllTables() []interface{} {
 		&TargetGroups{},
 		&ReminderTargets{},
 		&Validations{},
+		&QuestionnaireSearchTokens{},
+		&Tags{},
+		&QuestionnaireTags{},
+		&SystemAdmins{},
+		&AccessTokens{},
+		&QuestionnaireWebhooks{},
+		&WebhookDeliveries{},
+		&QuickPolls{},
+		&QuickPollStamps{},
+		&QuickPollVotes{},
+		&StreamEvents{},
+		&ResponseViewers{},
+		&ResponseViewerUsers{},
+		&ResponseViewerGroups{},
 	}
 }
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultPageLimit 1ページあたりの件数のデフォルト値
+	DefaultPageLimit = 20
+	// MaxPageLimit 1ページあたりの件数の最大値
+	MaxPageLimit = 100
+)
+
+// Cursor カーソルベースのページネーションでの位置
+// 直前のページの最後の要素のソートキーとIDを保持する
+type Cursor struct {
+	Sort  string    `json:"s,omitempty"`
+	Time  time.Time `json:"t,omitempty"`
+	Text  string    `json:"x,omitempty"`
+	Int   int       `json:"n,omitempty"`
+	IsNil bool      `json:"z,omitempty"`
+	ID    int       `json:"i"`
+}
+
+// Encode クライアントに返す不透明な文字列に変換
+func (c *Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor クライアントから受け取った文字列をCursorに変換
+func DecodeCursor(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cursor: %w", ErrInvalidCursor)
+	}
+
+	var cursor Cursor
+	err = json.Unmarshal(b, &cursor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cursor: %w", ErrInvalidCursor)
+	}
+
+	return &cursor, nil
+}
+
+// whereAfterCursor (column, idColumn)の順に並べたときにカーソルより後ろの要素に絞り込む
+// columnが空の場合はidColumnのみで比較する
+func whereAfterCursor(query *gorm.DB, column string, desc bool, value interface{}, idColumn string, idDesc bool, id int) *gorm.DB {
+	op := ">"
+	if desc {
+		op = "<"
+	}
+	idOp := ">"
+	if idDesc {
+		idOp = "<"
+	}
+
+	if column == "" {
+		return query.Where(fmt.Sprintf("%s %s ?", idColumn, idOp), id)
+	}
+
+	return query.Where(
+		fmt.Sprintf("%s %s ? OR (%s = ? AND %s %s ?)", column, op, column, idColumn, idOp),
+		value, value, id,
+	)
+}
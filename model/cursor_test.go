@@ -0,0 +1,151 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestCursorEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	cursor := &Cursor{
+		Sort: "-created_at",
+		Time: time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC),
+		ID:   42,
+	}
+	decoded, err := DecodeCursor(cursor.Encode())
+	if assertion.NoError(err) {
+		assertion.Equal(cursor.Sort, decoded.Sort)
+		assertion.True(cursor.Time.Equal(decoded.Time))
+		assertion.Equal(cursor.ID, decoded.ID)
+	}
+
+	for _, invalid := range []string{"!!!", "bm90IGpzb24"} {
+		_, err := DecodeCursor(invalid)
+		if !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("invalid error(%s): expected: %+v, actual: %+v", invalid, ErrInvalidCursor, err)
+		}
+	}
+}
+
+func TestGetQuestionnairesCursor(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	for i := 0; i < 7; i++ {
+		_, err := questionnaireImpl.InsertQuestionnaire(ctx, fmt.Sprintf("cursorTestQuestionnaire%d", i%3), "cursor test", null.Time{}, "public", true, false, false)
+		if err != nil {
+			t.Fatalf("failed to insert questionnaire: %v", err)
+		}
+	}
+
+	for _, sort := range []string{"", "created_at", "-title", "modified_at"} {
+		expected, _, _, _, err := questionnaireImpl.GetQuestionnaires(ctx, questionnairesTestUserID, sort, "^cursorTestQuestionnaire", "", nil, 1, 10, nil, false, false, false, nil, nil, nil, false)
+		if err != nil {
+			t.Fatalf("failed to get questionnaires(%s): %v", sort, err)
+		}
+		assertion.Len(expected, 7, sort)
+
+		actual := []QuestionnaireInfo{}
+		var cursor *Cursor
+		for i := 0; i < 10; i++ {
+			questionnaires, totalRecords, pageMax, nextCursor, err := questionnaireImpl.GetQuestionnaires(ctx, questionnairesTestUserID, sort, "^cursorTestQuestionnaire", "", nil, 1, 3, cursor, false, false, false, nil, nil, nil, false)
+			if err != nil {
+				t.Fatalf("failed to get questionnaires(%s): %v", sort, err)
+			}
+			assertion.Equal(7, totalRecords, sort, "totalRecords")
+			assertion.Equal(3, pageMax, sort, "pageMax")
+
+			actual = append(actual, questionnaires...)
+			if nextCursor == nil {
+				break
+			}
+			cursor, err = DecodeCursor(nextCursor.Encode())
+			if err != nil {
+				t.Fatalf("failed to decode cursor(%s): %v", sort, err)
+			}
+		}
+
+		expectedIDs := make([]int, 0, len(expected))
+		for _, questionnaire := range expected {
+			expectedIDs = append(expectedIDs, questionnaire.ID)
+		}
+		actualIDs := make([]int, 0, len(actual))
+		for _, questionnaire := range actual {
+			actualIDs = append(actualIDs, questionnaire.ID)
+		}
+		assertion.Equal(expectedIDs, actualIDs, sort)
+	}
+
+	_, _, _, _, err := questionnaireImpl.GetQuestionnaires(ctx, questionnairesTestUserID, "title", "^cursorTestQuestionnaire", "", nil, 1, 3, &Cursor{Sort: "-title"}, false, false, false, nil, nil, nil, false)
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("invalid error: expected: %+v, actual: %+v", ErrInvalidCursor, err)
+	}
+}
+
+func TestGetRespondentDetailsCursor(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "cursor test", "cursor test", null.Time{}, "public", true, false, true)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+	for i, userID := range []string{userOne, userTwo, userThree, userOne, userTwo} {
+		submittedAt := null.NewTime(time.Now().Add(time.Duration(i%2)*time.Hour), i != 2)
+		_, err := respondentImpl.InsertRespondent(ctx, userID, questionnaireID, submittedAt)
+		if err != nil {
+			t.Fatalf("failed to insert respondent: %v", err)
+		}
+	}
+
+	for _, sort := range []string{"", "traqid", "-traqid", "submitted_at", "-submitted_at", "-modified_at"} {
+		expected, nextCursor, err := respondentImpl.GetRespondentDetails(ctx, questionnaireID, sort, false, "", nil, nil, 0, nil)
+		if err != nil {
+			t.Fatalf("failed to get respondent details(%s): %v", sort, err)
+		}
+		assertion.Nil(nextCursor, sort)
+		assertion.Len(expected, 5, sort)
+
+		actual := []RespondentDetail{}
+		var cursor *Cursor
+		for i := 0; i < 10; i++ {
+			respondentDetails, nextCursor, err := respondentImpl.GetRespondentDetails(ctx, questionnaireID, sort, false, "", nil, nil, 2, cursor)
+			if err != nil {
+				t.Fatalf("failed to get respondent details(%s): %v", sort, err)
+			}
+
+			actual = append(actual, respondentDetails...)
+			if nextCursor == nil {
+				break
+			}
+			cursor, err = DecodeCursor(nextCursor.Encode())
+			if err != nil {
+				t.Fatalf("failed to decode cursor(%s): %v", sort, err)
+			}
+		}
+
+		expectedIDs := make([]int, 0, len(expected))
+		for _, respondentDetail := range expected {
+			expectedIDs = append(expectedIDs, respondentDetail.ResponseID)
+		}
+		actualIDs := make([]int, 0, len(actual))
+		for _, respondentDetail := range actual {
+			actualIDs = append(actualIDs, respondentDetail.ResponseID)
+		}
+		assertion.Equal(expectedIDs, actualIDs, sort)
+	}
+
+	_, _, err = respondentImpl.GetRespondentDetails(ctx, questionnaireID, "1", false, "", nil, nil, 2, nil)
+	if !errors.Is(err, ErrInvalidSortParam) {
+		t.Errorf("invalid error: expected: %+v, actual: %+v", ErrInvalidSortParam, err)
+	}
+}
@@ -1,10 +1,11 @@
 package model
 
 import (
+	"context"
 	"fmt"
-	"os"
 
 	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/traPtitech/anke-to/config"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -14,91 +15,121 @@ import (
 var db *gorm.DB
 
 // EstablishConnection DBと接続
-func EstablishConnection(env string) error {
-	var ok bool
-
-	var user string
-	if env == "neoshowcase" {
-		user, ok = os.LookupEnv("NS_MARIADB_USER")
-	} else {
-		user, ok = os.LookupEnv("MARIADB_USERNAME")
-	}
-	if !ok {
-		panic("no db user")
+func EstablishConnection(cfg *config.Config) error {
+	setupAnonymousResponseSecret(cfg.AnonymousResponseSecret)
+
+	var dialector gorm.Dialector
+	switch cfg.DB.Driver {
+	case config.DBDriverSQLite:
+		dialector = newSQLiteDialector(cfg.DB.Path)
+	case config.DBDriverPostgres:
+		dialector = newPostgresDialector(cfg.DB)
+	default:
+		dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s", cfg.DB.User, cfg.DB.Password, cfg.DB.Host, cfg.DB.Port, cfg.DB.Name) + "?parseTime=true&loc=Asia%2FTokyo&charset=utf8mb4"
+		dialector = mysql.Open(dsn)
 	}
 
-	var pass string
-	if env == "neoshowcase" {
-		pass, ok = os.LookupEnv("NS_MARIADB_PASSWORD")
-	} else {
-		pass, ok = os.LookupEnv("MARIADB_PASSWORD")
-	}
-	if !ok {
-		panic("no db password")
+	var err error
+	db, err = gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(gormLogLevel(cfg.Log.DBLevel)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to connect to DB: %w", err)
 	}
 
-	var host string
-	if env == "neoshowcase" {
-		host, ok = os.LookupEnv("NS_MARIADB_HOSTNAME")
-	} else {
-		host, ok = os.LookupEnv("MARIADB_HOSTNAME")
-	}
-	if !ok {
-		panic("no db host")
+	if cfg.DB.Driver == config.DBDriverMySQL {
+		db = db.Set("gorm:table_options", "ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci")
 	}
 
-	var port string
-	if env == "neoshowcase" {
-		port, ok = os.LookupEnv("NS_MARIADB_PORT")
-	} else {
-		port, ok = os.LookupEnv("MARIADB_PORT")
+	err = db.Use(prometheus.New(prometheus.Config{
+		DBName:          "anke-to",
+		RefreshInterval: 15,
+		MetricsCollector: []prometheus.MetricsCollector{
+			&MetricsCollector{},
+		},
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to use prometheus plugin: %w", err)
 	}
-	if !ok {
-		panic("no db port")
+
+	err = db.Use(&tracingPlugin{})
+	if err != nil {
+		return fmt.Errorf("failed to use tracing plugin: %w", err)
 	}
 
-	var dbname string
-	if env == "neoshowcase" {
-		dbname, ok = os.LookupEnv("NS_MARIADB_DATABASE")
-	} else {
-		dbname, ok = os.LookupEnv("MARIADB_DATABASE")
+	return nil
+}
+
+// CloseConnection DBとの接続を閉じる
+func CloseConnection() error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get sql.DB: %w", err)
 	}
-	if !ok {
-		panic("no db name")
+
+	err = sqlDB.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close DB: %w", err)
 	}
 
-	var logLevel logger.LogLevel
-	if env == "production" {
-		logLevel = logger.Silent
-	} else {
-		logLevel = logger.Info
+	return nil
+}
+
+// Ping DBに接続できるか確認する
+func Ping(ctx context.Context) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get sql.DB: %w", err)
 	}
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s", user, pass, host, port, dbname) + "?parseTime=true&loc=Asia%2FTokyo&charset=utf8mb4"
-	var err error
-	db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logLevel),
-	})
+	err = sqlDB.PingContext(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to connect to DB: %w", err)
+		return fmt.Errorf("failed to ping DB: %w", err)
 	}
 
-	db = db.Set("gorm:table_options", "ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci")
+	return nil
+}
 
-	err = db.Use(prometheus.New(prometheus.Config{
-		DBName:          "anke-to",
-		RefreshInterval: 15,
-		MetricsCollector: []prometheus.MetricsCollector{
-			&MetricsCollector{},
-		},
-	}))
+// CheckMigrations すべてのMigrationが適用済みか確認する
+func CheckMigrations(ctx context.Context) error {
+	var ids []string
+	err := db.
+		WithContext(ctx).
+		Session(&gorm.Session{NewDB: true}).
+		Table(gormigrate.DefaultOptions.TableName).
+		Pluck(gormigrate.DefaultOptions.IDColumnName, &ids).Error
 	if err != nil {
-		return fmt.Errorf("failed to use prometheus plugin: %w", err)
+		return fmt.Errorf("failed to get applied migrations: %w", err)
+	}
+
+	applied := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		applied[id] = struct{}{}
+	}
+
+	for _, migration := range Migrations() {
+		if _, ok := applied[migration.ID]; !ok {
+			return fmt.Errorf("migration %s is not applied", migration.ID)
+		}
 	}
 
 	return nil
 }
 
+// gormLogLevel 設定のログレベルをGORMのログレベルにする
+func gormLogLevel(level config.LogLevel) logger.LogLevel {
+	switch level {
+	case config.LogLevelDebug, config.LogLevelInfo:
+		return logger.Info
+	case config.LogLevelWarn:
+		return logger.Warn
+	case config.LogLevelError:
+		return logger.Error
+	default:
+		return logger.Silent
+	}
+}
+
 func Migrate() (init bool, err error) {
 	m := gormigrate.New(db.Session(&gorm.Session{}), gormigrate.DefaultOptions, Migrations())
 
@@ -1,10 +1,15 @@
 package model
 
 import (
+	"context"
 	"os"
 	"testing"
 
+	"github.com/go-gormigrate/gormigrate/v2"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/config"
+	"gorm.io/gorm"
 )
 
 const (
@@ -37,7 +42,17 @@ var (
 
 // TestMain テストのmain
 func TestMain(m *testing.M) {
-	err := EstablishConnection("production")
+	dir, err := os.MkdirTemp("", "anke-to-model-test")
+	if err != nil {
+		panic(err)
+	}
+
+	cfg, err := config.LoadTest(dir)
+	if err != nil {
+		panic(err)
+	}
+
+	err = EstablishConnection(cfg)
 	if err != nil {
 		panic(err)
 	}
@@ -49,5 +64,44 @@ func TestMain(m *testing.M) {
 
 	code := m.Run()
 
+	os.RemoveAll(dir)
 	os.Exit(code)
 }
+
+func TestPing(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	err := Ping(context.Background())
+	assertion.NoError(err)
+}
+
+func TestCheckMigrations(t *testing.T) {
+	assertion := assert.New(t)
+
+	ctx := context.Background()
+
+	err := CheckMigrations(ctx)
+	assertion.NoError(err, "all migrations applied")
+
+	migrations := Migrations()
+	lastID := migrations[len(migrations)-1].ID
+	newDB := func() *gorm.DB {
+		return db.Session(&gorm.Session{NewDB: true}).Table(gormigrate.DefaultOptions.TableName)
+	}
+
+	err = newDB().Where(gormigrate.DefaultOptions.IDColumnName+" = ?", lastID).Delete(nil).Error
+	if err != nil {
+		t.Fatalf("failed to delete migration: %v", err)
+	}
+	defer func() {
+		err := newDB().Create(map[string]any{gormigrate.DefaultOptions.IDColumnName: lastID}).Error
+		if err != nil {
+			t.Fatalf("failed to restore migration: %v", err)
+		}
+	}()
+
+	err = CheckMigrations(ctx)
+	assertion.ErrorContains(err, lastID, "migration not applied")
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// データベースごとの違いを吸収する
+// カラムの型はMySQL向けに書いているため、SQLiteとPostgreSQLではDialectorで読み替える
+
+// dialectPostgres PostgreSQLのDialectorの名前
+const dialectPostgres = "postgres"
+
+// mysqlTextMaxBytes MySQLのTEXT型の最大バイト数
+var mysqlTextMaxBytes = map[string]int{
+	"text":       65535,
+	"mediumtext": 16777215,
+}
+
+// regexpCondition columnが正規表現に一致する条件
+// MySQLのutf8mb4_general_ciに合わせて、大文字と小文字を区別しない
+func regexpCondition(db *gorm.DB, column string) string {
+	if db.Dialector.Name() == dialectPostgres {
+		return column + " ~* ?"
+	}
+
+	return column + " REGEXP ?"
+}
+
+// nullsFirstOrder NULLを最小の値として扱う並び順
+// MySQLとSQLiteではNULLは最小の値として並ぶが、PostgreSQLでは最大の値として並ぶため、NULLかどうかで先に並べる
+func nullsFirstOrder(column string, desc bool) string {
+	if desc {
+		return column + " IS NULL, " + column + " DESC"
+	}
+
+	return column + " IS NOT NULL, " + column
+}
+
+// quoteColumn カラム名をDialectorに合わせてクォートする
+func quoteColumn(dialector gorm.Dialector, name string) string {
+	var sb strings.Builder
+	dialector.QuoteTo(&sb, name)
+
+	return sb.String()
+}
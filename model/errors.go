@@ -7,6 +7,8 @@ import (
 var (
 	// ErrTooLargePageNum too large page number
 	ErrTooLargePageNum = errors.New("too large page number")
+	// ErrInvalidCursor invalid cursor
+	ErrInvalidCursor = errors.New("invalid cursor")
 	// ErrInvalidRegex invalid regexp
 	ErrInvalidRegex = errors.New("invalid regexp")
 	// ErrRecordNotFound record not found
@@ -31,6 +33,18 @@ var (
 	ErrInvalidTx = errors.New("invalid tx")
 	// ErrDeadlineExceeded deadline exceeded
 	ErrDeadlineExceeded = errors.New("deadline exceeded")
+	// ErrTagNotFound tag not found
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrDuplicatedTagName duplicated tag name
+	ErrDuplicatedTagName = errors.New("duplicated tag name")
+	// ErrDuplicatedSystemAdmin duplicated system admin
+	ErrDuplicatedSystemAdmin = errors.New("duplicated system admin")
+	// ErrLastSystemAdmin システム管理者が1人もいなくなってしまう
+	ErrLastSystemAdmin = errors.New("cannot delete the last system admin")
+	// ErrInvalidAccessTokenScope invalid access token scope
+	ErrInvalidAccessTokenScope = errors.New("invalid access token scope")
+	// ErrInvalidWebhookEvent invalid webhook event
+	ErrInvalidWebhookEvent = errors.New("invalid webhook event")
 	// ErrDuplicatedAnswered
 	ErrDuplicatedAnswered = errors.New("duplicated answered is not allowed")
 )
@@ -121,6 +121,10 @@ func (mc *MetricsCollector) collect(p *gormPrometheus.Prometheus) {
 	}
 }
 
+// isDeletedExpr 論理削除されているかどうかの式
+// SELECTの別名でGROUP BYするのは標準SQLではないため、どのDBでも使えるよう式のままGROUP BYに使う
+const isDeletedExpr = "deleted_at IS NOT NULL"
+
 func (mc *MetricsCollector) collectQuestionnaireMetrics(ctx context.Context, p *gormPrometheus.Prometheus) error {
 	var questionnaireCounts []struct {
 		IsDeleted bool  `gorm:"column:is_deleted"`
@@ -134,8 +138,8 @@ func (mc *MetricsCollector) collectQuestionnaireMetrics(ctx context.Context, p *
 		}).
 		Unscoped().
 		Model(&Questionnaires{}).
-		Select("deleted_at IS NOT NULL AS is_deleted, count(*) as count").
-		Group("is_deleted").
+		Select(isDeletedExpr + " AS is_deleted, count(*) as count").
+		Group(isDeletedExpr).
 		Find(&questionnaireCounts).Error
 	if err != nil {
 		return fmt.Errorf("failed to get questionnaire count from db: %v", err)
@@ -173,8 +177,8 @@ func (mc *MetricsCollector) collectQuestionMetrics(ctx context.Context, p *gormP
 		}).
 		Unscoped().
 		Model(&Questions{}).
-		Select("deleted_at IS NOT NULL AS is_deleted, type, is_required, count(*) as count").
-		Group("is_deleted, type, is_required").
+		Select(isDeletedExpr + " AS is_deleted, type, is_required, count(*) as count").
+		Group(isDeletedExpr + ", type, is_required").
 		Find(&questionCounts).Error
 	if err != nil {
 		return fmt.Errorf("failed to get question count from db: %v", err)
@@ -217,8 +221,8 @@ func (mc *MetricsCollector) collectRespondentMetrics(ctx context.Context, p *gor
 		}).
 		Unscoped().
 		Model(&Respondents{}).
-		Select("deleted_at IS NOT NULL AS is_deleted, count(*) as count").
-		Group("is_deleted").
+		Select(isDeletedExpr + " AS is_deleted, count(*) as count").
+		Group(isDeletedExpr).
 		Find(&respondentCounts).Error
 	if err != nil {
 		return fmt.Errorf("failed to get respondent count from db: %v", err)
@@ -254,8 +258,8 @@ func (mc *MetricsCollector) collectResponseMetrics(ctx context.Context, p *gormP
 		}).
 		Unscoped().
 		Model(&Responses{}).
-		Select("deleted_at IS NOT NULL AS is_deleted, count(*) as count").
-		Group("is_deleted").
+		Select(isDeletedExpr + " AS is_deleted, count(*) as count").
+		Group(isDeletedExpr).
 		Find(&responseCounts).Error
 	if err != nil {
 		return fmt.Errorf("failed to get response count from db: %v", err)
@@ -8,6 +8,7 @@ import "context"
 type IOption interface {
 	InsertOption(ctx context.Context, lastID int, num int, body string) error
 	UpdateOptions(ctx context.Context, options []string, questionID int) error
+	UpdateCorrectOptions(ctx context.Context, questionID int, correctOptions []string) error
 	DeleteOptions(ctx context.Context, questionID int) error
 	GetOptions(ctx context.Context, questionIDs []int) ([]Options, error)
 }
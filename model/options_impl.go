@@ -24,6 +24,7 @@ type Options struct {
 	QuestionID int    `gorm:"type:int(11);not null"`
 	OptionNum  int    `gorm:"type:int(11);not null"`
 	Body       string `gorm:"type:text;default:NULL;"`
+	IsCorrect  bool   `gorm:"type:boolean;not null;default:false"`
 }
 
 // InsertOption 選択肢の追加
@@ -119,6 +120,39 @@ func (*Option) UpdateOptions(ctx context.Context, options []string, questionID i
 	return nil
 }
 
+// UpdateCorrectOptions クイズの正解の選択肢の更新
+// correctOptionsに含まれない選択肢は不正解にする
+func (*Option) UpdateCorrectOptions(ctx context.Context, questionID int, correctOptions []string) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	err = db.
+		Session(&gorm.Session{}).
+		Model(&Options{}).
+		Where("question_id = ?", questionID).
+		Update("is_correct", false).Error
+	if err != nil {
+		return fmt.Errorf("failed to reset correct options: %w", err)
+	}
+
+	if len(correctOptions) == 0 {
+		return nil
+	}
+
+	err = db.
+		Session(&gorm.Session{}).
+		Model(&Options{}).
+		Where("question_id = ? AND body IN (?)", questionID, correctOptions).
+		Update("is_correct", true).Error
+	if err != nil {
+		return fmt.Errorf("failed to update correct options: %w", err)
+	}
+
+	return nil
+}
+
 // DeleteOptions 選択肢の削除
 func (*Option) DeleteOptions(ctx context.Context, questionID int) error {
 	db, err := getTx(ctx)
@@ -146,13 +180,14 @@ func (*Option) GetOptions(ctx context.Context, questionIDs []int) ([]Options, er
 	type option struct {
 		QuestionID int         `gorm:"type:int(11) NOT NULL;"`
 		Body       null.String `gorm:"type:text;default:NULL;"`
+		IsCorrect  bool        `gorm:"type:boolean;not null;default:false"`
 	}
 	options := []option{}
 
 	err = db.
 		Where("question_id IN (?)", questionIDs).
 		Order("question_id, option_num").
-		Select("question_id, body").
+		Select("question_id, body, is_correct").
 		Find(&options).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to get option: %w", err)
@@ -163,6 +198,7 @@ func (*Option) GetOptions(ctx context.Context, questionIDs []int) ([]Options, er
 		optns = append(optns, Options{
 			QuestionID: optn.QuestionID,
 			Body:       optn.Body.ValueOrZero(),
+			IsCorrect:  optn.IsCorrect,
 		})
 	}
 
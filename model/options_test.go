@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
 
@@ -689,3 +690,70 @@ func TestGetOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateCorrectOptions(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaire := Questionnaires{}
+	err := db.
+		Session(&gorm.Session{}).
+		Create(&questionnaire).Error
+	require.NoError(t, err)
+
+	question := Questions{
+		QuestionnaireID: questionnaire.ID,
+		Type:            "Checkbox",
+		IsRequired:      true,
+	}
+	err = db.
+		Session(&gorm.Session{}).
+		Create(&question).Error
+	require.NoError(t, err)
+
+	for i, body := range []string{"a", "b", "c"} {
+		err = optionImpl.InsertOption(ctx, question.ID, i+1, body)
+		require.NoError(t, err)
+	}
+
+	type test struct {
+		description    string
+		correctOptions []string
+		expect         map[string]bool
+	}
+
+	testCases := []test{
+		{
+			description:    "指定した選択肢が正解になる",
+			correctOptions: []string{"a", "b"},
+			expect:         map[string]bool{"a": true, "b": true, "c": false},
+		},
+		{
+			description:    "指定しなかった選択肢は不正解に戻る",
+			correctOptions: []string{"c"},
+			expect:         map[string]bool{"a": false, "b": false, "c": true},
+		},
+		{
+			description:    "空なら正解をすべて取り除く",
+			correctOptions: []string{},
+			expect:         map[string]bool{"a": false, "b": false, "c": false},
+		},
+	}
+
+	for _, testCase := range testCases {
+		err = optionImpl.UpdateCorrectOptions(ctx, question.ID, testCase.correctOptions)
+		if !assertion.NoError(err, testCase.description, "no error") {
+			continue
+		}
+
+		options, err := optionImpl.GetOptions(ctx, []int{question.ID})
+		require.NoError(t, err)
+		actual := map[string]bool{}
+		for _, option := range options {
+			actual[option.Body] = option.IsCorrect
+		}
+		assertion.Equal(testCase.expect, actual, testCase.description, "is_correct")
+	}
+}
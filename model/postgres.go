@@ -0,0 +1,85 @@
+package model
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/traPtitech/anke-to/config"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// postgresDSN PostgreSQLの接続先の設定からDSNを作る
+func postgresDSN(cfg config.DB) string {
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     net.JoinHostPort(cfg.Host, cfg.Port),
+		Path:     "/" + cfg.Name,
+		RawQuery: url.Values{"TimeZone": []string{"Asia/Tokyo"}}.Encode(),
+	}
+
+	return dsn.String()
+}
+
+// newPostgresDialector PostgreSQLのDialectorを作る
+func newPostgresDialector(cfg config.DB) gorm.Dialector {
+	return &postgresDialector{
+		Dialector: postgres.Dialector{
+			Config: &postgres.Config{
+				DSN: postgresDSN(cfg),
+			},
+		},
+	}
+}
+
+// postgresDialector MySQL向けのカラムの型をPostgreSQLの型にするDialector
+type postgresDialector struct {
+	postgres.Dialector
+}
+
+func (dialector *postgresDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return postgres.Migrator{
+		Migrator: migrator.Migrator{
+			Config: migrator.Config{
+				DB:                          db,
+				Dialector:                   dialector,
+				CreateIndexAfterCreateTable: true,
+			},
+		},
+	}
+}
+
+// DataTypeOf MySQL向けのカラムの型をPostgreSQLの型にする
+// TEXT型は長さの上限がないため、MySQLと同じ値を弾くようにCHECK制約を付ける
+func (dialector *postgresDialector) DataTypeOf(field *schema.Field) string {
+	dataType := strings.ToLower(string(field.DataType))
+
+	switch {
+	case strings.Contains(dataType, "auto_increment"):
+		if field.PrimaryKey && len(field.Schema.PrimaryFields) == 1 {
+			return "serial"
+		}
+		return "integer"
+	case strings.HasPrefix(dataType, "timestamp"):
+		return "timestamptz"
+	case strings.HasPrefix(dataType, "int("):
+		return "integer"
+	case strings.HasPrefix(dataType, "tinyint("):
+		if field.GORMDataType == schema.Bool {
+			return "boolean"
+		}
+		return "smallint"
+	case strings.HasPrefix(dataType, "varbinary("):
+		return "varchar" + strings.TrimPrefix(dataType, "varbinary")
+	}
+	if maxBytes, ok := mysqlTextMaxBytes[dataType]; ok {
+		return fmt.Sprintf("text CHECK (octet_length(%s) <= %d)", quoteColumn(dialector, field.DBName), maxBytes)
+	}
+
+	return dialector.Dialector.DataTypeOf(field)
+}
@@ -0,0 +1,23 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+// IQuestionnaireWebhook QuestionnaireWebhookのRepository
+type IQuestionnaireWebhook interface {
+	InsertQuestionnaireWebhook(ctx context.Context, questionnaireID int, url string, secret string, events []WebhookEvent, createdBy string) (int, error)
+	GetQuestionnaireWebhooks(ctx context.Context, questionnaireID int) ([]QuestionnaireWebhooks, error)
+	GetQuestionnaireWebhook(ctx context.Context, questionnaireID int, webhookID int) (*QuestionnaireWebhooks, error)
+	DeleteQuestionnaireWebhook(ctx context.Context, questionnaireID int, webhookID int) error
+	InsertWebhookDelivery(ctx context.Context, webhookID int, event WebhookEvent, payload string) (int, error)
+	UpdateWebhookDelivery(ctx context.Context, deliveryID int, status WebhookDeliveryStatus, attempts int, statusCode null.Int, errorMessage string, nextAttemptAt null.Time) error
+	GetWebhookDeliveries(ctx context.Context, webhookID int, limit int) ([]WebhookDeliveries, error)
+	GetPendingWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]PendingWebhookDelivery, error)
+	ClaimWebhookDelivery(ctx context.Context, deliveryID int, attempts int, leaseUntil time.Time) (bool, error)
+}
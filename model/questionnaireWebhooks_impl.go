@@ -0,0 +1,346 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+// QuestionnaireWebhook QuestionnaireWebhookRepositoryの実装
+type QuestionnaireWebhook struct{}
+
+// NewQuestionnaireWebhook QuestionnaireWebhookのコンストラクター
+func NewQuestionnaireWebhook() *QuestionnaireWebhook {
+	return new(QuestionnaireWebhook)
+}
+
+// WebhookEvent Webhookで通知するイベント
+type WebhookEvent string
+
+const (
+	// WebhookEventResponseSubmitted 回答が提出された
+	WebhookEventResponseSubmitted WebhookEvent = "response.submitted"
+	// WebhookEventResponseEdited 提出済みの回答が編集された
+	WebhookEventResponseEdited WebhookEvent = "response.edited"
+	// WebhookEventResponseDeleted 提出済みの回答が削除された
+	WebhookEventResponseDeleted WebhookEvent = "response.deleted"
+	// WebhookEventQuestionnaireClosed アンケートの回答が締め切られた
+	WebhookEventQuestionnaireClosed WebhookEvent = "questionnaire.closed"
+)
+
+// WebhookEvents 有効なイベントの一覧
+var WebhookEvents = []WebhookEvent{
+	WebhookEventResponseSubmitted,
+	WebhookEventResponseEdited,
+	WebhookEventResponseDeleted,
+	WebhookEventQuestionnaireClosed,
+}
+
+// IsValid 有効なイベントか
+func (event WebhookEvent) IsValid() bool {
+	return slices.Contains(WebhookEvents, event)
+}
+
+// WebhookDeliveryStatus Webhookの送信状況
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending 送信中(再送待ちを含む)
+	// next_attempt_atになると送信する
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryStatusSucceeded 送信成功
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryStatusFailed 再送しても送信できなかった
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+)
+
+// QuestionnaireWebhooks questionnaire_webhooksテーブルの構造体
+type QuestionnaireWebhooks struct {
+	ID              int       `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	QuestionnaireID int       `gorm:"type:int(11);not null;index"`
+	URL             string    `gorm:"type:varchar(500);size:500;not null"`
+	Secret          string    `gorm:"type:varchar(64);size:64;not null"`
+	Events          string    `gorm:"type:varchar(255);size:255;not null"`
+	CreatedBy       string    `gorm:"type:varchar(32);size:32;not null"`
+	CreatedAt       time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// WebhookDeliveries webhook_deliveriesテーブルの構造体
+type WebhookDeliveries struct {
+	ID            int                   `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	WebhookID     int                   `gorm:"type:int(11);not null;index"`
+	Event         string                `gorm:"type:varchar(32);size:32;not null"`
+	Payload       string                `gorm:"type:text;not null"`
+	Status        WebhookDeliveryStatus `gorm:"type:varchar(16);size:16;not null"`
+	Attempts      int                   `gorm:"type:int(11);not null;default:0"`
+	StatusCode    null.Int              `gorm:"type:int(11);default:NULL"`
+	ErrorMessage  string                `gorm:"type:varchar(1024);size:1024;not null;default:''"`
+	NextAttemptAt null.Time             `gorm:"type:TIMESTAMP NULL;default:NULL;index"`
+	CreatedAt     time.Time             `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time             `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// PendingWebhookDelivery 送信を待っているWebhookの送信履歴と送信先
+type PendingWebhookDelivery struct {
+	WebhookDeliveries
+	URL    string `gorm:"column:url"`
+	Secret string `gorm:"column:secret"`
+}
+
+// BeforeCreate insert時に自動でcreated_atを現在時刻に
+func (questionnaireWebhook *QuestionnaireWebhooks) BeforeCreate(_ *gorm.DB) error {
+	questionnaireWebhook.CreatedAt = time.Now()
+
+	return nil
+}
+
+// BeforeCreate insert時に自動でcreated_at, updated_atを現在時刻に
+func (webhookDelivery *WebhookDeliveries) BeforeCreate(_ *gorm.DB) error {
+	now := time.Now()
+	webhookDelivery.CreatedAt = now
+	webhookDelivery.UpdatedAt = now
+
+	return nil
+}
+
+// GetEvents 通知するイベントの一覧を取得
+// eventsカラムにはスペース区切りで保存している
+func (questionnaireWebhook *QuestionnaireWebhooks) GetEvents() []WebhookEvent {
+	events := []WebhookEvent{}
+	for _, event := range strings.Fields(questionnaireWebhook.Events) {
+		events = append(events, WebhookEvent(event))
+	}
+
+	return events
+}
+
+// HasEvent イベントを通知するか
+func (questionnaireWebhook *QuestionnaireWebhooks) HasEvent(event WebhookEvent) bool {
+	return slices.Contains(questionnaireWebhook.GetEvents(), event)
+}
+
+// InsertQuestionnaireWebhook Webhookの追加
+func (*QuestionnaireWebhook) InsertQuestionnaireWebhook(ctx context.Context, questionnaireID int, url string, secret string, events []WebhookEvent, createdBy string) (int, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	strEvents := make([]string, 0, len(events))
+	for _, event := range events {
+		if !event.IsValid() {
+			return 0, ErrInvalidWebhookEvent
+		}
+		if !slices.Contains(strEvents, string(event)) {
+			strEvents = append(strEvents, string(event))
+		}
+	}
+
+	questionnaireWebhook := QuestionnaireWebhooks{
+		QuestionnaireID: questionnaireID,
+		URL:             url,
+		Secret:          secret,
+		Events:          strings.Join(strEvents, " "),
+		CreatedBy:       createdBy,
+	}
+	err = db.Create(&questionnaireWebhook).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert questionnaire webhook: %w", err)
+	}
+
+	return questionnaireWebhook.ID, nil
+}
+
+// GetQuestionnaireWebhooks アンケートのWebhook一覧の取得
+func (*QuestionnaireWebhook) GetQuestionnaireWebhooks(ctx context.Context, questionnaireID int) ([]QuestionnaireWebhooks, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	questionnaireWebhooks := []QuestionnaireWebhooks{}
+	err = db.
+		Where("questionnaire_id = ?", questionnaireID).
+		Order("id").
+		Find(&questionnaireWebhooks).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questionnaire webhooks: %w", err)
+	}
+
+	return questionnaireWebhooks, nil
+}
+
+// GetQuestionnaireWebhook アンケートのWebhookの取得
+func (*QuestionnaireWebhook) GetQuestionnaireWebhook(ctx context.Context, questionnaireID int, webhookID int) (*QuestionnaireWebhooks, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	var questionnaireWebhook QuestionnaireWebhooks
+	err = db.
+		Where("id = ? AND questionnaire_id = ?", webhookID, questionnaireID).
+		First(&questionnaireWebhook).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questionnaire webhook: %w", err)
+	}
+
+	return &questionnaireWebhook, nil
+}
+
+// DeleteQuestionnaireWebhook Webhookと送信履歴の削除
+func (*QuestionnaireWebhook) DeleteQuestionnaireWebhook(ctx context.Context, questionnaireID int, webhookID int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	result := db.
+		Where("id = ? AND questionnaire_id = ?", webhookID, questionnaireID).
+		Delete(&QuestionnaireWebhooks{})
+	err = result.Error
+	if err != nil {
+		return fmt.Errorf("failed to delete questionnaire webhook: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNoRecordDeleted
+	}
+
+	err = db.
+		Where("webhook_id = ?", webhookID).
+		Delete(&WebhookDeliveries{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// InsertWebhookDelivery Webhookの送信履歴の追加
+func (*QuestionnaireWebhook) InsertWebhookDelivery(ctx context.Context, webhookID int, event WebhookEvent, payload string) (int, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	webhookDelivery := WebhookDeliveries{
+		WebhookID: webhookID,
+		Event:     string(event),
+		Payload:   payload,
+		Status:    WebhookDeliveryStatusPending,
+		// すぐに送信する
+		NextAttemptAt: null.TimeFrom(time.Now()),
+	}
+	err = db.Create(&webhookDelivery).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert webhook delivery: %w", err)
+	}
+
+	return webhookDelivery.ID, nil
+}
+
+// UpdateWebhookDelivery Webhookの送信結果の更新
+// 再送しない場合、nextAttemptAtはnull
+func (*QuestionnaireWebhook) UpdateWebhookDelivery(ctx context.Context, deliveryID int, status WebhookDeliveryStatus, attempts int, statusCode null.Int, errorMessage string, nextAttemptAt null.Time) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	if len(errorMessage) > 1024 {
+		errorMessage = errorMessage[:1024]
+	}
+
+	err = db.
+		Model(&WebhookDeliveries{}).
+		Where("id = ?", deliveryID).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"attempts":        attempts,
+			"status_code":     statusCode,
+			"error_message":   errorMessage,
+			"next_attempt_at": nextAttemptAt,
+			"updated_at":      time.Now(),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveries Webhookの送信履歴を新しい順に取得
+func (*QuestionnaireWebhook) GetWebhookDeliveries(ctx context.Context, webhookID int, limit int) ([]WebhookDeliveries, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	webhookDeliveries := []WebhookDeliveries{}
+	err = db.
+		Where("webhook_id = ?", webhookID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&webhookDeliveries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+
+	return webhookDeliveries, nil
+}
+
+// GetPendingWebhookDeliveries 送信する時刻になったWebhookの送信履歴を、送信先と合わせて古い順に取得
+func (*QuestionnaireWebhook) GetPendingWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]PendingWebhookDelivery, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	pendingWebhookDeliveries := []PendingWebhookDelivery{}
+	err = db.
+		Table("webhook_deliveries").
+		Joins("INNER JOIN questionnaire_webhooks ON questionnaire_webhooks.id = webhook_deliveries.webhook_id").
+		Where("webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= ?", WebhookDeliveryStatusPending, now).
+		Order("webhook_deliveries.next_attempt_at, webhook_deliveries.id").
+		Limit(limit).
+		Select("webhook_deliveries.*, questionnaire_webhooks.url, questionnaire_webhooks.secret").
+		Find(&pendingWebhookDeliveries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending webhook deliveries: %w", err)
+	}
+
+	return pendingWebhookDeliveries, nil
+}
+
+// ClaimWebhookDelivery Webhookの送信を始める
+// 送信を試みた回数を1増やし、送信中に他のインスタンスから送信されないようleaseUntilまで送信を遅らせる
+// attemptsが変わっている場合は他で送信を始めているため、falseを返す
+func (*QuestionnaireWebhook) ClaimWebhookDelivery(ctx context.Context, deliveryID int, attempts int, leaseUntil time.Time) (bool, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	result := db.
+		Model(&WebhookDeliveries{}).
+		Where("id = ? AND status = ? AND attempts = ?", deliveryID, WebhookDeliveryStatusPending, attempts).
+		Updates(map[string]interface{}{
+			"attempts":        attempts + 1,
+			"next_attempt_at": leaseUntil,
+			"updated_at":      time.Now(),
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to claim webhook delivery: %w", result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestQuestionnaireWebhooks(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+	questionnaireImpl := NewQuestionnaire()
+	questionnaireWebhookImpl := NewQuestionnaireWebhook()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "webhooksTestQuestionnaire", "webhooks test", null.Time{}, "public", true, false, false)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+	otherQuestionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "webhooksTestQuestionnaire", "webhooks test", null.Time{}, "public", true, false, false)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+
+	_, err = questionnaireWebhookImpl.InsertQuestionnaireWebhook(ctx, questionnaireID, "https://example.com/hook", "secret", []WebhookEvent{"response.unknown"}, userOne)
+	if !errors.Is(err, ErrInvalidWebhookEvent) {
+		t.Errorf("invalid error(invalid event): expected: %+v, actual: %+v", ErrInvalidWebhookEvent, err)
+	}
+
+	webhookID, err := questionnaireWebhookImpl.InsertQuestionnaireWebhook(ctx, questionnaireID, "https://example.com/hook", "secret", []WebhookEvent{
+		WebhookEventResponseSubmitted,
+		WebhookEventQuestionnaireClosed,
+		WebhookEventResponseSubmitted,
+	}, userOne)
+	if !assertion.NoError(err) {
+		return
+	}
+
+	webhooks, err := questionnaireWebhookImpl.GetQuestionnaireWebhooks(ctx, questionnaireID)
+	if assertion.NoError(err) && assertion.Len(webhooks, 1) {
+		assertion.Equal(webhookID, webhooks[0].ID)
+		assertion.Equal("https://example.com/hook", webhooks[0].URL)
+		assertion.Equal("secret", webhooks[0].Secret)
+		assertion.Equal(userOne, webhooks[0].CreatedBy)
+		assertion.Equal([]WebhookEvent{WebhookEventResponseSubmitted, WebhookEventQuestionnaireClosed}, webhooks[0].GetEvents())
+		assertion.True(webhooks[0].HasEvent(WebhookEventQuestionnaireClosed))
+		assertion.False(webhooks[0].HasEvent(WebhookEventResponseDeleted))
+	}
+
+	_, err = questionnaireWebhookImpl.GetQuestionnaireWebhook(ctx, otherQuestionnaireID, webhookID)
+	if !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("invalid error(other questionnaire): expected: %+v, actual: %+v", ErrRecordNotFound, err)
+	}
+
+	deliveryID, err := questionnaireWebhookImpl.InsertWebhookDelivery(ctx, webhookID, WebhookEventResponseSubmitted, `{"event":"response.submitted"}`)
+	if !assertion.NoError(err) {
+		return
+	}
+	pendingDeliveryID, err := questionnaireWebhookImpl.InsertWebhookDelivery(ctx, webhookID, WebhookEventQuestionnaireClosed, `{"event":"questionnaire.closed"}`)
+	assertion.NoError(err)
+
+	err = questionnaireWebhookImpl.UpdateWebhookDelivery(ctx, deliveryID, WebhookDeliveryStatusFailed, 4, null.IntFrom(500), "unexpected status code", null.Time{})
+	assertion.NoError(err)
+
+	findPendingDelivery := func(now time.Time) *PendingWebhookDelivery {
+		pendingDeliveries, err := questionnaireWebhookImpl.GetPendingWebhookDeliveries(ctx, now, 100)
+		if !assertion.NoError(err) {
+			return nil
+		}
+		for _, pendingDelivery := range pendingDeliveries {
+			assertion.NotEqual(deliveryID, pendingDelivery.ID, "failed delivery is not pending")
+			if pendingDelivery.ID == pendingDeliveryID {
+				return &pendingDelivery
+			}
+		}
+		return nil
+	}
+
+	pendingDelivery := findPendingDelivery(time.Now().Add(time.Second))
+	if assertion.NotNil(pendingDelivery, "pending delivery") {
+		assertion.Equal("https://example.com/hook", pendingDelivery.URL)
+		assertion.Equal("secret", pendingDelivery.Secret)
+		assertion.Equal(`{"event":"questionnaire.closed"}`, pendingDelivery.Payload)
+		assertion.Equal(0, pendingDelivery.Attempts)
+	}
+
+	leaseUntil := time.Now().Add(time.Minute)
+	claimed, err := questionnaireWebhookImpl.ClaimWebhookDelivery(ctx, pendingDeliveryID, 0, leaseUntil)
+	assertion.NoError(err)
+	assertion.True(claimed, "claim")
+	claimed, err = questionnaireWebhookImpl.ClaimWebhookDelivery(ctx, pendingDeliveryID, 0, leaseUntil)
+	assertion.NoError(err)
+	assertion.False(claimed, "claimed twice")
+
+	assertion.Nil(findPendingDelivery(time.Now().Add(time.Second)), "pending delivery while sending")
+	pendingDelivery = findPendingDelivery(leaseUntil.Add(time.Second))
+	if assertion.NotNil(pendingDelivery, "pending delivery after lease") {
+		assertion.Equal(1, pendingDelivery.Attempts)
+	}
+
+	deliveries, err := questionnaireWebhookImpl.GetWebhookDeliveries(ctx, webhookID, 10)
+	if assertion.NoError(err) && assertion.Len(deliveries, 2) {
+		assertion.Equal(string(WebhookEventQuestionnaireClosed), deliveries[0].Event)
+		assertion.Equal(WebhookDeliveryStatusPending, deliveries[0].Status)
+		assertion.Equal(deliveryID, deliveries[1].ID)
+		assertion.Equal(WebhookDeliveryStatusFailed, deliveries[1].Status)
+		assertion.Equal(4, deliveries[1].Attempts)
+		assertion.Equal(null.IntFrom(500), deliveries[1].StatusCode)
+		assertion.Equal("unexpected status code", deliveries[1].ErrorMessage)
+	}
+
+	deliveries, err = questionnaireWebhookImpl.GetWebhookDeliveries(ctx, webhookID, 1)
+	if assertion.NoError(err) {
+		assertion.Len(deliveries, 1)
+	}
+
+	err = questionnaireWebhookImpl.DeleteQuestionnaireWebhook(ctx, otherQuestionnaireID, webhookID)
+	if !errors.Is(err, ErrNoRecordDeleted) {
+		t.Errorf("invalid error(other questionnaire): expected: %+v, actual: %+v", ErrNoRecordDeleted, err)
+	}
+
+	err = questionnaireWebhookImpl.DeleteQuestionnaireWebhook(ctx, questionnaireID, webhookID)
+	assertion.NoError(err)
+
+	deliveries, err = questionnaireWebhookImpl.GetWebhookDeliveries(ctx, webhookID, 10)
+	if assertion.NoError(err) {
+		assertion.Len(deliveries, 0)
+	}
+}
@@ -14,7 +14,8 @@ type IQuestionnaire interface {
 	InsertQuestionnaire(ctx context.Context, title string, description string, resTimeLimit null.Time, resSharedTo string, isPublished bool, isAnonymous bool, isDuplicateAnswerAllowed bool) (int, error)
 	UpdateQuestionnaire(ctx context.Context, title string, description string, resTimeLimit null.Time, resSharedTo string, questionnaireID int, isPublished bool, isAnonymous bool, isDuplicateAnswerAllowed bool) error
 	DeleteQuestionnaire(ctx context.Context, questionnaireID int) error
-	GetQuestionnaires(ctx context.Context, userID string, sort string, search string, pageNum int, onlyTargetingMe bool, onlyAdministratedByMe bool, notOverDue bool, hasMyResponse *bool, hasMyDraft *bool, isDraft *bool, countOnly bool) ([]QuestionnaireInfo, int, int, error)
+	GetQuestionnaires(ctx context.Context, userID string, sort string, search string, keyword string, tagIDs []int, pageNum int, limit int, cursor *Cursor, onlyTargetingMe bool, onlyAdministratedByMe bool, notOverDue bool, hasMyResponse *bool, hasMyDraft *bool, isDraft *bool, countOnly bool) ([]QuestionnaireInfo, int, int, *Cursor, error)
+	GetQuestionnaireTagCounts(ctx context.Context, userID string, search string, keyword string, tagIDs []int, onlyTargetingMe bool, onlyAdministratedByMe bool, notOverDue bool, hasMyResponse *bool, hasMyDraft *bool, isDraft *bool) ([]TagCount, error)
 	GetAdminQuestionnaires(ctx context.Context, userID string) ([]Questionnaires, error)
 	GetQuestionnaireInfo(ctx context.Context, questionnaireID int) (*Questionnaires, []string, []string, []uuid.UUID, []string, []string, []uuid.UUID, []string, error)
 	GetTargettedQuestionnaires(ctx context.Context, userID string, answered string, sort string) ([]TargettedQuestionnaire, error)
@@ -23,6 +24,11 @@ type IQuestionnaire interface {
 	GetResponseReadPrivilegeInfoByResponseID(ctx context.Context, userID string, responseID int) (*ResponseReadPrivilegeInfo, error)
 	GetResponseReadPrivilegeInfoByQuestionnaireID(ctx context.Context, userID string, questionnaireID int) (*ResponseReadPrivilegeInfo, error)
 	GetResponseIsAnonymousByQuestionnaireID(ctx context.Context, questionnaireID int) (bool, error)
+	GetQuestionnaireIsQuiz(ctx context.Context, questionnaireID int) (bool, error)
 	GetQuestionnairesInfoForReminder(ctx context.Context) ([]Questionnaires, error)
 	UpdateQuestionnaireLimit(ctx context.Context, questionnaireID int, resTimeLimit null.Time) error
+	UpdateQuestionnaireResponseVisibility(ctx context.Context, questionnaireID int, isResponseHiddenUntilDue bool, isResponseAggregateOnly bool) error
+	UpdateQuestionnaireQuizSettings(ctx context.Context, questionnaireID int, isQuiz bool, isQuizAnswerHiddenUntilDue bool) error
+	UpdateQuestionnaireLanguage(ctx context.Context, questionnaireID int, language string) error
+	UpdateQuestionnaireMessageTemplates(ctx context.Context, questionnaireID int, announcementTemplate null.String, reminderTemplate null.String) error
 }
@@ -22,25 +22,32 @@ func NewQuestionnaire() *Questionnaire {
 
 // Questionnaires questionnairesテーブルの構造体
 type Questionnaires struct {
-	ID                       int                   `json:"questionnaireID" gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
-	Title                    string                `json:"title"           gorm:"type:varchar(1024);size:1024;not null"`
-	Description              string                `json:"description"     gorm:"type:text;not null"`
-	ResTimeLimit             null.Time             `json:"res_time_limit,omitempty"  gorm:"type:TIMESTAMP NULL;default:NULL;"`
-	DeletedAt                gorm.DeletedAt        `json:"-"      gorm:"type:TIMESTAMP NULL;default:NULL;"`
-	ResSharedTo              string                `json:"res_shared_to"   gorm:"type:char(30);size:30;not null;default:administrators"`
-	CreatedAt                time.Time             `json:"created_at"      gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
-	ModifiedAt               time.Time             `json:"modified_at"     gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
-	Administrators           []Administrators      `json:"-"  gorm:"foreignKey:QuestionnaireID"`
-	AdministratorUsers       []AdministratorUsers  `json:"-" gorm:"foreignKey:QuestionnaireID"`
-	AdministratorGroups      []AdministratorGroups `json:"-" gorm:"foreignKey:QuestionnaireID"`
-	Targets                  []Targets             `json:"-"  gorm:"foreignKey:QuestionnaireID"`
-	TargetUsers              []TargetUsers         `json:"-" gorm:"foreignKey:QuestionnaireID"`
-	TargetGroups             []TargetGroups        `json:"-" gorm:"foreignKey:QuestionnaireID"`
-	Questions                []Questions           `json:"-"  gorm:"foreignKey:QuestionnaireID"`
-	Respondents              []Respondents         `json:"-"  gorm:"foreignKey:QuestionnaireID"`
-	IsPublished              bool                  `json:"is_published" gorm:"type:boolean;not null;default:false"`
-	IsAnonymous              bool                  `json:"is_anonymous" gorm:"type:boolean;not null;default:false"`
-	IsDuplicateAnswerAllowed bool                  `json:"is_duplicate_answer_allowed" gorm:"type:boolean;not null;default:false"`
+	ID                         int                   `json:"questionnaireID" gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	Title                      string                `json:"title"           gorm:"type:varchar(1024);size:1024;not null"`
+	Description                string                `json:"description"     gorm:"type:text;not null"`
+	ResTimeLimit               null.Time             `json:"res_time_limit,omitempty"  gorm:"type:TIMESTAMP NULL;default:NULL;"`
+	DeletedAt                  gorm.DeletedAt        `json:"-"      gorm:"type:TIMESTAMP NULL;default:NULL;"`
+	ResSharedTo                string                `json:"res_shared_to"   gorm:"type:char(30);size:30;not null;default:administrators"`
+	CreatedAt                  time.Time             `json:"created_at"      gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	ModifiedAt                 time.Time             `json:"modified_at"     gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	Administrators             []Administrators      `json:"-"  gorm:"foreignKey:QuestionnaireID"`
+	AdministratorUsers         []AdministratorUsers  `json:"-" gorm:"foreignKey:QuestionnaireID"`
+	AdministratorGroups        []AdministratorGroups `json:"-" gorm:"foreignKey:QuestionnaireID"`
+	Targets                    []Targets             `json:"-"  gorm:"foreignKey:QuestionnaireID"`
+	TargetUsers                []TargetUsers         `json:"-" gorm:"foreignKey:QuestionnaireID"`
+	TargetGroups               []TargetGroups        `json:"-" gorm:"foreignKey:QuestionnaireID"`
+	Questions                  []Questions           `json:"-"  gorm:"foreignKey:QuestionnaireID"`
+	Respondents                []Respondents         `json:"-"  gorm:"foreignKey:QuestionnaireID"`
+	IsPublished                bool                  `json:"is_published" gorm:"type:boolean;not null;default:false"`
+	IsAnonymous                bool                  `json:"is_anonymous" gorm:"type:boolean;not null;default:false"`
+	IsDuplicateAnswerAllowed   bool                  `json:"is_duplicate_answer_allowed" gorm:"type:boolean;not null;default:false"`
+	IsResponseHiddenUntilDue   bool                  `json:"is_response_hidden_until_due" gorm:"type:boolean;not null;default:false"`
+	IsResponseAggregateOnly    bool                  `json:"is_response_aggregate_only" gorm:"type:boolean;not null;default:false"`
+	IsQuiz                     bool                  `json:"is_quiz" gorm:"type:boolean;not null;default:false"`
+	IsQuizAnswerHiddenUntilDue bool                  `json:"is_quiz_answer_hidden_until_due" gorm:"type:boolean;not null;default:false"`
+	Language                   string                `json:"language" gorm:"type:varchar(8);size:8;not null;default:ja"`
+	AnnouncementTemplate       null.String           `json:"announcement_template,omitempty" gorm:"type:text;default:NULL"`
+	ReminderTemplate           null.String           `json:"reminder_template,omitempty" gorm:"type:text;default:NULL"`
 }
 
 // BeforeCreate Update時に自動でmodified_atを現在時刻に
@@ -64,6 +71,7 @@ type QuestionnaireInfo struct {
 	Questionnaires
 	IsTargeted          bool `json:"is_targeted" gorm:"type:boolean"`
 	IsAdministratedByMe bool `json:"is_administrated_by_me" gorm:"type:boolean"`
+	Relevance           int  `json:"-" gorm:"column:relevance"`
 }
 
 // QuestionnaireDetail Questionnaireの詳細
@@ -82,11 +90,27 @@ type TargettedQuestionnaire struct {
 }
 
 type ResponseReadPrivilegeInfo struct {
-	ResSharedTo     string
-	IsAdministrator bool
-	IsRespondent    bool
+	ResSharedTo                string
+	ResTimeLimit               null.Time
+	IsResponseHiddenUntilDue   bool
+	IsResponseAggregateOnly    bool
+	IsQuiz                     bool
+	IsQuizAnswerHiddenUntilDue bool
+	IsAdministrator            bool
+	AdministratorRole          AdministratorRole
+	IsRespondent               bool
+	IsTarget                   bool
+	IsResponseViewer           bool
 }
 
+// responseReadPrivilegeInfoSelect 回答の閲覧権限情報を取得するときのSELECT句
+// administrators、targets、response_viewersをユーザーで絞り込んでJOINしておく
+const responseReadPrivilegeInfoSelect = "questionnaires.res_shared_to, questionnaires.res_time_limit, " +
+	"questionnaires.is_response_hidden_until_due, questionnaires.is_response_aggregate_only, " +
+	"questionnaires.is_quiz, questionnaires.is_quiz_answer_hidden_until_due, " +
+	"administrators.questionnaire_id IS NOT NULL AS is_administrator, COALESCE(administrators.role, '') AS administrator_role, " +
+	"targets.questionnaire_id IS NOT NULL AS is_target, response_viewers.questionnaire_id IS NOT NULL AS is_response_viewer"
+
 // InsertQuestionnaire アンケートの追加
 func (*Questionnaire) InsertQuestionnaire(ctx context.Context, title string, description string, resTimeLimit null.Time, resSharedTo string, isPublished bool, isAnonymous bool, isDuplicateAnswerAllowed bool) (int, error) {
 	db, err := getTx(ctx)
@@ -161,6 +185,106 @@ func (*Questionnaire) UpdateQuestionnaire(ctx context.Context, title string, des
 	return nil
 }
 
+// UpdateQuestionnaireResponseVisibility アンケートの結果の公開範囲の細かい設定を更新
+func (*Questionnaire) UpdateQuestionnaireResponseVisibility(ctx context.Context, questionnaireID int, isResponseHiddenUntilDue bool, isResponseAggregateOnly bool) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	result := db.
+		Model(&Questionnaires{}).
+		Where("id = ?", questionnaireID).
+		Updates(map[string]interface{}{
+			"is_response_hidden_until_due": isResponseHiddenUntilDue,
+			"is_response_aggregate_only":   isResponseAggregateOnly,
+			"modified_at":                  time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update questionnaire response visibility: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to update questionnaire response visibility: %w", ErrNoRecordUpdated)
+	}
+
+	return nil
+}
+
+// UpdateQuestionnaireQuizSettings アンケートのクイズの設定を更新
+func (*Questionnaire) UpdateQuestionnaireQuizSettings(ctx context.Context, questionnaireID int, isQuiz bool, isQuizAnswerHiddenUntilDue bool) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	result := db.
+		Model(&Questionnaires{}).
+		Where("id = ?", questionnaireID).
+		Updates(map[string]interface{}{
+			"is_quiz":                         isQuiz,
+			"is_quiz_answer_hidden_until_due": isQuizAnswerHiddenUntilDue,
+			"modified_at":                     time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update questionnaire quiz settings: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to update questionnaire quiz settings: %w", ErrNoRecordUpdated)
+	}
+
+	return nil
+}
+
+// UpdateQuestionnaireLanguage アンケートのtraQに投稿するメッセージの言語の更新
+func (*Questionnaire) UpdateQuestionnaireLanguage(ctx context.Context, questionnaireID int, language string) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	result := db.
+		Model(&Questionnaires{}).
+		Where("id = ?", questionnaireID).
+		Updates(map[string]interface{}{
+			"language":    language,
+			"modified_at": time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update questionnaire language: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to update questionnaire language: %w", ErrNoRecordUpdated)
+	}
+
+	return nil
+}
+
+// UpdateQuestionnaireMessageTemplates アンケートの作成とリマインダーのメッセージのテンプレートの更新
+// NULLのテンプレートは既定の形式のメッセージを使う
+func (*Questionnaire) UpdateQuestionnaireMessageTemplates(ctx context.Context, questionnaireID int, announcementTemplate null.String, reminderTemplate null.String) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	result := db.
+		Model(&Questionnaires{}).
+		Where("id = ?", questionnaireID).
+		Updates(map[string]interface{}{
+			"announcement_template": announcementTemplate,
+			"reminder_template":     reminderTemplate,
+			"modified_at":           time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update questionnaire message templates: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to update questionnaire message templates: %w", ErrNoRecordUpdated)
+	}
+
+	return nil
+}
+
 // UpdateQuestionnaireLimit アンケートの回答期限の更新
 func (*Questionnaire) UpdateQuestionnaireLimit(ctx context.Context, questionnaireID int, resTimeLimit null.Time) error {
 	db, err := getTx(ctx)
@@ -211,12 +335,35 @@ func (*Questionnaire) DeleteQuestionnaire(ctx context.Context, questionnaireID i
 	return nil
 }
 
-func buildQuestionnairesQuery(db *gorm.DB, userID string, sort string, search string, onlyTargetingMe bool, onlyAdministratedByMe bool, notOverDue bool, hasMyResponse *bool, hasMyDraft *bool, isDraft *bool) (*gorm.DB, error) {
+func buildQuestionnairesQuery(db *gorm.DB, userID string, sort string, search string, keyword string, tagIDs []int, onlyTargetingMe bool, onlyAdministratedByMe bool, notOverDue bool, hasMyResponse *bool, hasMyDraft *bool, isDraft *bool) (*gorm.DB, error) {
 	query := db.
 		Table("questionnaires").
 		Where("deleted_at IS NULL").
 		Joins("LEFT OUTER JOIN targets ON questionnaires.id = targets.questionnaire_id")
 
+	// キーワードを構成するすべてのトークンを含むアンケートに絞り込む
+	tokens := keywordTokens(keyword)
+	if len(tokens) != 0 {
+		query = query.Joins("INNER JOIN (SELECT questionnaire_id, SUM(weight) AS relevance FROM questionnaire_search_tokens WHERE token IN ? GROUP BY questionnaire_id HAVING COUNT(*) = ?) AS search ON questionnaires.id = search.questionnaire_id", tokens, len(tokens))
+	}
+
+	// 指定されたすべてのタグが付いたアンケートに絞り込む
+	if len(tagIDs) != 0 {
+		tagIDSet := make(map[int]struct{}, len(tagIDs))
+		for _, tagID := range tagIDs {
+			tagIDSet[tagID] = struct{}{}
+		}
+		query = query.Where("questionnaires.id IN (SELECT questionnaire_id FROM questionnaire_tags WHERE tag_id IN (?) GROUP BY questionnaire_id HAVING COUNT(*) = ?)", tagIDs, len(tagIDSet))
+	}
+
+	// relevanceはキーワード検索時の関連度順、キーワードがなければ指定なしと同じ
+	if sort == "relevance" {
+		if len(tokens) != 0 {
+			query = query.Order("MAX(search.relevance) desc")
+		}
+		sort = ""
+	}
+
 	var err error
 	query, err = setQuestionnairesOrder(query, sort)
 	if err != nil {
@@ -237,19 +384,26 @@ func buildQuestionnairesQuery(db *gorm.DB, userID string, sort string, search st
 		query = query.Where("questionnaires.res_time_limit > ? OR questionnaires.res_time_limit IS NULL", time.Now())
 	}
 
-	if hasMyResponse != nil {
-		if *hasMyResponse {
-			query = query.Where("EXISTS (SELECT 1 FROM respondents WHERE questionnaires.id = respondents.questionnaire_id AND respondents.user_traqid = ? AND respondents.submitted_at IS NOT NULL AND respondents.deleted_at IS NULL)", userID)
-		} else {
-			query = query.Where("NOT EXISTS (SELECT 1 FROM respondents WHERE questionnaires.id = respondents.questionnaire_id AND respondents.user_traqid = ? AND respondents.submitted_at IS NOT NULL AND respondents.deleted_at IS NULL)", userID)
+	if hasMyResponse != nil || hasMyDraft != nil {
+		myRespondent, myRespondentArgs, err := myRespondentCondition(db, "respondents", userID, nil)
+		if err != nil {
+			return nil, err
 		}
-	}
 
-	if hasMyDraft != nil {
-		if *hasMyDraft {
-			query = query.Where("EXISTS (SELECT 1 FROM respondents WHERE questionnaires.id = respondents.questionnaire_id AND respondents.user_traqid = ? AND respondents.submitted_at IS NULL AND respondents.deleted_at IS NULL)", userID)
-		} else {
-			query = query.Where("NOT EXISTS (SELECT 1 FROM respondents WHERE questionnaires.id = respondents.questionnaire_id AND respondents.user_traqid = ? AND respondents.submitted_at IS NULL AND respondents.deleted_at IS NULL)", userID)
+		if hasMyResponse != nil {
+			if *hasMyResponse {
+				query = query.Where("EXISTS (SELECT 1 FROM respondents WHERE questionnaires.id = respondents.questionnaire_id AND "+myRespondent+" AND respondents.submitted_at IS NOT NULL AND respondents.deleted_at IS NULL)", myRespondentArgs...)
+			} else {
+				query = query.Where("NOT EXISTS (SELECT 1 FROM respondents WHERE questionnaires.id = respondents.questionnaire_id AND "+myRespondent+" AND respondents.submitted_at IS NOT NULL AND respondents.deleted_at IS NULL)", myRespondentArgs...)
+			}
+		}
+
+		if hasMyDraft != nil {
+			if *hasMyDraft {
+				query = query.Where("EXISTS (SELECT 1 FROM respondents WHERE questionnaires.id = respondents.questionnaire_id AND "+myRespondent+" AND respondents.submitted_at IS NULL AND respondents.deleted_at IS NULL)", myRespondentArgs...)
+			} else {
+				query = query.Where("NOT EXISTS (SELECT 1 FROM respondents WHERE questionnaires.id = respondents.questionnaire_id AND "+myRespondent+" AND respondents.submitted_at IS NULL AND respondents.deleted_at IS NULL)", myRespondentArgs...)
+			}
 		}
 	}
 
@@ -266,13 +420,12 @@ func buildQuestionnairesQuery(db *gorm.DB, userID string, sort string, search st
 	}
 
 	if len(search) != 0 {
-		// MySQLでのregexpの構文は少なくともGoのregexpの構文でvalidである必要がある
+		// DBでのregexpの構文は少なくともGoのregexpの構文でvalidである必要がある
 		if _, err := regexp.Compile(search); err != nil {
 			return nil, fmt.Errorf("invalid search param: %w", ErrInvalidRegex)
 		}
 
-		// BINARYをつけていないので大文字小文字区別しない
-		query = query.Where("questionnaires.title REGEXP ?", search)
+		query = query.Where(regexpCondition(db, "questionnaires.title"), search)
 	}
 
 	return query, nil
@@ -280,21 +433,26 @@ func buildQuestionnairesQuery(db *gorm.DB, userID string, sort string, search st
 
 /*
 GetQuestionnaires アンケートの一覧
-2つ目の戻り値は対象件数、3つ目の戻り値はページ数の最大値
+2つ目の戻り値は対象件数、3つ目の戻り値はページ数の最大値、4つ目の戻り値は次のページのカーソル(次のページがない場合はnil)
+cursorが指定された場合はpageNumを無視してカーソルの続きから取得する
 */
-func (*Questionnaire) GetQuestionnaires(ctx context.Context, userID string, sort string, search string, pageNum int, onlyTargetingMe bool, onlyAdministratedByMe bool, notOverDue bool, hasMyResponse *bool, hasMyDraft *bool, isDraft *bool, countOnly bool) ([]QuestionnaireInfo, int, int, error) {
+func (*Questionnaire) GetQuestionnaires(ctx context.Context, userID string, sort string, search string, keyword string, tagIDs []int, pageNum int, limit int, cursor *Cursor, onlyTargetingMe bool, onlyAdministratedByMe bool, notOverDue bool, hasMyResponse *bool, hasMyDraft *bool, isDraft *bool, countOnly bool) ([]QuestionnaireInfo, int, int, *Cursor, error) {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
 
 	db, err := getTx(ctx)
 	if err != nil {
-		return nil, 0, 0, fmt.Errorf("failed to get tx: %w", err)
+		return nil, 0, 0, nil, fmt.Errorf("failed to get tx: %w", err)
 	}
 
-	questionnaires := make([]QuestionnaireInfo, 0, 20)
-	query, err := buildQuestionnairesQuery(db, userID, sort, search, onlyTargetingMe, onlyAdministratedByMe, notOverDue, hasMyResponse, hasMyDraft, isDraft)
+	if limit < 1 || limit > MaxPageLimit {
+		limit = DefaultPageLimit
+	}
+
+	questionnaires := make([]QuestionnaireInfo, 0, limit+1)
+	query, err := buildQuestionnairesQuery(db, userID, sort, search, keyword, tagIDs, onlyTargetingMe, onlyAdministratedByMe, notOverDue, hasMyResponse, hasMyDraft, isDraft)
 	if err != nil {
-		return nil, 0, 0, err
+		return nil, 0, 0, nil, err
 	}
 
 	var count int64
@@ -303,41 +461,94 @@ func (*Questionnaire) GetQuestionnaires(ctx context.Context, userID string, sort
 		Group("questionnaires.id").
 		Count(&count).Error
 	if errors.Is(err, context.DeadlineExceeded) {
-		return nil, 0, 0, ErrDeadlineExceeded
+		return nil, 0, 0, nil, ErrDeadlineExceeded
 	}
 	if err != nil {
-		return nil, 0, 0, fmt.Errorf("failed to retrieve the number of questionnaires: %w", err)
+		return nil, 0, 0, nil, fmt.Errorf("failed to retrieve the number of questionnaires: %w", err)
 	}
 
 	if count == 0 {
-		return []QuestionnaireInfo{}, 0, 0, nil
+		return []QuestionnaireInfo{}, 0, 0, nil, nil
 	}
-	pageMax := (int(count) + 19) / 20
+	pageMax := (int(count) + limit - 1) / limit
 
 	if countOnly {
-		return []QuestionnaireInfo{}, int(count), pageMax, nil
+		return []QuestionnaireInfo{}, int(count), pageMax, nil, nil
 	}
 
-	if pageNum > pageMax {
-		return nil, 0, 0, fmt.Errorf("failed to set page offset: %w", ErrTooLargePageNum)
+	hasKeyword := len(keywordTokens(keyword)) != 0
+	if cursor != nil {
+		query, err = setQuestionnairesCursor(query, sort, hasKeyword, cursor)
+		if err != nil {
+			return nil, 0, 0, nil, err
+		}
+	} else {
+		if pageNum > pageMax {
+			return nil, 0, 0, nil, fmt.Errorf("failed to set page offset: %w", ErrTooLargePageNum)
+		}
+		query = query.Offset((pageNum - 1) * limit)
 	}
 
-	offset := (pageNum - 1) * 20
+	selectQuery := "questionnaires.*, EXISTS(SELECT 1 FROM targets WHERE targets.questionnaire_id = questionnaires.id AND (targets.user_traqid = ? OR targets.user_traqid = 'traP')) AS is_targeted, EXISTS(SELECT 1 FROM administrators WHERE administrators.questionnaire_id = questionnaires.id AND (administrators.user_traqid = ? OR administrators.user_traqid = 'traP')) AS is_administrated_by_me"
+	if hasKeyword {
+		selectQuery += ", MAX(search.relevance) AS relevance"
+	}
 
+	// 次のページの有無を判定するために1件多く取得する
 	err = query.
-		Limit(20).
-		Offset(offset).
-		Select("questionnaires.*, EXISTS(SELECT 1 FROM targets WHERE targets.questionnaire_id = questionnaires.id AND (targets.user_traqid = ? OR targets.user_traqid = 'traP')) AS is_targeted, EXISTS(SELECT 1 FROM administrators WHERE administrators.questionnaire_id = questionnaires.id AND (administrators.user_traqid = ? OR administrators.user_traqid = 'traP')) AS is_administrated_by_me", userID, userID).
+		Limit(limit+1).
+		Select(selectQuery, userID, userID).
 		Group("questionnaires.id").
 		Find(&questionnaires).Error
 	if errors.Is(err, context.DeadlineExceeded) {
-		return nil, 0, 0, ErrDeadlineExceeded
+		return nil, 0, 0, nil, ErrDeadlineExceeded
 	}
 	if err != nil {
-		return nil, 0, 0, fmt.Errorf("failed to get the targeted questionnaires: %w", err)
+		return nil, 0, 0, nil, fmt.Errorf("failed to get the targeted questionnaires: %w", err)
+	}
+
+	var nextCursor *Cursor
+	if len(questionnaires) > limit {
+		questionnaires = questionnaires[:limit]
+		nextCursor = newQuestionnairesCursor(sort, questionnaires[limit-1])
 	}
 
-	return questionnaires, int(count), pageMax, nil
+	return questionnaires, int(count), pageMax, nextCursor, nil
+}
+
+// GetQuestionnaireTagCounts GetQuestionnairesと同じ条件に一致するアンケートのタグごとの件数
+func (*Questionnaire) GetQuestionnaireTagCounts(ctx context.Context, userID string, search string, keyword string, tagIDs []int, onlyTargetingMe bool, onlyAdministratedByMe bool, notOverDue bool, hasMyResponse *bool, hasMyDraft *bool, isDraft *bool) ([]TagCount, error) {
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	query, err := buildQuestionnairesQuery(db, userID, "", search, keyword, tagIDs, onlyTargetingMe, onlyAdministratedByMe, notOverDue, hasMyResponse, hasMyDraft, isDraft)
+	if err != nil {
+		return nil, err
+	}
+
+	tagCounts := []TagCount{}
+	err = db.
+		Session(&gorm.Session{NewDB: true}).
+		Table("questionnaire_tags").
+		Joins("INNER JOIN tags ON questionnaire_tags.tag_id = tags.id").
+		Where("questionnaire_tags.questionnaire_id IN (?)", query.Select("questionnaires.id")).
+		Select("tags.id, tags.name, tags.created_by, tags.created_at, COUNT(*) AS count").
+		Group("tags.id, tags.name, tags.created_by, tags.created_at").
+		Order("count desc, tags.name").
+		Find(&tagCounts).Error
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, ErrDeadlineExceeded
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag counts: %w", err)
+	}
+
+	return tagCounts, nil
 }
 
 // GetAdminQuestionnaires 自分が管理者のアンケートの取得
@@ -442,15 +653,34 @@ func (*Questionnaire) GetQuestionnaireInfo(ctx context.Context, questionnaireID
 		return nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("failed to get administrator groups: %w", err)
 	}
 
+	submittedRespondents := []Respondents{}
 	err = db.
 		Session(&gorm.Session{NewDB: true}).
-		Table("respondents").
-		Where("questionnaire_id = ? AND deleted_at IS NULL AND submitted_at IS NOT NULL", questionnaire.ID).
-		Pluck("user_traqid", &respondents).Error
+		Where("questionnaire_id = ? AND submitted_at IS NOT NULL", questionnaire.ID).
+		Select("user_traqid", "anonymous_key").
+		Find(&submittedRespondents).Error
 	if err != nil {
 		return nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("failed to get respondents: %w", err)
 	}
 
+	anonymousKeys := []string{}
+	for _, respondent := range submittedRespondents {
+		if respondent.AnonymousKey.Valid {
+			anonymousKeys = append(anonymousKeys, respondent.AnonymousKey.String)
+		}
+	}
+	resolved, err := resolveAnonymousRespondents(db, questionnaire.ID, anonymousKeys)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("failed to resolve anonymous respondents: %w", err)
+	}
+	for _, respondent := range submittedRespondents {
+		if respondent.AnonymousKey.Valid {
+			respondents = append(respondents, resolved[respondent.AnonymousKey.String])
+		} else {
+			respondents = append(respondents, respondent.UserTraqid)
+		}
+	}
+
 	return &questionnaire, targets, targetUsers, targetGroups, administrators, administratorUsers, administoratorGroups, respondents, nil
 }
 
@@ -461,12 +691,17 @@ func (*Questionnaire) GetTargettedQuestionnaires(ctx context.Context, userID str
 		return nil, fmt.Errorf("failed to get tx: %w", err)
 	}
 
+	myRespondent, myRespondentArgs, err := myRespondentCondition(db, "respondents", userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	query := db.
 		Table("questionnaires").
 		Where("questionnaires.res_time_limit > ? OR questionnaires.res_time_limit IS NULL", time.Now()).
 		Joins("INNER JOIN targets ON questionnaires.id = targets.questionnaire_id").
 		Where("targets.user_traqid = ? OR targets.user_traqid = 'traP'", userID).
-		Joins("LEFT OUTER JOIN respondents ON questionnaires.id = respondents.questionnaire_id AND respondents.user_traqid = ? AND respondents.deleted_at IS NULL", userID).
+		Joins("LEFT OUTER JOIN respondents ON questionnaires.id = respondents.questionnaire_id AND "+myRespondent+" AND respondents.deleted_at IS NULL", myRespondentArgs...).
 		Group("questionnaires.id,respondents.user_traqid").
 		Select("questionnaires.*, MAX(respondents.submitted_at) AS responded_at, COUNT(respondents.response_id) != 0 AS has_response")
 
@@ -476,7 +711,7 @@ func (*Questionnaire) GetTargettedQuestionnaires(ctx context.Context, userID str
 	}
 
 	query = query.
-		Order("questionnaires.res_time_limit").
+		Order(nullsFirstOrder("questionnaires.res_time_limit", false)).
 		Order("questionnaires.modified_at desc")
 
 	switch answered {
@@ -570,14 +805,21 @@ func (*Questionnaire) GetResponseReadPrivilegeInfoByResponseID(ctx context.Conte
 		return nil, fmt.Errorf("failed to get tx: %w", err)
 	}
 
+	myRespondent, myRespondentArgs, err := myRespondentCondition(db, "respondents2", userID, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	var responseReadPrivilegeInfo ResponseReadPrivilegeInfo
 	err = db.
 		Table("respondents").
 		Where("respondents.response_id = ? AND respondents.submitted_at IS NOT NULL", responseID).
 		Joins("INNER JOIN questionnaires ON questionnaires.id = respondents.questionnaire_id").
 		Joins("LEFT OUTER JOIN administrators ON questionnaires.id = administrators.questionnaire_id AND administrators.user_traqid = ?", userID).
-		Joins("LEFT OUTER JOIN respondents AS respondents2 ON questionnaires.id = respondents2.questionnaire_id AND respondents2.user_traqid = ? AND respondents2.submitted_at IS NOT NULL", userID).
-		Select("questionnaires.res_shared_to, administrators.questionnaire_id IS NOT NULL AS is_administrator, respondents2.response_id IS NOT NULL AS is_respondent").
+		Joins("LEFT OUTER JOIN respondents AS respondents2 ON questionnaires.id = respondents2.questionnaire_id AND "+myRespondent+" AND respondents2.submitted_at IS NOT NULL", myRespondentArgs...).
+		Joins("LEFT OUTER JOIN targets ON questionnaires.id = targets.questionnaire_id AND targets.user_traqid = ?", userID).
+		Joins("LEFT OUTER JOIN response_viewers ON questionnaires.id = response_viewers.questionnaire_id AND response_viewers.user_traqid = ?", userID).
+		Select(responseReadPrivilegeInfoSelect + ", respondents2.response_id IS NOT NULL AS is_respondent").
 		Take(&responseReadPrivilegeInfo).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, ErrRecordNotFound
@@ -601,8 +843,10 @@ func (*Questionnaire) GetResponseReadPrivilegeInfoByQuestionnaireID(ctx context.
 		Table("questionnaires").
 		Where("questionnaires.id = ?", questionnaireID).
 		Joins("LEFT OUTER JOIN administrators ON questionnaires.id = administrators.questionnaire_id AND administrators.user_traqid = ?", userID).
-		Joins("LEFT OUTER JOIN respondents ON questionnaires.id = respondents.questionnaire_id AND respondents.user_traqid = ? AND respondents.submitted_at IS NOT NULL", userID).
-		Select("questionnaires.res_shared_to, administrators.questionnaire_id IS NOT NULL AS is_administrator, respondents.response_id IS NOT NULL AS is_respondent").
+		Joins("LEFT OUTER JOIN respondents ON questionnaires.id = respondents.questionnaire_id AND (respondents.user_traqid = ? OR respondents.anonymous_key = ?) AND respondents.submitted_at IS NOT NULL", userID, AnonymousRespondentKey(questionnaireID, userID)).
+		Joins("LEFT OUTER JOIN targets ON questionnaires.id = targets.questionnaire_id AND targets.user_traqid = ?", userID).
+		Joins("LEFT OUTER JOIN response_viewers ON questionnaires.id = response_viewers.questionnaire_id AND response_viewers.user_traqid = ?", userID).
+		Select(responseReadPrivilegeInfoSelect + ", respondents.response_id IS NOT NULL AS is_respondent").
 		Take(&responseReadPrivilegeInfo).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, ErrRecordNotFound
@@ -636,6 +880,29 @@ func (*Questionnaire) GetResponseIsAnonymousByQuestionnaireID(ctx context.Contex
 	return isAnonymous, nil
 }
 
+// GetQuestionnaireIsQuiz アンケートがクイズかどうかを取得
+func (*Questionnaire) GetQuestionnaireIsQuiz(ctx context.Context, questionnaireID int) (bool, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	var isQuiz bool
+	err = db.
+		Table("questionnaires").
+		Where("questionnaires.id = ?", questionnaireID).
+		Select("questionnaires.is_quiz").
+		Take(&isQuiz).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, ErrRecordNotFound
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get is_quiz: %w", err)
+	}
+
+	return isQuiz, nil
+}
+
 func setQuestionnairesOrder(query *gorm.DB, sort string) (*gorm.DB, error) {
 	switch sort {
 	case "created_at":
@@ -658,3 +925,56 @@ func setQuestionnairesOrder(query *gorm.DB, sort string) (*gorm.DB, error) {
 
 	return query, nil
 }
+
+func setQuestionnairesCursor(query *gorm.DB, sort string, hasKeyword bool, cursor *Cursor) (*gorm.DB, error) {
+	if cursor.Sort != sort {
+		return nil, fmt.Errorf("cursor for another sort: %w", ErrInvalidCursor)
+	}
+
+	switch sort {
+	case "created_at":
+		query = whereAfterCursor(query, "questionnaires.created_at", false, cursor.Time, "questionnaires.id", true, cursor.ID)
+	case "-created_at":
+		query = whereAfterCursor(query, "questionnaires.created_at", true, cursor.Time, "questionnaires.id", true, cursor.ID)
+	case "title":
+		query = whereAfterCursor(query, "questionnaires.title", false, cursor.Text, "questionnaires.id", true, cursor.ID)
+	case "-title":
+		query = whereAfterCursor(query, "questionnaires.title", true, cursor.Text, "questionnaires.id", true, cursor.ID)
+	case "modified_at":
+		query = whereAfterCursor(query, "questionnaires.modified_at", false, cursor.Time, "questionnaires.id", true, cursor.ID)
+	case "-modified_at":
+		query = whereAfterCursor(query, "questionnaires.modified_at", true, cursor.Time, "questionnaires.id", true, cursor.ID)
+	case "relevance":
+		if hasKeyword {
+			query = whereAfterCursor(query, "search.relevance", true, cursor.Int, "questionnaires.id", true, cursor.ID)
+		} else {
+			query = whereAfterCursor(query, "", false, nil, "questionnaires.id", true, cursor.ID)
+		}
+	case "":
+		query = whereAfterCursor(query, "", false, nil, "questionnaires.id", true, cursor.ID)
+	default:
+		return nil, ErrInvalidSortParam
+	}
+
+	return query, nil
+}
+
+func newQuestionnairesCursor(sort string, questionnaire QuestionnaireInfo) *Cursor {
+	cursor := &Cursor{
+		Sort: sort,
+		ID:   questionnaire.ID,
+	}
+
+	switch sort {
+	case "created_at", "-created_at":
+		cursor.Time = questionnaire.CreatedAt
+	case "title", "-title":
+		cursor.Text = questionnaire.Title
+	case "modified_at", "-modified_at":
+		cursor.Time = questionnaire.ModifiedAt
+	case "relevance":
+		cursor.Int = questionnaire.Relevance
+	}
+
+	return cursor
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 )
@@ -1502,7 +1503,7 @@ func getQuestionnairesTest(t *testing.T) {
 	for _, testCase := range testCases {
 		ctx := context.Background()
 
-		questionnaires, totalRecords, pageMax, err := questionnaireImpl.GetQuestionnaires(ctx, testCase.args.userID, testCase.args.sort, testCase.args.search, testCase.args.pageNum, testCase.args.onlyTargetingMe, testCase.args.onlyAdministratedByMe, false, nil, nil, nil, testCase.args.countOnly) // isDraft=nil: published only
+		questionnaires, totalRecords, pageMax, _, err := questionnaireImpl.GetQuestionnaires(ctx, testCase.args.userID, testCase.args.sort, testCase.args.search, "", nil, testCase.args.pageNum, 20, nil, testCase.args.onlyTargetingMe, testCase.args.onlyAdministratedByMe, false, nil, nil, nil, testCase.args.countOnly) // isDraft=nil: published only
 
 		if !testCase.expect.isErr {
 			assertion.NoError(err, testCase.description, "no error")
@@ -2319,6 +2320,7 @@ func getResponseReadPrivilegeInfoByResponseIDTest(t *testing.T) {
 			expect: expect{
 				responseReadPrivilegeInfo: &ResponseReadPrivilegeInfo{
 					ResSharedTo:     datas[3].questionnaire.ResSharedTo,
+					ResTimeLimit:    datas[3].questionnaire.ResTimeLimit,
 					IsAdministrator: false,
 					IsRespondent:    false,
 				},
@@ -2333,6 +2335,7 @@ func getResponseReadPrivilegeInfoByResponseIDTest(t *testing.T) {
 			expect: expect{
 				responseReadPrivilegeInfo: &ResponseReadPrivilegeInfo{
 					ResSharedTo:     datas[3].questionnaire.ResSharedTo,
+					ResTimeLimit:    datas[3].questionnaire.ResTimeLimit,
 					IsAdministrator: false,
 					IsRespondent:    true,
 				},
@@ -2346,9 +2349,11 @@ func getResponseReadPrivilegeInfoByResponseIDTest(t *testing.T) {
 			},
 			expect: expect{
 				responseReadPrivilegeInfo: &ResponseReadPrivilegeInfo{
-					ResSharedTo:     datas[28].questionnaire.ResSharedTo,
-					IsAdministrator: true,
-					IsRespondent:    false,
+					ResSharedTo:       datas[28].questionnaire.ResSharedTo,
+					ResTimeLimit:      datas[28].questionnaire.ResTimeLimit,
+					IsAdministrator:   true,
+					AdministratorRole: AdministratorRoleOwner,
+					IsRespondent:      false,
 				},
 			},
 		},
@@ -2360,9 +2365,11 @@ func getResponseReadPrivilegeInfoByResponseIDTest(t *testing.T) {
 			},
 			expect: expect{
 				responseReadPrivilegeInfo: &ResponseReadPrivilegeInfo{
-					ResSharedTo:     datas[28].questionnaire.ResSharedTo,
-					IsAdministrator: true,
-					IsRespondent:    true,
+					ResSharedTo:       datas[28].questionnaire.ResSharedTo,
+					ResTimeLimit:      datas[28].questionnaire.ResTimeLimit,
+					IsAdministrator:   true,
+					AdministratorRole: AdministratorRoleOwner,
+					IsRespondent:      true,
 				},
 			},
 		},
@@ -2375,6 +2382,7 @@ func getResponseReadPrivilegeInfoByResponseIDTest(t *testing.T) {
 			expect: expect{
 				responseReadPrivilegeInfo: &ResponseReadPrivilegeInfo{
 					ResSharedTo:     datas[29].questionnaire.ResSharedTo,
+					ResTimeLimit:    datas[29].questionnaire.ResTimeLimit,
 					IsAdministrator: false,
 					IsRespondent:    false,
 				},
@@ -2400,6 +2408,11 @@ func getResponseReadPrivilegeInfoByResponseIDTest(t *testing.T) {
 			continue
 		}
 
+		expectResTimeLimit := testCase.expect.responseReadPrivilegeInfo.ResTimeLimit
+		if assertion.Equal(expectResTimeLimit.Valid, responseReadPrivilegeInfo.ResTimeLimit.Valid, testCase.description, "res_time_limit") {
+			assertion.WithinDuration(expectResTimeLimit.ValueOrZero(), responseReadPrivilegeInfo.ResTimeLimit.ValueOrZero(), 2*time.Second, testCase.description, "res_time_limit")
+		}
+		responseReadPrivilegeInfo.ResTimeLimit = expectResTimeLimit
 		assertion.Equal(testCase.expect.responseReadPrivilegeInfo, responseReadPrivilegeInfo, testCase.description, "responseReadPrivilegeInfo")
 	}
 }
@@ -2462,6 +2475,7 @@ func getResponseReadPrivilegeInfoByQuestionnaireIDTest(t *testing.T) {
 			expect: expect{
 				responseReadPrivilegeInfo: &ResponseReadPrivilegeInfo{
 					ResSharedTo:     datas[0].questionnaire.ResSharedTo,
+					ResTimeLimit:    datas[0].questionnaire.ResTimeLimit,
 					IsAdministrator: false,
 					IsRespondent:    false,
 				},
@@ -2475,9 +2489,11 @@ func getResponseReadPrivilegeInfoByQuestionnaireIDTest(t *testing.T) {
 			},
 			expect: expect{
 				responseReadPrivilegeInfo: &ResponseReadPrivilegeInfo{
-					ResSharedTo:     datas[2].questionnaire.ResSharedTo,
-					IsAdministrator: true,
-					IsRespondent:    false,
+					ResSharedTo:       datas[2].questionnaire.ResSharedTo,
+					ResTimeLimit:      datas[2].questionnaire.ResTimeLimit,
+					IsAdministrator:   true,
+					AdministratorRole: AdministratorRoleOwner,
+					IsRespondent:      false,
 				},
 			},
 		},
@@ -2490,6 +2506,7 @@ func getResponseReadPrivilegeInfoByQuestionnaireIDTest(t *testing.T) {
 			expect: expect{
 				responseReadPrivilegeInfo: &ResponseReadPrivilegeInfo{
 					ResSharedTo:     datas[3].questionnaire.ResSharedTo,
+					ResTimeLimit:    datas[3].questionnaire.ResTimeLimit,
 					IsAdministrator: false,
 					IsRespondent:    true,
 				},
@@ -2504,6 +2521,7 @@ func getResponseReadPrivilegeInfoByQuestionnaireIDTest(t *testing.T) {
 			expect: expect{
 				responseReadPrivilegeInfo: &ResponseReadPrivilegeInfo{
 					ResSharedTo:     datas[4].questionnaire.ResSharedTo,
+					ResTimeLimit:    datas[4].questionnaire.ResTimeLimit,
 					IsAdministrator: false,
 					IsRespondent:    false,
 				},
@@ -2517,9 +2535,11 @@ func getResponseReadPrivilegeInfoByQuestionnaireIDTest(t *testing.T) {
 			},
 			expect: expect{
 				responseReadPrivilegeInfo: &ResponseReadPrivilegeInfo{
-					ResSharedTo:     datas[28].questionnaire.ResSharedTo,
-					IsAdministrator: true,
-					IsRespondent:    true,
+					ResSharedTo:       datas[28].questionnaire.ResSharedTo,
+					ResTimeLimit:      datas[28].questionnaire.ResTimeLimit,
+					IsAdministrator:   true,
+					AdministratorRole: AdministratorRoleOwner,
+					IsRespondent:      true,
 				},
 			},
 		},
@@ -2543,6 +2563,96 @@ func getResponseReadPrivilegeInfoByQuestionnaireIDTest(t *testing.T) {
 			continue
 		}
 
+		expectResTimeLimit := testCase.expect.responseReadPrivilegeInfo.ResTimeLimit
+		if assertion.Equal(expectResTimeLimit.Valid, responseReadPrivilegeInfo.ResTimeLimit.Valid, testCase.description, "res_time_limit") {
+			assertion.WithinDuration(expectResTimeLimit.ValueOrZero(), responseReadPrivilegeInfo.ResTimeLimit.ValueOrZero(), 2*time.Second, testCase.description, "res_time_limit")
+		}
+		responseReadPrivilegeInfo.ResTimeLimit = expectResTimeLimit
 		assertion.Equal(testCase.expect.responseReadPrivilegeInfo, responseReadPrivilegeInfo, testCase.description, "responseReadPrivilegeInfo")
 	}
 }
+
+func TestUpdateQuestionnaireQuizSettings(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
+	require.NoError(t, err)
+
+	isQuiz, err := questionnaireImpl.GetQuestionnaireIsQuiz(ctx, questionnaireID)
+	require.NoError(t, err)
+	assertion.False(isQuiz, "not quiz by default")
+
+	err = questionnaireImpl.UpdateQuestionnaireQuizSettings(ctx, questionnaireID, true, true)
+	require.NoError(t, err)
+
+	isQuiz, err = questionnaireImpl.GetQuestionnaireIsQuiz(ctx, questionnaireID)
+	require.NoError(t, err)
+	assertion.True(isQuiz, "quiz")
+
+	questionnaire := Questionnaires{}
+	err = db.
+		Session(&gorm.Session{NewDB: true}).
+		Where("id = ?", questionnaireID).
+		First(&questionnaire).Error
+	require.NoError(t, err)
+	assertion.True(questionnaire.IsQuizAnswerHiddenUntilDue, "quiz answer hidden until due")
+
+	err = questionnaireImpl.UpdateQuestionnaireQuizSettings(ctx, -1, true, false)
+	assertion.ErrorIs(err, ErrNoRecordUpdated, "questionnaire not found")
+
+	_, err = questionnaireImpl.GetQuestionnaireIsQuiz(ctx, -1)
+	assertion.ErrorIs(err, ErrRecordNotFound, "questionnaire not found")
+}
+
+func TestUpdateQuestionnaireLanguage(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
+	require.NoError(t, err)
+
+	questionnaire, _, _, _, _, _, _, _, err := questionnaireImpl.GetQuestionnaireInfo(ctx, questionnaireID)
+	require.NoError(t, err)
+	assertion.Equal("ja", questionnaire.Language, "default language")
+
+	err = questionnaireImpl.UpdateQuestionnaireLanguage(ctx, questionnaireID, "en")
+	require.NoError(t, err)
+
+	questionnaire, _, _, _, _, _, _, _, err = questionnaireImpl.GetQuestionnaireInfo(ctx, questionnaireID)
+	require.NoError(t, err)
+	assertion.Equal("en", questionnaire.Language, "updated language")
+
+	err = questionnaireImpl.UpdateQuestionnaireLanguage(ctx, -1, "en")
+	assertion.ErrorIs(err, ErrNoRecordUpdated, "questionnaire not found")
+}
+
+func TestUpdateQuestionnaireMessageTemplates(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
+	require.NoError(t, err)
+
+	questionnaire, _, _, _, _, _, _, _, err := questionnaireImpl.GetQuestionnaireInfo(ctx, questionnaireID)
+	require.NoError(t, err)
+	assertion.False(questionnaire.AnnouncementTemplate.Valid, "no announcement template by default")
+	assertion.False(questionnaire.ReminderTemplate.Valid, "no reminder template by default")
+
+	err = questionnaireImpl.UpdateQuestionnaireMessageTemplates(ctx, questionnaireID, null.StringFrom("{{.Title}}"), null.String{})
+	require.NoError(t, err)
+
+	questionnaire, _, _, _, _, _, _, _, err = questionnaireImpl.GetQuestionnaireInfo(ctx, questionnaireID)
+	require.NoError(t, err)
+	assertion.Equal(null.StringFrom("{{.Title}}"), questionnaire.AnnouncementTemplate, "announcement template")
+	assertion.False(questionnaire.ReminderTemplate.Valid, "reminder template")
+
+	err = questionnaireImpl.UpdateQuestionnaireMessageTemplates(ctx, -1, null.String{}, null.String{})
+	assertion.ErrorIs(err, ErrNoRecordUpdated, "questionnaire not found")
+}
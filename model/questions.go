@@ -8,6 +8,8 @@ import "context"
 type IQuestion interface {
 	InsertQuestion(ctx context.Context, questionnaireID int, pageNum int, questionNum int, questionType string, title string, description string, isRequired bool) (int, error)
 	UpdateQuestion(ctx context.Context, questionnaireID int, pageNum int, questionNum int, questionType string, title string, description string, isRequired bool, questionID int) error
+	UpdateQuestionIsResponseHidden(ctx context.Context, questionID int, isResponseHidden bool) error
+	UpdateQuestionPoints(ctx context.Context, questionID int, points int) error
 	DeleteQuestion(ctx context.Context, questionID int) error
 	GetQuestions(ctx context.Context, questionnaireID int) ([]Questions, error)
 	CheckQuestionAdmin(ctx context.Context, userID string, questionID int) (bool, error)
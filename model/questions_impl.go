@@ -19,20 +19,22 @@ func NewQuestion() *Question {
 
 // Questions questionテーブルの構造体
 type Questions struct {
-	ID              int            `json:"id"                  gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
-	QuestionnaireID int            `json:"questionnaireID"     gorm:"type:int(11);not null"`
-	PageNum         int            `json:"page_num"            gorm:"type:int(11);not null"`
-	QuestionNum     int            `json:"question_num"        gorm:"type:int(11);not null"`
-	Type            string         `json:"type"                gorm:"type:char(20);size:20;not null"`
-	Body            string         `json:"body"                gorm:"type:text;default:NULL"`
-	Description     string         `json:"description"         gorm:"type:text;default:NULL"`
-	IsRequired      bool           `json:"is_required"         gorm:"type:tinyint(4);size:4;not null;default:0"`
-	DeletedAt       gorm.DeletedAt `json:"-"          gorm:"type:TIMESTAMP NULL;default:NULL"`
-	CreatedAt       time.Time      `json:"created_at"          gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
-	Options         []Options      `json:"-"  gorm:"foreignKey:QuestionID"`
-	Responses       []Responses    `json:"-"  gorm:"foreignKey:QuestionID"`
-	ScaleLabels     []ScaleLabels  `json:"-"  gorm:"foreignKey:QuestionID"`
-	Validations     []Validations  `json:"-"  gorm:"foreignKey:QuestionID"`
+	ID               int            `json:"id"                  gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	QuestionnaireID  int            `json:"questionnaireID"     gorm:"type:int(11);not null"`
+	PageNum          int            `json:"page_num"            gorm:"type:int(11);not null"`
+	QuestionNum      int            `json:"question_num"        gorm:"type:int(11);not null"`
+	Type             string         `json:"type"                gorm:"type:char(20);size:20;not null"`
+	Body             string         `json:"body"                gorm:"type:text;default:NULL"`
+	Description      string         `json:"description"         gorm:"type:text;default:NULL"`
+	IsRequired       bool           `json:"is_required"         gorm:"type:tinyint(4);size:4;not null;default:0"`
+	IsResponseHidden bool           `json:"is_response_hidden"  gorm:"type:boolean;not null;default:false"`
+	Points           int            `json:"points"              gorm:"type:int(11);not null;default:1"`
+	DeletedAt        gorm.DeletedAt `json:"-"          gorm:"type:TIMESTAMP NULL;default:NULL"`
+	CreatedAt        time.Time      `json:"created_at"          gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	Options          []Options      `json:"-"  gorm:"foreignKey:QuestionID"`
+	Responses        []Responses    `json:"-"  gorm:"foreignKey:QuestionID"`
+	ScaleLabels      []ScaleLabels  `json:"-"  gorm:"foreignKey:QuestionID"`
+	Validations      []Validations  `json:"-"  gorm:"foreignKey:QuestionID"`
 }
 
 // BeforeCreate Update時に自動でmodified_atを現在時刻に
@@ -126,6 +128,48 @@ func (*Question) UpdateQuestion(ctx context.Context, questionnaireID int, pageNu
 	return nil
 }
 
+// UpdateQuestionIsResponseHidden 質問への回答をアンケートのオーナーと回答者本人以外に見せないかを更新
+func (*Question) UpdateQuestionIsResponseHidden(ctx context.Context, questionID int, isResponseHidden bool) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	result := db.
+		Model(&Questions{}).
+		Where("id = ?", questionID).
+		Update("is_response_hidden", isResponseHidden)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update is_response_hidden: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to update is_response_hidden: %w", ErrNoRecordUpdated)
+	}
+
+	return nil
+}
+
+// UpdateQuestionPoints クイズで質問に正解したときの配点を更新
+func (*Question) UpdateQuestionPoints(ctx context.Context, questionID int, points int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	result := db.
+		Model(&Questions{}).
+		Where("id = ?", questionID).
+		Update("points", points)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update points: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to update points: %w", ErrNoRecordUpdated)
+	}
+
+	return nil
+}
+
 // DeleteQuestion 質問の削除
 func (*Question) DeleteQuestion(ctx context.Context, questionID int) error {
 	db, err := getTx(ctx)
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 )
 
@@ -1037,3 +1039,31 @@ func checkQuestionNumTest(t *testing.T) {
 		assertion.Equal(testCase.expect.exists, exists, testCase.description, "questionNumAlreadyExists")
 	}
 }
+
+func TestUpdateQuestionPoints(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
+	require.NoError(t, err)
+
+	questionID, err := questionImpl.InsertQuestion(ctx, questionnaireID, 1, 1, "Text", "答えは？", "", true)
+	require.NoError(t, err)
+
+	questions, err := questionImpl.GetQuestions(ctx, questionnaireID)
+	require.NoError(t, err)
+	if assertion.Len(questions, 1) {
+		assertion.Equal(1, questions[0].Points, "default points")
+	}
+
+	err = questionImpl.UpdateQuestionPoints(ctx, questionID, 5)
+	require.NoError(t, err)
+
+	questions, err = questionImpl.GetQuestions(ctx, questionnaireID)
+	require.NoError(t, err)
+	if assertion.Len(questions, 1) {
+		assertion.Equal(5, questions[0].Points, "updated points")
+	}
+}
@@ -0,0 +1,15 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package model
+
+import "context"
+
+// IQuickPoll QuickPollのRepository
+type IQuickPoll interface {
+	InsertQuickPoll(ctx context.Context, questionnaireID int, questionID int, channelID string, messageID string, stamps []QuickPollStamps, createdBy string) error
+	GetQuickPoll(ctx context.Context, questionnaireID int) (*QuickPolls, error)
+	GetQuickPollByMessageID(ctx context.Context, messageID string) (*QuickPolls, error)
+	GetQuickPollVotes(ctx context.Context, questionnaireID int) ([]QuickPollVotes, error)
+	UpsertQuickPollVote(ctx context.Context, questionnaireID int, userID string, responseID int, optionNum int) error
+	DeleteQuickPollVote(ctx context.Context, questionnaireID int, userID string) error
+}
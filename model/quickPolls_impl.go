@@ -0,0 +1,200 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// QuickPoll QuickPollRepositoryの実装
+type QuickPoll struct{}
+
+// NewQuickPoll QuickPollのコンストラクター
+func NewQuickPoll() *QuickPoll {
+	return new(QuickPoll)
+}
+
+// QuickPolls quick_pollsテーブルの構造体
+// スタンプで回答するアンケートと、BOTが投稿したメッセージの対応
+type QuickPolls struct {
+	QuestionnaireID int               `gorm:"type:int(11);not null;primaryKey"`
+	QuestionID      int               `gorm:"type:int(11);not null"`
+	ChannelID       string            `gorm:"type:char(36);size:36;not null"`
+	MessageID       string            `gorm:"type:char(36);size:36;not null;uniqueIndex"`
+	CreatedBy       string            `gorm:"type:varchar(32);size:32;not null"`
+	CreatedAt       time.Time         `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	Stamps          []QuickPollStamps `gorm:"foreignKey:QuestionnaireID"`
+}
+
+// BeforeCreate insert時に自動でcreated_atを現在時刻に
+func (quickPoll *QuickPolls) BeforeCreate(_ *gorm.DB) error {
+	quickPoll.CreatedAt = time.Now()
+
+	return nil
+}
+
+// QuickPollStamps quick_poll_stampsテーブルの構造体
+// スタンプと選択肢の対応
+type QuickPollStamps struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	StampID         string `gorm:"type:char(36);size:36;not null;primaryKey"`
+	OptionNum       int    `gorm:"type:int(11);not null"`
+}
+
+// QuickPollVotes quick_poll_votesテーブルの構造体
+// スタンプによる回答と、respondentsテーブルの回答の対応
+type QuickPollVotes struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	UserTraqid      string `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+	ResponseID      int    `gorm:"type:int(11);not null"`
+	OptionNum       int    `gorm:"type:int(11);not null"`
+}
+
+// InsertQuickPoll スタンプで回答するアンケートの追加
+func (*QuickPoll) InsertQuickPoll(ctx context.Context, questionnaireID int, questionID int, channelID string, messageID string, stamps []QuickPollStamps, createdBy string) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	quickPollStamps := make([]QuickPollStamps, 0, len(stamps))
+	for _, stamp := range stamps {
+		quickPollStamps = append(quickPollStamps, QuickPollStamps{
+			QuestionnaireID: questionnaireID,
+			StampID:         stamp.StampID,
+			OptionNum:       stamp.OptionNum,
+		})
+	}
+
+	quickPoll := QuickPolls{
+		QuestionnaireID: questionnaireID,
+		QuestionID:      questionID,
+		ChannelID:       channelID,
+		MessageID:       messageID,
+		CreatedBy:       createdBy,
+		Stamps:          quickPollStamps,
+	}
+	err = db.Create(&quickPoll).Error
+	if err != nil {
+		return fmt.Errorf("failed to insert quick poll: %w", err)
+	}
+
+	return nil
+}
+
+// GetQuickPoll アンケートのスタンプでの回答の設定の取得
+func (*QuickPoll) GetQuickPoll(ctx context.Context, questionnaireID int) (*QuickPolls, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	var quickPoll QuickPolls
+	err = db.
+		Where("questionnaire_id = ?", questionnaireID).
+		Preload("Stamps", func(db *gorm.DB) *gorm.DB {
+			return db.Order("option_num")
+		}).
+		First(&quickPoll).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quick poll: %w", err)
+	}
+
+	return &quickPoll, nil
+}
+
+// GetQuickPollByMessageID BOTが投稿したメッセージのIDからスタンプでの回答の設定を取得
+func (*QuickPoll) GetQuickPollByMessageID(ctx context.Context, messageID string) (*QuickPolls, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	var quickPoll QuickPolls
+	err = db.
+		Where("message_id = ?", messageID).
+		Preload("Stamps", func(db *gorm.DB) *gorm.DB {
+			return db.Order("option_num")
+		}).
+		First(&quickPoll).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quick poll: %w", err)
+	}
+
+	return &quickPoll, nil
+}
+
+// GetQuickPollVotes スタンプによる回答の一覧の取得
+func (*QuickPoll) GetQuickPollVotes(ctx context.Context, questionnaireID int) ([]QuickPollVotes, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	votes := []QuickPollVotes{}
+	err = db.
+		Where("questionnaire_id = ?", questionnaireID).
+		Order("user_traqid").
+		Find(&votes).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quick poll votes: %w", err)
+	}
+
+	return votes, nil
+}
+
+// UpsertQuickPollVote スタンプによる回答の追加・更新
+func (*QuickPoll) UpsertQuickPollVote(ctx context.Context, questionnaireID int, userID string, responseID int, optionNum int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "questionnaire_id"}, {Name: "user_traqid"}},
+			DoUpdates: clause.AssignmentColumns([]string{"response_id", "option_num"}),
+		}).
+		Create(&QuickPollVotes{
+			QuestionnaireID: questionnaireID,
+			UserTraqid:      userID,
+			ResponseID:      responseID,
+			OptionNum:       optionNum,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to upsert quick poll vote: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteQuickPollVote スタンプによる回答の削除
+func (*QuickPoll) DeleteQuickPollVote(ctx context.Context, questionnaireID int, userID string) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	result := db.
+		Where("questionnaire_id = ? AND user_traqid = ?", questionnaireID, userID).
+		Delete(&QuickPollVotes{})
+	err = result.Error
+	if err != nil {
+		return fmt.Errorf("failed to delete quick poll vote: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNoRecordDeleted
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestQuickPolls(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+	questionnaireImpl := NewQuestionnaire()
+	questionImpl := NewQuestion()
+	quickPollImpl := NewQuickPoll()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "quickPollsTestQuestionnaire", "quick polls test", null.Time{}, "public", true, false, false)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+	questionID, err := questionImpl.InsertQuestion(ctx, questionnaireID, 1, 1, "MultipleChoice", "quick poll", "", true)
+	if err != nil {
+		t.Fatalf("failed to insert question: %v", err)
+	}
+
+	_, err = quickPollImpl.GetQuickPoll(ctx, questionnaireID)
+	if !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("invalid error(not created): expected: %+v, actual: %+v", ErrRecordNotFound, err)
+	}
+
+	messageID := "2d7ff3f5-c313-4f4a-a9bb-0b5f84d2b6f8"
+	err = quickPollImpl.InsertQuickPoll(ctx, questionnaireID, questionID, "9aba50da-f605-4cd0-a428-5e4558cb911e", messageID, []QuickPollStamps{
+		{StampID: "0b9a1a9c-4d7e-4c1b-a8b3-6f5e1c2d3a4b", OptionNum: 2},
+		{StampID: "5c6d7e8f-9a0b-4c1d-8e2f-3a4b5c6d7e8f", OptionNum: 1},
+	}, userOne)
+	if !assertion.NoError(err) {
+		return
+	}
+
+	quickPoll, err := quickPollImpl.GetQuickPoll(ctx, questionnaireID)
+	if assertion.NoError(err) {
+		assertion.Equal(questionID, quickPoll.QuestionID)
+		assertion.Equal(messageID, quickPoll.MessageID)
+		assertion.Equal(userOne, quickPoll.CreatedBy)
+		if assertion.Len(quickPoll.Stamps, 2) {
+			assertion.Equal(1, quickPoll.Stamps[0].OptionNum)
+			assertion.Equal("5c6d7e8f-9a0b-4c1d-8e2f-3a4b5c6d7e8f", quickPoll.Stamps[0].StampID)
+		}
+	}
+
+	quickPoll, err = quickPollImpl.GetQuickPollByMessageID(ctx, messageID)
+	if assertion.NoError(err) {
+		assertion.Equal(questionnaireID, quickPoll.QuestionnaireID)
+		assertion.Len(quickPoll.Stamps, 2)
+	}
+
+	_, err = quickPollImpl.GetQuickPollByMessageID(ctx, "00000000-0000-0000-0000-000000000000")
+	if !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("invalid error(unknown message): expected: %+v, actual: %+v", ErrRecordNotFound, err)
+	}
+
+	err = quickPollImpl.UpsertQuickPollVote(ctx, questionnaireID, userOne, 1, 1)
+	assertion.NoError(err)
+	err = quickPollImpl.UpsertQuickPollVote(ctx, questionnaireID, userTwo, 2, 1)
+	assertion.NoError(err)
+	err = quickPollImpl.UpsertQuickPollVote(ctx, questionnaireID, userOne, 1, 2)
+	assertion.NoError(err)
+
+	votes, err := quickPollImpl.GetQuickPollVotes(ctx, questionnaireID)
+	if assertion.NoError(err) && assertion.Len(votes, 2) {
+		for _, vote := range votes {
+			switch vote.UserTraqid {
+			case userOne:
+				assertion.Equal(1, vote.ResponseID)
+				assertion.Equal(2, vote.OptionNum)
+			case userTwo:
+				assertion.Equal(2, vote.ResponseID)
+				assertion.Equal(1, vote.OptionNum)
+			default:
+				t.Errorf("unexpected vote: %+v", vote)
+			}
+		}
+	}
+
+	err = quickPollImpl.DeleteQuickPollVote(ctx, questionnaireID, userTwo)
+	assertion.NoError(err)
+	err = quickPollImpl.DeleteQuickPollVote(ctx, questionnaireID, userTwo)
+	if !errors.Is(err, ErrNoRecordDeleted) {
+		t.Errorf("invalid error(already deleted): expected: %+v, actual: %+v", ErrNoRecordDeleted, err)
+	}
+
+	votes, err = quickPollImpl.GetQuickPollVotes(ctx, questionnaireID)
+	if assertion.NoError(err) {
+		assertion.Len(votes, 1)
+	}
+}
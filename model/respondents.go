@@ -10,13 +10,15 @@ import (
 )
 
 type MyResponseQuestionnaireInfo struct {
-	QuestionnaireID     int
-	Title               string
-	CreatedAt           time.Time
-	ModifiedAt          time.Time
-	ResponseDueDateTime null.Time
-	IsAnonymous         bool
-	IsTargetingMe       bool
+	QuestionnaireID            int
+	Title                      string
+	CreatedAt                  time.Time
+	ModifiedAt                 time.Time
+	ResponseDueDateTime        null.Time
+	IsAnonymous                bool
+	IsQuiz                     bool
+	IsQuizAnswerHiddenUntilDue bool
+	IsTargetingMe              bool
 }
 
 type MyResponseGroup struct {
@@ -29,13 +31,18 @@ type IRespondent interface {
 	InsertRespondent(ctx context.Context, userID string, questionnaireID int, submittedAt null.Time) (int, error)
 	UpdateSubmittedAt(ctx context.Context, responseID int) error
 	UpdateModifiedAt(ctx context.Context, responseID int) error
+	UpdateEnteredBy(ctx context.Context, responseID int, enteredBy string) error
+	UpdateScore(ctx context.Context, responseID int, score null.Int) error
+	UpdateReview(ctx context.Context, responseID int, status ReviewStatus, comment null.String, reviewedBy string) error
 	DeleteRespondent(ctx context.Context, responseID int) error
 	GetRespondent(ctx context.Context, responseID int) (*Respondents, error)
 	GetRespondentInfos(ctx context.Context, userID string, questionnaireIDs ...int) ([]RespondentInfo, error)
 	GetRespondentDetail(ctx context.Context, responseID int) (RespondentDetail, error)
-	GetRespondentDetails(ctx context.Context, questionnaireID int, sort string, onlyMyResponse bool, userID string, isDraft *bool) ([]RespondentDetail, error)
-	GetMyResponseGroups(ctx context.Context, userID string, questionnaireIDs []int, isDraft *bool, pageNum int) ([]MyResponseGroup, int, error)
+	GetRespondentDetails(ctx context.Context, questionnaireID int, sort string, onlyMyResponse bool, userID string, isDraft *bool, reviewStatus *ReviewStatus, limit int, cursor *Cursor) ([]RespondentDetail, *Cursor, error)
+	GetMyResponseGroups(ctx context.Context, userID string, questionnaireIDs []int, isDraft *bool, pageNum int, limit int, cursor *Cursor) ([]MyResponseGroup, int, *Cursor, error)
 	GetRespondentsUserIDs(ctx context.Context, questionnaireIDs []int) ([]Respondents, error)
 	GetMyResponseIDs(ctx context.Context, sort string, userID string, questionnaireIDs []int, isDraft *bool) ([]int, error)
 	CheckRespondent(ctx context.Context, userID string, questionnaireID int) (bool, error)
+	GetRespondentCounts(ctx context.Context, questionnaireID int) (int, int, error)
+	AnonymizeRespondents(ctx context.Context, questionnaireID int) error
 }
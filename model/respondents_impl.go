@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"crypto/hmac"
 	"errors"
 	"fmt"
 	"math"
@@ -23,11 +24,42 @@ func NewRespondent() *Respondent {
 	return new(Respondent)
 }
 
+// ReviewStatus 回答の審査状況
+type ReviewStatus string
+
+const (
+	// ReviewStatusPending 未審査
+	ReviewStatusPending ReviewStatus = "pending"
+	// ReviewStatusAccepted 承認
+	ReviewStatusAccepted ReviewStatus = "accepted"
+	// ReviewStatusRejected 却下
+	ReviewStatusRejected ReviewStatus = "rejected"
+	// ReviewStatusNeedsChanges 要修正
+	ReviewStatusNeedsChanges ReviewStatus = "needs_changes"
+)
+
+// IsValid 有効な審査状況か判定
+func (s ReviewStatus) IsValid() bool {
+	switch s {
+	case ReviewStatusPending, ReviewStatusAccepted, ReviewStatusRejected, ReviewStatusNeedsChanges:
+		return true
+	}
+
+	return false
+}
+
 // Respondents respondentsテーブルの構造体
 type Respondents struct {
 	ResponseID      int            `json:"responseID" gorm:"column:response_id;type:int(11) AUTO_INCREMENT;not null;primaryKey"`
 	QuestionnaireID int            `json:"questionnaireID" gorm:"type:int(11);not null"`
 	UserTraqid      string         `json:"user_traq_id,omitempty" gorm:"type:varchar(32);size:32;default:NULL"`
+	AnonymousKey    null.String    `json:"-" gorm:"type:char(64);size:64;default:NULL;index"`
+	EnteredBy       null.String    `json:"entered_by,omitempty" gorm:"type:varchar(32);size:32;default:NULL"`
+	ReviewStatus    ReviewStatus   `json:"review_status" gorm:"type:varchar(20);size:20;not null;default:pending"`
+	ReviewComment   null.String    `json:"review_comment,omitempty" gorm:"type:text;default:NULL"`
+	ReviewedBy      null.String    `json:"reviewed_by,omitempty" gorm:"type:varchar(32);size:32;default:NULL"`
+	ReviewedAt      null.Time      `json:"reviewed_at,omitempty" gorm:"type:TIMESTAMP NULL;default:NULL"`
+	Score           null.Int       `json:"score,omitempty" gorm:"type:int(11);default:NULL"`
 	ModifiedAt      time.Time      `json:"modified_at,omitempty" gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
 	SubmittedAt     null.Time      `json:"submitted_at,omitempty" gorm:"type:TIMESTAMP NULL;default:NULL"`
 	DeletedAt       gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"type:TIMESTAMP NULL;default:NULL"`
@@ -48,6 +80,19 @@ func (r *Respondents) BeforeUpdate(_ *gorm.DB) error {
 	return nil
 }
 
+// IsRespondedBy userIDのユーザーの回答か
+// 匿名のアンケートの回答はユーザーIDを保存していないため、ハッシュで照合する
+func (r *Respondents) IsRespondedBy(userID string) bool {
+	if userID == "" {
+		return false
+	}
+	if r.AnonymousKey.Valid {
+		return hmac.Equal([]byte(r.AnonymousKey.String), []byte(AnonymousRespondentKey(r.QuestionnaireID, userID)))
+	}
+
+	return r.UserTraqid == userID
+}
+
 // RespondentInfo 回答とその周辺情報の構造体
 type RespondentInfo struct {
 	Title        string    `json:"questionnaire_title"`
@@ -63,7 +108,27 @@ type RespondentDetail struct {
 	QuestionnaireID int            `json:"questionnaireID,omitempty"`
 	SubmittedAt     null.Time      `json:"submitted_at,omitempty"`
 	ModifiedAt      time.Time      `json:"modified_at,omitempty"`
+	EnteredBy       null.String    `json:"entered_by,omitempty"`
+	ReviewStatus    ReviewStatus   `json:"review_status"`
+	ReviewComment   null.String    `json:"review_comment,omitempty"`
+	ReviewedBy      null.String    `json:"reviewed_by,omitempty"`
+	ReviewedAt      null.Time      `json:"reviewed_at,omitempty"`
+	Score           null.Int       `json:"score,omitempty"`
 	Responses       []ResponseBody `json:"body"`
+	// AnonymousKey 匿名のアンケートの回答者を照合するためのハッシュ。回答者を特定できるため外には返さない
+	AnonymousKey null.String `json:"-"`
+}
+
+// IsRespondedBy userIDのユーザーの回答か
+// 匿名のアンケートの回答はTraqIDが空なので、ハッシュで照合する
+func (r *RespondentDetail) IsRespondedBy(userID string) bool {
+	respondent := Respondents{
+		QuestionnaireID: r.QuestionnaireID,
+		UserTraqid:      r.TraqID,
+		AnonymousKey:    r.AnonymousKey,
+	}
+
+	return respondent.IsRespondedBy(userID)
 }
 
 // InsertRespondent 回答の追加
@@ -99,6 +164,14 @@ func (*Respondent) InsertRespondent(ctx context.Context, userID string, question
 		}
 	}
 
+	// 匿名のアンケートでは回答とユーザーを結びつけないよう、ユーザーIDの代わりにハッシュを保存する
+	createQuery := db
+	if questionnaire.IsAnonymous {
+		respondent.UserTraqid = ""
+		respondent.AnonymousKey = null.StringFrom(AnonymousRespondentKey(questionnaireID, userID))
+		createQuery = db.Omit("UserTraqid")
+	}
+
 	if !questionnaire.IsDuplicateAnswerAllowed {
 		// Lock the questionnaire row to serialize concurrent insert attempts.
 		// SELECT ... FOR UPDATE on respondents would not lock non-existent rows,
@@ -113,7 +186,7 @@ func (*Respondent) InsertRespondent(ctx context.Context, userID string, question
 			return 0, fmt.Errorf("failed to lock questionnaire row: %w", err)
 		}
 		err = db.
-			Where("questionnaire_id = ? AND user_traqid = ?", questionnaireID, userID).
+			Where("questionnaire_id = ? AND (user_traqid = ? OR anonymous_key = ?)", questionnaireID, userID, AnonymousRespondentKey(questionnaireID, userID)).
 			First(&Respondents{}).Error
 		if err == nil {
 			return 0, ErrDuplicatedAnswered
@@ -123,7 +196,7 @@ func (*Respondent) InsertRespondent(ctx context.Context, userID string, question
 		}
 	}
 
-	err = db.Create(&respondent).Error
+	err = createQuery.Create(&respondent).Error
 	if err != nil {
 		return 0, fmt.Errorf("failed to insert a respondent record: %w", err)
 	}
@@ -150,6 +223,70 @@ func (*Respondent) UpdateSubmittedAt(ctx context.Context, responseID int) error
 	return nil
 }
 
+// UpdateEnteredBy 回答を代理で入力した運営の更新
+func (*Respondent) UpdateEnteredBy(ctx context.Context, responseID int, enteredBy string) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = db.
+		Model(&Respondents{}).
+		Where("response_id = ?", responseID).
+		Update("entered_by", enteredBy).Error
+	if err != nil {
+		return fmt.Errorf("failed to update response's entered_by: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateScore クイズの回答の得点の更新
+// 採点は回答の編集ではないため、modified_atは更新しない
+func (*Respondent) UpdateScore(ctx context.Context, responseID int, score null.Int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = db.
+		Model(&Respondents{}).
+		Where("response_id = ?", responseID).
+		UpdateColumn("score", score).Error
+	if err != nil {
+		return fmt.Errorf("failed to update response's score: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateReview 回答の審査状況の更新
+// 審査は回答の編集ではないため、modified_atは更新しない
+func (*Respondent) UpdateReview(ctx context.Context, responseID int, status ReviewStatus, comment null.String, reviewedBy string) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	result := db.
+		Model(&Respondents{}).
+		Where("response_id = ?", responseID).
+		UpdateColumns(map[string]interface{}{
+			"review_status":  status,
+			"review_comment": comment,
+			"reviewed_by":    reviewedBy,
+			"reviewed_at":    time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update response's review: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNoRecordUpdated
+	}
+
+	return nil
+}
+
 // UpdateModifiedAt 編集日時更新
 func (*Respondent) UpdateModifiedAt(ctx context.Context, responseID int) error {
 	db, err := getTx(ctx)
@@ -220,11 +357,21 @@ func (*Respondent) GetRespondentInfos(ctx context.Context, userID string, questi
 
 	respondentInfos := []RespondentInfo{}
 
+	var targetQuestionnaireIDs []int
+	if len(questionnaireIDs) != 0 {
+		targetQuestionnaireIDs = questionnaireIDs
+	}
+	myRespondent, myRespondentArgs, err := myRespondentCondition(db, "respondents", userID, targetQuestionnaireIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	query := db.
 		Table("respondents").
 		Joins("LEFT OUTER JOIN questionnaires ON respondents.questionnaire_id = questionnaires.id").
-		Order("respondents.submitted_at DESC").
-		Where("user_traqid = ? AND respondents.deleted_at IS NULL AND questionnaires.deleted_at IS NULL", userID)
+		Order(nullsFirstOrder("respondents.submitted_at", true)).
+		Where(myRespondent, myRespondentArgs...).
+		Where("respondents.deleted_at IS NULL AND questionnaires.deleted_at IS NULL")
 
 	if len(questionnaireIDs) != 0 {
 		query = query.Where("questionnaire_id IN (?)", questionnaireIDs)
@@ -252,7 +399,7 @@ func (*Respondent) GetRespondentDetail(ctx context.Context, responseID int) (Res
 	err = db.
 		Session(&gorm.Session{}).
 		Where("respondents.response_id = ?", responseID).
-		Select("QuestionnaireID", "UserTraqid", "ModifiedAt", "SubmittedAt").
+		Select("QuestionnaireID", "UserTraqid", "AnonymousKey", "ModifiedAt", "SubmittedAt", "EnteredBy", "ReviewStatus", "ReviewComment", "ReviewedBy", "ReviewedAt", "Score").
 		Take(&respondent).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return RespondentDetail{}, ErrRecordNotFound
@@ -281,6 +428,13 @@ func (*Respondent) GetRespondentDetail(ctx context.Context, responseID int) (Res
 		QuestionnaireID: respondent.QuestionnaireID,
 		ModifiedAt:      respondent.ModifiedAt,
 		SubmittedAt:     respondent.SubmittedAt,
+		EnteredBy:       respondent.EnteredBy,
+		ReviewStatus:    respondent.ReviewStatus,
+		ReviewComment:   respondent.ReviewComment,
+		ReviewedBy:      respondent.ReviewedBy,
+		ReviewedAt:      respondent.ReviewedAt,
+		Score:           respondent.Score,
+		AnonymousKey:    respondent.AnonymousKey,
 	}
 
 	for _, question := range questions {
@@ -309,10 +463,13 @@ func (*Respondent) GetRespondentDetail(ctx context.Context, responseID int) (Res
 }
 
 // GetRespondentDetails アンケートの回答の詳細情報一覧の取得
-func (*Respondent) GetRespondentDetails(ctx context.Context, questionnaireID int, sort string, onlyMyResponse bool, userID string, isDraft *bool) ([]RespondentDetail, error) {
+// reviewStatusがnilでない場合はその審査状況の回答のみを取得する
+// limitが0の場合はすべて取得する
+// 2つ目の戻り値は次のページのカーソル(次のページがない場合はnil)
+func (*Respondent) GetRespondentDetails(ctx context.Context, questionnaireID int, sort string, onlyMyResponse bool, userID string, isDraft *bool, reviewStatus *ReviewStatus, limit int, cursor *Cursor) ([]RespondentDetail, *Cursor, error) {
 	db, err := getTx(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tx: %w", err)
+		return nil, nil, fmt.Errorf("failed to get tx: %w", err)
 	}
 
 	respondents := []Respondents{}
@@ -320,9 +477,9 @@ func (*Respondent) GetRespondentDetails(ctx context.Context, questionnaireID int
 	query := db.
 		Session(&gorm.Session{}).
 		Where("respondents.questionnaire_id = ?", questionnaireID).
-		Select("ResponseID", "UserTraqid", "ModifiedAt", "SubmittedAt")
+		Select("ResponseID", "UserTraqid", "AnonymousKey", "ModifiedAt", "SubmittedAt", "EnteredBy", "ReviewStatus", "ReviewComment", "ReviewedBy", "ReviewedAt", "Score")
 	if onlyMyResponse {
-		query = query.Where("user_traqid = ?", userID)
+		query = query.Where("(user_traqid = ? OR anonymous_key = ?)", userID, AnonymousRespondentKey(questionnaireID, userID))
 	}
 	if isDraft != nil {
 		if *isDraft {
@@ -331,20 +488,44 @@ func (*Respondent) GetRespondentDetails(ctx context.Context, questionnaireID int
 			query = query.Where("submitted_at IS NOT NULL")
 		}
 	}
+	if reviewStatus != nil {
+		query = query.Where("review_status = ?", *reviewStatus)
+	}
 
 	query, sortNum, err := setRespondentsOrder(query, sort)
 	if err != nil {
-		return nil, fmt.Errorf("failed to set order: %w", err)
+		return nil, nil, fmt.Errorf("failed to set order: %w", err)
+	}
+
+	// 質問の回答による並び替えはメモリ上で行うため、ページネーションとは併用できない
+	if sortNum != 0 && (limit != 0 || cursor != nil) {
+		return nil, nil, fmt.Errorf("pagination with sort by question: %w", ErrInvalidSortParam)
+	}
+	if cursor != nil {
+		query, err = setRespondentsCursor(query, sort, cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if limit != 0 {
+		// 次のページの有無を判定するために1件多く取得する
+		query = query.Limit(limit + 1)
 	}
 
 	err = query.
 		Find(&respondents).Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to get respondents: %w", err)
+		return nil, nil, fmt.Errorf("failed to get respondents: %w", err)
+	}
+
+	var nextCursor *Cursor
+	if limit != 0 && len(respondents) > limit {
+		respondents = respondents[:limit]
+		nextCursor = newRespondentsCursor(sort, respondents[limit-1])
 	}
 
 	if len(respondents) == 0 {
-		return []RespondentDetail{}, nil
+		return []RespondentDetail{}, nil, nil
 	}
 
 	responseIDs := make([]int, 0, len(respondents))
@@ -354,7 +535,7 @@ func (*Respondent) GetRespondentDetails(ctx context.Context, questionnaireID int
 
 	isAnonymous, err := NewQuestionnaire().GetResponseIsAnonymousByQuestionnaireID(ctx, questionnaireID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get response is anonymous by questionnaire id: %w", err)
+		return nil, nil, fmt.Errorf("failed to get response is anonymous by questionnaire id: %w", err)
 	}
 
 	respondentDetails := make([]RespondentDetail, 0, len(respondents))
@@ -365,6 +546,13 @@ func (*Respondent) GetRespondentDetails(ctx context.Context, questionnaireID int
 			QuestionnaireID: questionnaireID,
 			SubmittedAt:     respondent.SubmittedAt,
 			ModifiedAt:      respondent.ModifiedAt,
+			EnteredBy:       respondent.EnteredBy,
+			ReviewStatus:    respondent.ReviewStatus,
+			ReviewComment:   respondent.ReviewComment,
+			ReviewedBy:      respondent.ReviewedBy,
+			ReviewedAt:      respondent.ReviewedAt,
+			Score:           respondent.Score,
+			AnonymousKey:    respondent.AnonymousKey,
 		}
 
 		if !isAnonymous {
@@ -390,7 +578,7 @@ func (*Respondent) GetRespondentDetails(ctx context.Context, questionnaireID int
 		Select("ID", "Type").
 		Find(&questions).Error
 	if err != nil {
-		return nil, fmt.Errorf("failed to get questions: %w", err)
+		return nil, nil, fmt.Errorf("failed to get questions: %w", err)
 	}
 
 	for _, question := range questions {
@@ -429,28 +617,31 @@ func (*Respondent) GetRespondentDetails(ctx context.Context, questionnaireID int
 
 	respondentDetails, err = sortRespondentDetail(sortNum, len(questions), respondentDetails)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sort RespondentDetails: %w", err)
+		return nil, nil, fmt.Errorf("failed to sort RespondentDetails: %w", err)
 	}
 
-	return respondentDetails, nil
+	return respondentDetails, nextCursor, nil
 }
 
 type myResponseGroupRow struct {
-	QuestionnaireID int       `gorm:"column:questionnaire_id"`
-	Title           string    `gorm:"column:title"`
-	CreatedAt       time.Time `gorm:"column:created_at"`
-	ModifiedAt      time.Time `gorm:"column:modified_at"`
-	ResTimeLimit    null.Time `gorm:"column:res_time_limit"`
-	IsAnonymous     bool      `gorm:"column:is_anonymous"`
-	IsTargetingMe   bool      `gorm:"column:is_targeting_me"`
-	FirstResponseID int       `gorm:"column:first_response_id"`
+	QuestionnaireID            int       `gorm:"column:questionnaire_id"`
+	Title                      string    `gorm:"column:title"`
+	CreatedAt                  time.Time `gorm:"column:created_at"`
+	ModifiedAt                 time.Time `gorm:"column:modified_at"`
+	ResTimeLimit               null.Time `gorm:"column:res_time_limit"`
+	IsAnonymous                bool      `gorm:"column:is_anonymous"`
+	IsQuiz                     bool      `gorm:"column:is_quiz"`
+	IsQuizAnswerHiddenUntilDue bool      `gorm:"column:is_quiz_answer_hidden_until_due"`
+	IsTargetingMe              bool      `gorm:"column:is_targeting_me"`
+	FirstResponseID            int       `gorm:"column:first_response_id"`
 }
 
-func buildMyResponseBaseQuery(db *gorm.DB, userID string, questionnaireIDs []int, isDraft *bool) *gorm.DB {
+func buildMyResponseBaseQuery(db *gorm.DB, myRespondent string, myRespondentArgs []interface{}, questionnaireIDs []int, isDraft *bool) *gorm.DB {
 	query := db.
 		Table("respondents").
 		Joins("INNER JOIN questionnaires ON respondents.questionnaire_id = questionnaires.id").
-		Where("respondents.deleted_at IS NULL AND questionnaires.deleted_at IS NULL AND respondents.user_traqid = ?", userID)
+		Where("respondents.deleted_at IS NULL AND questionnaires.deleted_at IS NULL").
+		Where(myRespondent, myRespondentArgs...)
 
 	if questionnaireIDs != nil {
 		query = query.Where("respondents.questionnaire_id IN (?)", questionnaireIDs)
@@ -468,13 +659,24 @@ func buildMyResponseBaseQuery(db *gorm.DB, userID string, questionnaireIDs []int
 }
 
 // GetMyResponseGroups 自分の回答をアンケートごとにまとめて取得
-func (*Respondent) GetMyResponseGroups(ctx context.Context, userID string, questionnaireIDs []int, isDraft *bool, pageNum int) ([]MyResponseGroup, int, error) {
+// 2つ目の戻り値はページ数の最大値、3つ目の戻り値は次のページのカーソル(次のページがない場合はnil)
+// cursorが指定された場合はpageNumを無視してカーソルの続きから取得する
+func (*Respondent) GetMyResponseGroups(ctx context.Context, userID string, questionnaireIDs []int, isDraft *bool, pageNum int, limit int, cursor *Cursor) ([]MyResponseGroup, int, *Cursor, error) {
 	db, err := getTx(ctx)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get transaction: %w", err)
+		return nil, 0, nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if limit < 1 || limit > MaxPageLimit {
+		limit = DefaultPageLimit
+	}
+
+	myRespondent, myRespondentArgs, err := myRespondentCondition(db, "respondents", userID, questionnaireIDs)
+	if err != nil {
+		return nil, 0, nil, err
 	}
 
-	baseQuery := buildMyResponseBaseQuery(db, userID, questionnaireIDs, isDraft)
+	baseQuery := buildMyResponseBaseQuery(db, myRespondent, myRespondentArgs, questionnaireIDs, isDraft)
 
 	var count int64
 	err = baseQuery.
@@ -482,39 +684,54 @@ func (*Respondent) GetMyResponseGroups(ctx context.Context, userID string, quest
 		Distinct("respondents.questionnaire_id").
 		Count(&count).Error
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to count my response questionnaires: %w", err)
+		return nil, 0, nil, fmt.Errorf("failed to count my response questionnaires: %w", err)
 	}
 
 	if count == 0 {
-		return []MyResponseGroup{}, 0, nil
+		return []MyResponseGroup{}, 0, nil, nil
 	}
 
-	pageMax := (int(count) + 19) / 20
-	if pageNum > pageMax {
-		return nil, 0, ErrTooLargePageNum
+	pageMax := (int(count) + limit - 1) / limit
+	if cursor == nil && pageNum > pageMax {
+		return nil, 0, nil, ErrTooLargePageNum
 	}
 
 	groupRows := []myResponseGroupRow{}
-	groupQuery := buildMyResponseBaseQuery(db, userID, questionnaireIDs, isDraft).
+	groupQuery := buildMyResponseBaseQuery(db, myRespondent, myRespondentArgs, questionnaireIDs, isDraft).
 		Select(
-			"respondents.questionnaire_id, questionnaires.title, questionnaires.created_at, questionnaires.modified_at, questionnaires.res_time_limit, questionnaires.is_anonymous, "+
+			"respondents.questionnaire_id, questionnaires.title, questionnaires.created_at, questionnaires.modified_at, questionnaires.res_time_limit, questionnaires.is_anonymous, questionnaires.is_quiz, questionnaires.is_quiz_answer_hidden_until_due, "+
 				"EXISTS(SELECT 1 FROM targets WHERE targets.questionnaire_id = questionnaires.id AND targets.user_traqid = ?) AS is_targeting_me, "+
 				"MIN(respondents.response_id) AS first_response_id",
 			userID,
 		).
-		Group("respondents.questionnaire_id, questionnaires.id, questionnaires.title, questionnaires.created_at, questionnaires.modified_at, questionnaires.res_time_limit, questionnaires.is_anonymous").
+		Group("respondents.questionnaire_id, questionnaires.id, questionnaires.title, questionnaires.created_at, questionnaires.modified_at, questionnaires.res_time_limit, questionnaires.is_anonymous, questionnaires.is_quiz, questionnaires.is_quiz_answer_hidden_until_due").
 		Order("first_response_id")
 
+	if cursor != nil {
+		if cursor.Sort != "" {
+			return nil, 0, nil, fmt.Errorf("cursor for another sort: %w", ErrInvalidCursor)
+		}
+		groupQuery = groupQuery.Having("MIN(respondents.response_id) > ?", cursor.ID)
+	} else {
+		groupQuery = groupQuery.Offset((pageNum - 1) * limit)
+	}
+
+	// 次のページの有無を判定するために1件多く取得する
 	err = groupQuery.
-		Limit(20).
-		Offset((pageNum - 1) * 20).
+		Limit(limit + 1).
 		Find(&groupRows).Error
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get my response groups: %w", err)
+		return nil, 0, nil, fmt.Errorf("failed to get my response groups: %w", err)
 	}
 
 	if len(groupRows) == 0 {
-		return []MyResponseGroup{}, pageMax, nil
+		return []MyResponseGroup{}, pageMax, nil, nil
+	}
+
+	var nextCursor *Cursor
+	if len(groupRows) > limit {
+		groupRows = groupRows[:limit]
+		nextCursor = &Cursor{ID: groupRows[limit-1].FirstResponseID}
 	}
 
 	groups := make([]MyResponseGroup, 0, len(groupRows))
@@ -523,13 +740,15 @@ func (*Respondent) GetMyResponseGroups(ctx context.Context, userID string, quest
 	for i, row := range groupRows {
 		groups = append(groups, MyResponseGroup{
 			QuestionnaireInfo: MyResponseQuestionnaireInfo{
-				QuestionnaireID:     row.QuestionnaireID,
-				Title:               row.Title,
-				CreatedAt:           row.CreatedAt,
-				ModifiedAt:          row.ModifiedAt,
-				ResponseDueDateTime: row.ResTimeLimit,
-				IsAnonymous:         row.IsAnonymous,
-				IsTargetingMe:       row.IsTargetingMe,
+				QuestionnaireID:            row.QuestionnaireID,
+				Title:                      row.Title,
+				CreatedAt:                  row.CreatedAt,
+				ModifiedAt:                 row.ModifiedAt,
+				ResponseDueDateTime:        row.ResTimeLimit,
+				IsAnonymous:                row.IsAnonymous,
+				IsQuiz:                     row.IsQuiz,
+				IsQuizAnswerHiddenUntilDue: row.IsQuizAnswerHiddenUntilDue,
+				IsTargetingMe:              row.IsTargetingMe,
 			},
 			Responses: []RespondentDetail{},
 		})
@@ -540,8 +759,9 @@ func (*Respondent) GetMyResponseGroups(ctx context.Context, userID string, quest
 	respondents := []Respondents{}
 	respondentQuery := db.
 		Session(&gorm.Session{}).
-		Where("respondents.deleted_at IS NULL AND respondents.user_traqid = ? AND respondents.questionnaire_id IN (?)", userID, pageQuestionnaireIDs).
-		Select("ResponseID", "QuestionnaireID", "UserTraqid", "ModifiedAt", "SubmittedAt")
+		Where("respondents.deleted_at IS NULL AND respondents.questionnaire_id IN (?)", pageQuestionnaireIDs).
+		Where(myRespondent, myRespondentArgs...).
+		Select("ResponseID", "QuestionnaireID", "UserTraqid", "ModifiedAt", "SubmittedAt", "EnteredBy", "ReviewStatus", "ReviewComment", "ReviewedBy", "ReviewedAt", "Score")
 	if isDraft != nil {
 		if *isDraft {
 			respondentQuery = respondentQuery.Where("submitted_at IS NULL")
@@ -553,11 +773,11 @@ func (*Respondent) GetMyResponseGroups(ctx context.Context, userID string, quest
 
 	err = respondentQuery.Find(&respondents).Error
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get respondents for my response groups: %w", err)
+		return nil, 0, nil, fmt.Errorf("failed to get respondents for my response groups: %w", err)
 	}
 
 	if len(respondents) == 0 {
-		return groups, pageMax, nil
+		return groups, pageMax, nextCursor, nil
 	}
 
 	responseIDs := make([]int, 0, len(respondents))
@@ -571,6 +791,12 @@ func (*Respondent) GetMyResponseGroups(ctx context.Context, userID string, quest
 			QuestionnaireID: respondent.QuestionnaireID,
 			ModifiedAt:      respondent.ModifiedAt,
 			SubmittedAt:     respondent.SubmittedAt,
+			EnteredBy:       respondent.EnteredBy,
+			ReviewStatus:    respondent.ReviewStatus,
+			ReviewComment:   respondent.ReviewComment,
+			ReviewedBy:      respondent.ReviewedBy,
+			ReviewedAt:      respondent.ReviewedAt,
+			Score:           respondent.Score,
 			Responses:       []ResponseBody{},
 		})
 		lastIdx := len(groups[groupIdx].Responses) - 1
@@ -592,7 +818,7 @@ func (*Respondent) GetMyResponseGroups(ctx context.Context, userID string, quest
 		Select("ID", "QuestionnaireID", "QuestionNum", "Type").
 		Find(&questions).Error
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get questions for my response groups: %w", err)
+		return nil, 0, nil, fmt.Errorf("failed to get questions for my response groups: %w", err)
 	}
 
 	for _, question := range questions {
@@ -634,7 +860,7 @@ func (*Respondent) GetMyResponseGroups(ctx context.Context, userID string, quest
 		}
 	}
 
-	return groups, pageMax, nil
+	return groups, pageMax, nextCursor, nil
 }
 
 // GetRespondentsUserIDs 回答者のユーザーID取得
@@ -648,12 +874,31 @@ func (*Respondent) GetRespondentsUserIDs(ctx context.Context, questionnaireIDs [
 
 	err = db.
 		Where("questionnaire_id IN (?)", questionnaireIDs).
-		Select("questionnaire_id, user_traqid").
+		Select("questionnaire_id, user_traqid, anonymous_key").
 		Find(&respondents).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to get respondents:%w", err)
 	}
 
+	anonymousKeys := map[int][]string{}
+	for _, respondent := range respondents {
+		if respondent.AnonymousKey.Valid {
+			anonymousKeys[respondent.QuestionnaireID] = append(anonymousKeys[respondent.QuestionnaireID], respondent.AnonymousKey.String)
+		}
+	}
+	resolved := make(map[int]map[string]string, len(anonymousKeys))
+	for questionnaireID, keys := range anonymousKeys {
+		resolved[questionnaireID], err = resolveAnonymousRespondents(db, questionnaireID, keys)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve anonymous respondents: %w", err)
+		}
+	}
+	for i, respondent := range respondents {
+		if respondent.AnonymousKey.Valid {
+			respondents[i].UserTraqid = resolved[respondent.QuestionnaireID][respondent.AnonymousKey.String]
+		}
+	}
+
 	return respondents, nil
 }
 
@@ -664,9 +909,15 @@ func (*Respondent) GetMyResponseIDs(ctx context.Context, sort string, userID str
 		return nil, fmt.Errorf("failed to get transaction: %w", err)
 	}
 
+	myRespondent, myRespondentArgs, err := myRespondentCondition(db, "respondents", userID, questionnaireIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	responsesID := []int{}
 	query := db.Model(&Respondents{}).
-		Where("deleted_at IS NULL AND user_traqid = ?", userID).
+		Where("deleted_at IS NULL").
+		Where(myRespondent, myRespondentArgs...).
 		Select("response_id")
 
 	if questionnaireIDs != nil {
@@ -702,7 +953,7 @@ func (*Respondent) CheckRespondent(ctx context.Context, userID string, questionn
 	}
 
 	err = db.
-		Where("user_traqid = ? AND questionnaire_id = ?", userID, questionnaireID).
+		Where("(user_traqid = ? OR anonymous_key = ?) AND questionnaire_id = ?", userID, AnonymousRespondentKey(questionnaireID, userID), questionnaireID).
 		First(&Respondents{}).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return false, nil
@@ -714,6 +965,80 @@ func (*Respondent) CheckRespondent(ctx context.Context, userID string, questionn
 	return true, nil
 }
 
+// GetRespondentCounts 提出済みの回答の回答者数と回答数を取得
+func (*Respondent) GetRespondentCounts(ctx context.Context, questionnaireID int) (int, int, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	counts := struct {
+		RespondentCount int
+		ResponseCount   int
+	}{}
+	err = db.
+		Model(&Respondents{}).
+		Where("questionnaire_id = ? AND submitted_at IS NOT NULL", questionnaireID).
+		Select("COUNT(DISTINCT COALESCE(user_traqid, anonymous_key)) AS respondent_count, COUNT(*) AS response_count").
+		Scan(&counts).Error
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get respondent counts: %w", err)
+	}
+
+	return counts.RespondentCount, counts.ResponseCount, nil
+}
+
+// AnonymizeRespondents アンケートの回答のユーザーIDをハッシュに置き換える
+// 削除済みの回答も含めて、回答とユーザーの対応が残らないようにする
+func (*Respondent) AnonymizeRespondents(ctx context.Context, questionnaireID int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	return anonymizeRespondents(db, questionnaireID)
+}
+
+func anonymizeRespondents(db *gorm.DB, questionnaireID int) error {
+	respondents := []Respondents{}
+	err := db.
+		Session(&gorm.Session{NewDB: true}).
+		Unscoped().
+		Where("questionnaire_id = ? AND user_traqid IS NOT NULL", questionnaireID).
+		Select("ResponseID", "UserTraqid").
+		Find(&respondents).Error
+	if err != nil {
+		return fmt.Errorf("failed to get respondents: %w", err)
+	}
+
+	for _, respondent := range respondents {
+		// 匿名化で編集日時が変わらないよう、フックを通さずに更新する
+		err = db.
+			Session(&gorm.Session{NewDB: true}).
+			Unscoped().
+			Model(&Respondents{}).
+			Where("response_id = ?", respondent.ResponseID).
+			UpdateColumns(map[string]interface{}{
+				"user_traqid":   gorm.Expr("NULL"),
+				"anonymous_key": AnonymousRespondentKey(questionnaireID, respondent.UserTraqid),
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to anonymize respondent: %w", err)
+		}
+	}
+
+	// スタンプでの回答はtraQ上で誰が押したかわかるため、回答との対応も残さない
+	err = db.
+		Session(&gorm.Session{NewDB: true}).
+		Where("questionnaire_id = ?", questionnaireID).
+		Delete(&QuickPollVotes{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete quick poll votes: %w", err)
+	}
+
+	return nil
+}
+
 func setRespondentsOrder(query *gorm.DB, sort string) (*gorm.DB, int, error) {
 	var sortNum int
 	switch sort {
@@ -722,9 +1047,9 @@ func setRespondentsOrder(query *gorm.DB, sort string) (*gorm.DB, int, error) {
 	case "-traqid":
 		query = query.Order("user_traqid DESC")
 	case "submitted_at":
-		query = query.Order("submitted_at")
+		query = query.Order(nullsFirstOrder("submitted_at", false))
 	case "-submitted_at":
-		query = query.Order("submitted_at DESC")
+		query = query.Order(nullsFirstOrder("submitted_at", true))
 	case "modified_at":
 		query = query.Order("modified_at")
 	case "-modified_at":
@@ -743,6 +1068,61 @@ func setRespondentsOrder(query *gorm.DB, sort string) (*gorm.DB, int, error) {
 	return query, sortNum, nil
 }
 
+func setRespondentsCursor(query *gorm.DB, sort string, cursor *Cursor) (*gorm.DB, error) {
+	if cursor.Sort != sort {
+		return nil, fmt.Errorf("cursor for another sort: %w", ErrInvalidCursor)
+	}
+
+	switch sort {
+	case "traqid":
+		query = whereAfterCursor(query, "user_traqid", false, cursor.Text, "response_id", false, cursor.ID)
+	case "-traqid":
+		query = whereAfterCursor(query, "user_traqid", true, cursor.Text, "response_id", false, cursor.ID)
+	case "submitted_at", "-submitted_at":
+		// submitted_atがNULLのもの(下書き)は昇順では先頭、降順では末尾に並ぶ
+		desc := sort == "-submitted_at"
+		switch {
+		case cursor.IsNil && desc:
+			query = query.Where("submitted_at IS NULL AND response_id > ?", cursor.ID)
+		case cursor.IsNil:
+			query = query.Where("(submitted_at IS NULL AND response_id > ?) OR submitted_at IS NOT NULL", cursor.ID)
+		case desc:
+			query = query.Where("submitted_at < ? OR (submitted_at = ? AND response_id > ?) OR submitted_at IS NULL", cursor.Time, cursor.Time, cursor.ID)
+		default:
+			query = whereAfterCursor(query, "submitted_at", false, cursor.Time, "response_id", false, cursor.ID)
+		}
+	case "modified_at":
+		query = whereAfterCursor(query, "modified_at", false, cursor.Time, "response_id", false, cursor.ID)
+	case "-modified_at":
+		query = whereAfterCursor(query, "modified_at", true, cursor.Time, "response_id", false, cursor.ID)
+	case "":
+		query = whereAfterCursor(query, "", false, nil, "response_id", false, cursor.ID)
+	default:
+		return nil, ErrInvalidSortParam
+	}
+
+	return query, nil
+}
+
+func newRespondentsCursor(sort string, respondent Respondents) *Cursor {
+	cursor := &Cursor{
+		Sort: sort,
+		ID:   respondent.ResponseID,
+	}
+
+	switch sort {
+	case "traqid", "-traqid":
+		cursor.Text = respondent.UserTraqid
+	case "submitted_at", "-submitted_at":
+		cursor.Time = respondent.SubmittedAt.Time
+		cursor.IsNil = !respondent.SubmittedAt.Valid
+	case "modified_at", "-modified_at":
+		cursor.Time = respondent.ModifiedAt
+	}
+
+	return cursor
+}
+
 func sortRespondentDetail(sortNum int, questionNum int, respondentDetails []RespondentDetail) ([]RespondentDetail, error) {
 	if sortNum == 0 {
 		return respondentDetails, nil
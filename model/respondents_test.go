@@ -22,7 +22,7 @@ func TestInsertRespondent(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -123,6 +123,211 @@ func TestInsertRespondent(t *testing.T) {
 	}
 }
 
+func TestInsertRespondentAnonymous(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, true, false)
+	require.NoError(t, err)
+
+	responseID, err := respondentImpl.InsertRespondent(ctx, userTwo, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+
+	respondent := Respondents{}
+	err = db.
+		Session(&gorm.Session{NewDB: true}).
+		Where("response_id = ?", responseID).
+		First(&respondent).Error
+	require.NoError(t, err)
+
+	assertion.Empty(respondent.UserTraqid, "userID is not stored")
+	assertion.Equal(null.StringFrom(AnonymousRespondentKey(questionnaireID, userTwo)), respondent.AnonymousKey, "anonymousKey")
+	assertion.True(respondent.IsRespondedBy(userTwo), "responded by userTwo")
+	assertion.False(respondent.IsRespondedBy(userOne), "not responded by userOne")
+
+	// 複数回答が許可されていないので、同じユーザーは2回目の回答ができない
+	_, err = respondentImpl.InsertRespondent(ctx, userTwo, questionnaireID, null.NewTime(time.Now(), true))
+	assertion.Error(err, "duplicate answer")
+
+	isRespondent, err := respondentImpl.CheckRespondent(ctx, userTwo, questionnaireID)
+	assertion.NoError(err, "check respondent")
+	assertion.True(isRespondent, "check respondent")
+
+	// 回答の詳細情報ではtraQ IDが空になるが、ハッシュで回答者本人かを照合できる
+	respondentDetail, err := respondentImpl.GetRespondentDetail(ctx, responseID)
+	require.NoError(t, err)
+	assertion.True(respondentDetail.IsRespondedBy(userTwo), "detail responded by userTwo")
+	assertion.False(respondentDetail.IsRespondedBy(userOne), "detail not responded by userOne")
+	respondentDetails, _, err := respondentImpl.GetRespondentDetails(ctx, questionnaireID, "", false, userTwo, nil, nil, 0, nil)
+	require.NoError(t, err)
+	if assertion.Len(respondentDetails, 1, "respondent details") {
+		assertion.Empty(respondentDetails[0].TraqID, "traqID is empty")
+		assertion.True(respondentDetails[0].IsRespondedBy(userTwo), "details responded by userTwo")
+		assertion.False(respondentDetails[0].IsRespondedBy(userOne), "details not responded by userOne")
+	}
+
+	// 別のアンケートでは同じユーザーでもハッシュが異なる
+	assertion.NotEqual(AnonymousRespondentKey(questionnaireID, userTwo), AnonymousRespondentKey(questionnaireID+1, userTwo), "key per questionnaire")
+}
+
+func TestV3_10AnonymousRespondentKey(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	// 3.10で書き込んだハッシュで回答者が自分の回答を見つけられるよう、現在の計算処理と一致している必要がある
+	assertion.Equal(AnonymousRespondentKey(1, "mazrean"), v3_10AnonymousRespondentKey(1, "mazrean"))
+	assertion.NotEqual(v3_10AnonymousRespondentKey(1, "mazrean"), v3_10AnonymousRespondentKey(2, "mazrean"))
+}
+
+func TestUpdateEnteredBy(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, false)
+	require.NoError(t, err)
+
+	responseID, err := respondentImpl.InsertRespondent(ctx, userTwo, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+
+	respondentDetail, err := respondentImpl.GetRespondentDetail(ctx, responseID)
+	require.NoError(t, err)
+	assertion.False(respondentDetail.EnteredBy.Valid, "entered by the respondent")
+
+	err = respondentImpl.UpdateEnteredBy(ctx, responseID, userOne)
+	require.NoError(t, err)
+
+	respondentDetail, err = respondentImpl.GetRespondentDetail(ctx, responseID)
+	require.NoError(t, err)
+	assertion.Equal(userTwo, respondentDetail.TraqID, "respondent is not changed")
+	assertion.Equal(null.StringFrom(userOne), respondentDetail.EnteredBy, "entered by")
+
+	respondentDetails, _, err := respondentImpl.GetRespondentDetails(ctx, questionnaireID, "", false, userOne, nil, nil, 0, nil)
+	require.NoError(t, err)
+	if assertion.Len(respondentDetails, 1) {
+		assertion.Equal(null.StringFrom(userOne), respondentDetails[0].EnteredBy, "entered by")
+	}
+}
+
+func TestUpdateReview(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, false)
+	require.NoError(t, err)
+
+	responseID, err := respondentImpl.InsertRespondent(ctx, userTwo, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+	otherResponseID, err := respondentImpl.InsertRespondent(ctx, userThree, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+
+	respondentDetail, err := respondentImpl.GetRespondentDetail(ctx, responseID)
+	require.NoError(t, err)
+	assertion.Equal(ReviewStatusPending, respondentDetail.ReviewStatus, "default review status")
+	assertion.False(respondentDetail.ReviewedAt.Valid, "not reviewed yet")
+
+	err = respondentImpl.UpdateReview(ctx, responseID, ReviewStatusNeedsChanges, null.StringFrom("回答を修正してください"), userOne)
+	require.NoError(t, err)
+
+	respondentDetail, err = respondentImpl.GetRespondentDetail(ctx, responseID)
+	require.NoError(t, err)
+	assertion.Equal(ReviewStatusNeedsChanges, respondentDetail.ReviewStatus, "review status")
+	assertion.Equal(null.StringFrom("回答を修正してください"), respondentDetail.ReviewComment, "review comment")
+	assertion.Equal(null.StringFrom(userOne), respondentDetail.ReviewedBy, "reviewed by")
+	assertion.True(respondentDetail.ReviewedAt.Valid, "reviewed at")
+
+	reviewStatus := ReviewStatusNeedsChanges
+	respondentDetails, _, err := respondentImpl.GetRespondentDetails(ctx, questionnaireID, "", false, userOne, nil, &reviewStatus, 0, nil)
+	require.NoError(t, err)
+	if assertion.Len(respondentDetails, 1) {
+		assertion.Equal(responseID, respondentDetails[0].ResponseID, "filtered by review status")
+	}
+
+	reviewStatus = ReviewStatusPending
+	respondentDetails, _, err = respondentImpl.GetRespondentDetails(ctx, questionnaireID, "", false, userOne, nil, &reviewStatus, 0, nil)
+	require.NoError(t, err)
+	if assertion.Len(respondentDetails, 1) {
+		assertion.Equal(otherResponseID, respondentDetails[0].ResponseID, "filtered by review status")
+	}
+
+	err = respondentImpl.UpdateReview(ctx, -1, ReviewStatusAccepted, null.String{}, userOne)
+	assertion.ErrorIs(err, ErrNoRecordUpdated, "response not found")
+}
+
+func TestUpdateScore(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, false)
+	require.NoError(t, err)
+
+	responseID, err := respondentImpl.InsertRespondent(ctx, userTwo, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+
+	respondentDetail, err := respondentImpl.GetRespondentDetail(ctx, responseID)
+	require.NoError(t, err)
+	assertion.False(respondentDetail.Score.Valid, "not scored yet")
+
+	err = respondentImpl.UpdateScore(ctx, responseID, null.IntFrom(3))
+	require.NoError(t, err)
+
+	respondentDetail, err = respondentImpl.GetRespondentDetail(ctx, responseID)
+	require.NoError(t, err)
+	assertion.Equal(null.IntFrom(3), respondentDetail.Score, "score")
+
+	err = respondentImpl.UpdateScore(ctx, responseID, null.Int{})
+	require.NoError(t, err)
+
+	respondentDetail, err = respondentImpl.GetRespondentDetail(ctx, responseID)
+	require.NoError(t, err)
+	assertion.False(respondentDetail.Score.Valid, "score cleared")
+}
+
+func TestAnonymizeRespondents(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
+	require.NoError(t, err)
+
+	responseID, err := respondentImpl.InsertRespondent(ctx, userTwo, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+	deletedResponseID, err := respondentImpl.InsertRespondent(ctx, userThree, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+	err = respondentImpl.DeleteRespondent(ctx, deletedResponseID)
+	require.NoError(t, err)
+
+	err = respondentImpl.AnonymizeRespondents(ctx, questionnaireID)
+	assertion.NoError(err, "anonymize respondents")
+
+	respondents := []Respondents{}
+	err = db.
+		Session(&gorm.Session{NewDB: true}).
+		Unscoped().
+		Where("questionnaire_id = ?", questionnaireID).
+		Order("response_id").
+		Find(&respondents).Error
+	require.NoError(t, err)
+
+	if assertion.Len(respondents, 2, "respondents") {
+		assertion.Equal(responseID, respondents[0].ResponseID, "responseID")
+		assertion.Empty(respondents[0].UserTraqid, "userID is removed")
+		assertion.True(respondents[0].IsRespondedBy(userTwo), "responded by userTwo")
+		assertion.Empty(respondents[1].UserTraqid, "userID of deleted response is removed")
+		assertion.True(respondents[1].IsRespondedBy(userThree), "responded by userThree")
+	}
+}
+
 func TestUpdateSubmittedAt(t *testing.T) {
 	t.Parallel()
 
@@ -132,7 +337,7 @@ func TestUpdateSubmittedAt(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -206,7 +411,7 @@ func TestUpdateModifiedAt(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -278,7 +483,7 @@ func TestDeleteRespondent(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -606,7 +811,7 @@ func TestGetRespondentDetail(t *testing.T) {
 		Find(&questionnaire).Error
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -703,7 +908,7 @@ func TestGetRespondentDetails(t *testing.T) {
 		Find(&questionnaire).Error
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -1105,7 +1310,7 @@ func TestGetRespondentDetails(t *testing.T) {
 	}
 
 	for _, testCase := range testCases {
-		respondentDetails, err := respondentImpl.GetRespondentDetails(ctx, testCase.args.questionnaireID, testCase.args.sort, testCase.args.onlyMyResponse, testCase.args.userID, nil)
+		respondentDetails, _, err := respondentImpl.GetRespondentDetails(ctx, testCase.args.questionnaireID, testCase.args.sort, testCase.args.onlyMyResponse, testCase.args.userID, nil, nil, 0, nil)
 		if !testCase.expect.isErr {
 			assertion.NoError(err, testCase.description, "no error")
 		} else if testCase.expect.err != nil {
@@ -1336,7 +1541,7 @@ func TestTestCheckRespondent(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	_, err = respondentImpl.InsertRespondent(ctx, userTwo, questionnaireID, null.NewTime(time.Now(), true))
@@ -1407,3 +1612,75 @@ func TestTestCheckRespondent(t *testing.T) {
 		assertion.Equal(testCase.expect.isRespondent, isRespondent, testCase.description, "isRespondent")
 	}
 }
+
+func TestGetRespondentCounts(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "private", true, false, true)
+	require.NoError(t, err)
+
+	_, err = respondentImpl.InsertRespondent(ctx, userOne, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+	_, err = respondentImpl.InsertRespondent(ctx, userOne, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+	_, err = respondentImpl.InsertRespondent(ctx, userTwo, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+	// 一時保存の回答は数えない
+	_, err = respondentImpl.InsertRespondent(ctx, userThree, questionnaireID, null.NewTime(time.Now(), false))
+	require.NoError(t, err)
+	// 削除された回答は数えない
+	deletedResponseID, err := respondentImpl.InsertRespondent(ctx, userThree, questionnaireID, null.NewTime(time.Now(), true))
+	require.NoError(t, err)
+	err = respondentImpl.DeleteRespondent(ctx, deletedResponseID)
+	require.NoError(t, err)
+
+	type args struct {
+		questionnaireID int
+	}
+	type expect struct {
+		respondentCount int
+		responseCount   int
+	}
+
+	type test struct {
+		description string
+		args
+		expect
+	}
+
+	testCases := []test{
+		{
+			description: "valid",
+			args: args{
+				questionnaireID: questionnaireID,
+			},
+			expect: expect{
+				respondentCount: 2,
+				responseCount:   3,
+			},
+		},
+		{
+			description: "questionnaireID does not exist",
+			args: args{
+				questionnaireID: -1,
+			},
+			expect: expect{
+				respondentCount: 0,
+				responseCount:   0,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		respondentCount, responseCount, err := respondentImpl.GetRespondentCounts(ctx, testCase.args.questionnaireID)
+		if !assertion.NoError(err, testCase.description, "no error") {
+			continue
+		}
+
+		assertion.Equal(testCase.expect.respondentCount, respondentCount, testCase.description, "respondentCount")
+		assertion.Equal(testCase.expect.responseCount, responseCount, testCase.description, "responseCount")
+	}
+}
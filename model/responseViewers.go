@@ -0,0 +1,16 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package model
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// IResponseViewer ResponseViewerのRepository
+type IResponseViewer interface {
+	InsertResponseViewers(ctx context.Context, questionnaireID int, users []string, groupIDs []uuid.UUID, allUsers []string) error
+	GetResponseViewers(ctx context.Context, questionnaireID int) ([]string, []uuid.UUID, error)
+	DeleteResponseViewers(ctx context.Context, questionnaireID int) error
+}
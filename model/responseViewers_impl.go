@@ -0,0 +1,150 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ResponseViewer ResponseViewerRepositoryの実装
+type ResponseViewer struct{}
+
+// NewResponseViewer ResponseViewerのコンストラクター
+func NewResponseViewer() *ResponseViewer {
+	return new(ResponseViewer)
+}
+
+// ResponseViewers response_viewersテーブルの構造体
+// グループを展開した、結果を閲覧できるユーザー
+type ResponseViewers struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	UserTraqid      string `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+}
+
+// ResponseViewerUsers response_viewer_usersテーブルの構造体
+// 結果を閲覧できるユーザーとして指定されたユーザー
+type ResponseViewerUsers struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	UserTraqid      string `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+}
+
+// ResponseViewerGroups response_viewer_groupsテーブルの構造体
+// 結果を閲覧できるグループとして指定されたグループ
+type ResponseViewerGroups struct {
+	QuestionnaireID int       `gorm:"type:int(11);not null;primaryKey"`
+	GroupID         uuid.UUID `gorm:"type:char(36);size:36;not null;primaryKey"`
+}
+
+// InsertResponseViewers 結果を閲覧できるユーザー・グループを追加
+// allUsersはグループを展開したユーザーの一覧
+func (*ResponseViewer) InsertResponseViewers(ctx context.Context, questionnaireID int, users []string, groupIDs []uuid.UUID, allUsers []string) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if len(allUsers) > 0 {
+		responseViewers := make([]ResponseViewers, 0, len(allUsers))
+		for _, user := range allUsers {
+			responseViewers = append(responseViewers, ResponseViewers{
+				QuestionnaireID: questionnaireID,
+				UserTraqid:      user,
+			})
+		}
+		err = db.Create(&responseViewers).Error
+		if err != nil {
+			return fmt.Errorf("failed to insert response viewers: %w", err)
+		}
+	}
+
+	if len(users) > 0 {
+		responseViewerUsers := make([]ResponseViewerUsers, 0, len(users))
+		for _, user := range users {
+			responseViewerUsers = append(responseViewerUsers, ResponseViewerUsers{
+				QuestionnaireID: questionnaireID,
+				UserTraqid:      user,
+			})
+		}
+		err = db.Create(&responseViewerUsers).Error
+		if err != nil {
+			return fmt.Errorf("failed to insert response viewer users: %w", err)
+		}
+	}
+
+	if len(groupIDs) > 0 {
+		responseViewerGroups := make([]ResponseViewerGroups, 0, len(groupIDs))
+		for _, groupID := range groupIDs {
+			responseViewerGroups = append(responseViewerGroups, ResponseViewerGroups{
+				QuestionnaireID: questionnaireID,
+				GroupID:         groupID,
+			})
+		}
+		err = db.Create(&responseViewerGroups).Error
+		if err != nil {
+			return fmt.Errorf("failed to insert response viewer groups: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetResponseViewers 結果を閲覧できるユーザー・グループとして指定されたものを取得
+func (*ResponseViewer) GetResponseViewers(ctx context.Context, questionnaireID int) ([]string, []uuid.UUID, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	users := []string{}
+	err = db.
+		Model(&ResponseViewerUsers{}).
+		Where("questionnaire_id = ?", questionnaireID).
+		Pluck("user_traqid", &users).Error
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get response viewer users: %w", err)
+	}
+
+	responseViewerGroups := []ResponseViewerGroups{}
+	err = db.
+		Where("questionnaire_id = ?", questionnaireID).
+		Find(&responseViewerGroups).Error
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get response viewer groups: %w", err)
+	}
+	groupIDs := make([]uuid.UUID, 0, len(responseViewerGroups))
+	for _, responseViewerGroup := range responseViewerGroups {
+		groupIDs = append(groupIDs, responseViewerGroup.GroupID)
+	}
+
+	return users, groupIDs, nil
+}
+
+// DeleteResponseViewers 結果を閲覧できるユーザー・グループを削除
+func (*ResponseViewer) DeleteResponseViewers(ctx context.Context, questionnaireID int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	err = db.
+		Where("questionnaire_id = ?", questionnaireID).
+		Delete(&ResponseViewers{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete response viewers: %w", err)
+	}
+	err = db.
+		Where("questionnaire_id = ?", questionnaireID).
+		Delete(&ResponseViewerUsers{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete response viewer users: %w", err)
+	}
+	err = db.
+		Where("questionnaire_id = ?", questionnaireID).
+		Delete(&ResponseViewerGroups{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete response viewer groups: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestResponseViewers(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+	questionnaireImpl := NewQuestionnaire()
+	responseViewerImpl := NewResponseViewer()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "responseViewersTestQuestionnaire", "response viewers test", null.Time{}, "viewers", true, false, false)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+
+	groupID := uuid.New()
+	err = responseViewerImpl.InsertResponseViewers(ctx, questionnaireID, []string{userOne}, []uuid.UUID{groupID}, []string{userOne, userTwo})
+	if !assertion.NoError(err) {
+		return
+	}
+
+	users, groupIDs, err := responseViewerImpl.GetResponseViewers(ctx, questionnaireID)
+	if assertion.NoError(err) {
+		assertion.Equal([]string{userOne}, users)
+		assertion.Equal([]uuid.UUID{groupID}, groupIDs)
+	}
+
+	info, err := questionnaireImpl.GetResponseReadPrivilegeInfoByQuestionnaireID(ctx, userTwo, questionnaireID)
+	if assertion.NoError(err) {
+		assertion.True(info.IsResponseViewer, "user in group is rolled out")
+	}
+
+	err = responseViewerImpl.DeleteResponseViewers(ctx, questionnaireID)
+	assertion.NoError(err)
+
+	users, groupIDs, err = responseViewerImpl.GetResponseViewers(ctx, questionnaireID)
+	if assertion.NoError(err) {
+		assertion.Empty(users)
+		assertion.Empty(groupIDs)
+	}
+
+	info, err = questionnaireImpl.GetResponseReadPrivilegeInfoByQuestionnaireID(ctx, userTwo, questionnaireID)
+	if assertion.NoError(err) {
+		assertion.False(info.IsResponseViewer)
+	}
+}
+
+func TestUpdateQuestionnaireResponseVisibility(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+	questionnaireImpl := NewQuestionnaire()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "responseVisibilityTestQuestionnaire", "response visibility test", null.Time{}, "public", true, false, false)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+
+	err = questionnaireImpl.UpdateQuestionnaireResponseVisibility(ctx, questionnaireID, true, true)
+	if !assertion.NoError(err) {
+		return
+	}
+
+	info, err := questionnaireImpl.GetResponseReadPrivilegeInfoByQuestionnaireID(ctx, userOne, questionnaireID)
+	if assertion.NoError(err) {
+		assertion.True(info.IsResponseHiddenUntilDue)
+		assertion.True(info.IsResponseAggregateOnly)
+	}
+}
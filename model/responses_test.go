@@ -22,7 +22,7 @@ func TestInsertResponses(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	questionID, err := questionImpl.InsertQuestion(ctx, questionnaireID, 1, 1, "Text", "質問文", "", true)
@@ -145,7 +145,7 @@ func TestDeleteResponse(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	questionID, err := questionImpl.InsertQuestion(ctx, questionnaireID, 1, 1, "Text", "質問文", "", true)
@@ -23,7 +23,7 @@ func TestInsertScaleLabel(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -166,7 +166,7 @@ func TestUpdateScaleLabel(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -286,7 +286,7 @@ func TestDeleteScaleLabel(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -374,7 +374,7 @@ func TestGetScaleLabels(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -492,7 +492,7 @@ func TestCheckScaleLabel(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	questionID, err := questionImpl.InsertQuestion(ctx, questionnaireID, 1, 1, "LinearScale", "Linear", "", true)
@@ -0,0 +1,11 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package model
+
+import "context"
+
+// ISearchIndex アンケートの全文検索用インデックスのRepository
+type ISearchIndex interface {
+	UpdateSearchIndex(ctx context.Context, questionnaireID int) error
+	DeleteSearchIndex(ctx context.Context, questionnaireID int) error
+}
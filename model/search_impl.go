@@ -0,0 +1,190 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"gorm.io/gorm"
+)
+
+// SearchIndex SearchIndexRepositoryの実装
+type SearchIndex struct{}
+
+// NewSearchIndex SearchIndexのコンストラクター
+func NewSearchIndex() *SearchIndex {
+	return new(SearchIndex)
+}
+
+// QuestionnaireSearchTokens questionnaire_search_tokensテーブルの構造体
+// タイトル・説明・質問文をn-gram(1文字と2文字)に分割したものを保持する
+type QuestionnaireSearchTokens struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	Token           string `gorm:"type:varbinary(16);size:16;not null;primaryKey;index"`
+	Weight          int    `gorm:"type:int(11);not null;default:0"`
+}
+
+const (
+	// titleTokenWeight タイトルに含まれるトークンの重み
+	titleTokenWeight = 2
+	// bodyTokenWeight 説明・質問文に含まれるトークンの重み
+	bodyTokenWeight = 1
+	// maxKeywordTokens 検索キーワードから生成するトークンの最大数
+	maxKeywordTokens = 32
+)
+
+// UpdateSearchIndex アンケートの検索インデックスを作り直す
+func (*SearchIndex) UpdateSearchIndex(ctx context.Context, questionnaireID int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	return updateSearchIndex(db, questionnaireID)
+}
+
+// DeleteSearchIndex アンケートの検索インデックスを削除
+func (*SearchIndex) DeleteSearchIndex(ctx context.Context, questionnaireID int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = db.
+		Where("questionnaire_id = ?", questionnaireID).
+		Delete(&QuestionnaireSearchTokens{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete search tokens: %w", err)
+	}
+
+	return nil
+}
+
+func updateSearchIndex(db *gorm.DB, questionnaireID int) error {
+	var questionnaire Questionnaires
+	err := db.
+		Session(&gorm.Session{NewDB: true}).
+		Select("id", "title", "description").
+		Where("id = ?", questionnaireID).
+		Take(&questionnaire).Error
+	if err != nil {
+		return fmt.Errorf("failed to get questionnaire: %w", err)
+	}
+
+	var bodies []string
+	err = db.
+		Session(&gorm.Session{NewDB: true}).
+		Model(&Questions{}).
+		Where("questionnaire_id = ?", questionnaireID).
+		Pluck("body", &bodies).Error
+	if err != nil {
+		return fmt.Errorf("failed to get question bodies: %w", err)
+	}
+
+	weights := map[string]int{}
+	for _, token := range searchTokens(questionnaire.Title) {
+		weights[token] += titleTokenWeight
+	}
+	for _, text := range append([]string{questionnaire.Description}, bodies...) {
+		for _, token := range searchTokens(text) {
+			weights[token] += bodyTokenWeight
+		}
+	}
+
+	err = db.
+		Session(&gorm.Session{NewDB: true}).
+		Where("questionnaire_id = ?", questionnaireID).
+		Delete(&QuestionnaireSearchTokens{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete search tokens: %w", err)
+	}
+
+	if len(weights) == 0 {
+		return nil
+	}
+
+	tokens := make([]QuestionnaireSearchTokens, 0, len(weights))
+	for token, weight := range weights {
+		tokens = append(tokens, QuestionnaireSearchTokens{
+			QuestionnaireID: questionnaireID,
+			Token:           token,
+			Weight:          weight,
+		})
+	}
+
+	err = db.
+		Session(&gorm.Session{NewDB: true}).
+		CreateInBatches(tokens, 500).Error
+	if err != nil {
+		return fmt.Errorf("failed to insert search tokens: %w", err)
+	}
+
+	return nil
+}
+
+// splitSearchText 正規化した上で文字・数字の連続ごとに分割する
+func splitSearchText(text string) [][]rune {
+	text = strings.ToLower(norm.NFKC.String(text))
+
+	runs := [][]rune{}
+	run := []rune{}
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) {
+			run = append(run, r)
+			continue
+		}
+		if len(run) != 0 {
+			runs = append(runs, run)
+			run = []rune{}
+		}
+	}
+	if len(run) != 0 {
+		runs = append(runs, run)
+	}
+
+	return runs
+}
+
+// searchTokens インデックスに登録するトークン(1-gramと2-gram)の一覧
+// 出現回数だけ重複して返す
+func searchTokens(text string) []string {
+	tokens := []string{}
+	for _, run := range splitSearchText(text) {
+		for i := range run {
+			tokens = append(tokens, string(run[i]))
+			if i+1 < len(run) {
+				tokens = append(tokens, string(run[i:i+2]))
+			}
+		}
+	}
+
+	return tokens
+}
+
+// keywordTokens 検索キーワードから検索に用いるトークンの一覧
+// 1文字の語は1-gram、2文字以上の語は2-gramで検索する
+func keywordTokens(keyword string) []string {
+	tokens := []string{}
+	tokenSet := map[string]struct{}{}
+	add := func(token string) {
+		if _, ok := tokenSet[token]; ok || len(tokens) >= maxKeywordTokens {
+			return
+		}
+		tokenSet[token] = struct{}{}
+		tokens = append(tokens, token)
+	}
+
+	for _, run := range splitSearchText(keyword) {
+		if len(run) == 1 {
+			add(string(run))
+			continue
+		}
+		for i := 0; i+1 < len(run); i++ {
+			add(string(run[i : i+2]))
+		}
+	}
+
+	return tokens
+}
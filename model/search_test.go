@@ -0,0 +1,145 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestKeywordTokens(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	testCases := []struct {
+		description string
+		keyword     string
+		expect      []string
+	}{
+		{
+			description: "empty",
+			keyword:     "",
+			expect:      []string{},
+		},
+		{
+			description: "japanese",
+			keyword:     "合宿費",
+			expect:      []string{"合宿", "宿費"},
+		},
+		{
+			description: "single character",
+			keyword:     "春",
+			expect:      []string{"春"},
+		},
+		{
+			description: "normalized",
+			keyword:     "ＡＢＣ",
+			expect:      []string{"ab", "bc"},
+		},
+		{
+			description: "multiple words",
+			keyword:     "新歓 2026",
+			expect:      []string{"新歓", "20", "02", "26"},
+		},
+		{
+			description: "duplicated",
+			keyword:     "ああああ",
+			expect:      []string{"ああ"},
+		},
+		{
+			description: "symbols only",
+			keyword:     "!?☆",
+			expect:      []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		assertion.Equal(testCase.expect, keywordTokens(testCase.keyword), testCase.description)
+	}
+}
+
+func TestV3_2SearchTokens(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	testCases := []struct {
+		description string
+		text        string
+		expect      []string
+	}{
+		{
+			description: "empty",
+			text:        "",
+			expect:      []string{},
+		},
+		{
+			description: "japanese",
+			text:        "合宿費",
+			expect:      []string{"合", "合宿", "宿", "宿費", "費"},
+		},
+		{
+			description: "normalized",
+			text:        "ＡＢ",
+			expect:      []string{"a", "ab", "b"},
+		},
+		{
+			description: "multiple words",
+			text:        "春 春",
+			expect:      []string{"春", "春"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		assertion.Equal(testCase.expect, v3_2SearchTokens(testCase.text), testCase.description)
+	}
+}
+
+func TestSearchIndex(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+	searchIndex := NewSearchIndex()
+
+	titleMatchID, err := questionnaireImpl.InsertQuestionnaire(ctx, "夏合宿検索テスト参加登録", "検索テスト用のアンケート", null.Time{}, "public", true, false, false)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+	bodyMatchID, err := questionnaireImpl.InsertQuestionnaire(ctx, "検索テスト参加登録", "検索テスト用のアンケート", null.Time{}, "public", true, false, false)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+	_, err = questionImpl.InsertQuestion(ctx, bodyMatchID, 1, 1, "Text", "夏合宿に参加しますか", "", true)
+	if err != nil {
+		t.Fatalf("failed to insert question: %v", err)
+	}
+	notMatchID, err := questionnaireImpl.InsertQuestionnaire(ctx, "冬合宿検索テスト参加登録", "検索テスト用のアンケート", null.Time{}, "public", true, false, false)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+
+	for _, questionnaireID := range []int{titleMatchID, bodyMatchID, notMatchID} {
+		err = searchIndex.UpdateSearchIndex(ctx, questionnaireID)
+		if err != nil {
+			t.Fatalf("failed to update search index: %v", err)
+		}
+	}
+
+	questionnaires, totalRecords, _, _, err := questionnaireImpl.GetQuestionnaires(ctx, questionnairesTestUserID, "relevance", "", "夏合宿", nil, 1, 20, nil, false, false, false, nil, nil, nil, false)
+	assertion.NoError(err)
+	assertion.Equal(2, totalRecords)
+	if assertion.Len(questionnaires, 2) {
+		assertion.Equal(titleMatchID, questionnaires[0].ID, "title match first")
+		assertion.Equal(bodyMatchID, questionnaires[1].ID, "body match second")
+	}
+
+	err = searchIndex.DeleteSearchIndex(ctx, titleMatchID)
+	assertion.NoError(err)
+
+	questionnaires, _, _, _, err = questionnaireImpl.GetQuestionnaires(ctx, questionnairesTestUserID, "relevance", "", "夏合宿", nil, 1, 20, nil, false, false, false, nil, nil, nil, false)
+	assertion.NoError(err)
+	if assertion.Len(questionnaires, 1) {
+		assertion.Equal(bodyMatchID, questionnaires[0].ID)
+	}
+}
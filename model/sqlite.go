@@ -0,0 +1,175 @@
+package model
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+// sqliteDriverName MySQLとの違いを吸収したSQLiteのドライバーの名前
+const sqliteDriverName = "sqlite3_anke_to"
+
+var registerSQLiteDriverOnce sync.Once
+
+// registerSQLiteDriver MySQLとの違いを吸収したSQLiteのドライバーを登録する
+func registerSQLiteDriver() {
+	registerSQLiteDriverOnce.Do(func() {
+		sql.Register(sqliteDriverName, &sqliteDriver{
+			SQLiteDriver: &sqlite3.SQLiteDriver{
+				ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+					return conn.RegisterFunc("regexp", sqliteRegexp, true)
+				},
+			},
+		})
+	})
+}
+
+// sqliteDriver 時刻をUTCで保存するSQLiteのドライバー
+type sqliteDriver struct {
+	*sqlite3.SQLiteDriver
+}
+
+func (d *sqliteDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.SQLiteDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqliteConn{SQLiteConn: conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+// sqliteConn 時刻をUTCで保存するSQLiteの接続
+// SQLiteは時刻を文字列で保存して比較するため、タイムゾーンを揃えないと正しく比較できない
+type sqliteConn struct {
+	*sqlite3.SQLiteConn
+}
+
+func (c *sqliteConn) CheckNamedValue(nv *driver.NamedValue) error {
+	v, err := driver.DefaultParameterConverter.ConvertValue(nv.Value)
+	if err != nil {
+		return err
+	}
+	if t, ok := v.(time.Time); ok {
+		v = t.UTC()
+	}
+	nv.Value = v
+
+	return nil
+}
+
+var sqliteRegexpCache sync.Map
+
+// sqliteRegexp SQLiteのREGEXP演算子の実装
+// MySQLのutf8mb4_general_ciに合わせて、大文字と小文字を区別しない
+func sqliteRegexp(pattern string, s string) (bool, error) {
+	if re, ok := sqliteRegexpCache.Load(pattern); ok {
+		return re.(*regexp.Regexp).MatchString(s), nil
+	}
+
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return false, fmt.Errorf("failed to compile regexp: %w", err)
+	}
+	sqliteRegexpCache.Store(pattern, re)
+
+	return re.MatchString(s), nil
+}
+
+// sqliteDSN SQLiteのファイルのパスからDSNを作る
+func sqliteDSN(path string) string {
+	query := url.Values{}
+	query.Set("_loc", "auto")
+	query.Set("_busy_timeout", "10000")
+	query.Set("_journal_mode", "WAL")
+	query.Set("_foreign_keys", "1")
+	// 読み込んだ後に書き込むトランザクション同士がデッドロックしないよう、最初に書き込みのロックを取る
+	query.Set("_txlock", "immediate")
+
+	return "file:" + path + "?" + query.Encode()
+}
+
+// newSQLiteDialector SQLiteのDialectorを作る
+func newSQLiteDialector(path string) gorm.Dialector {
+	registerSQLiteDriver()
+
+	return &sqliteDialector{
+		Dialector: sqlite.Dialector{
+			DriverName: sqliteDriverName,
+			DSN:        sqliteDSN(path),
+		},
+	}
+}
+
+// sqliteDialector MySQL向けのカラムの型をSQLiteの型にするDialector
+type sqliteDialector struct {
+	sqlite.Dialector
+}
+
+func (dialector *sqliteDialector) Migrator(db *gorm.DB) gorm.Migrator {
+	return sqlite.Migrator{
+		Migrator: migrator.Migrator{
+			Config: migrator.Config{
+				DB:                          db,
+				Dialector:                   dialector,
+				CreateIndexAfterCreateTable: true,
+			},
+		},
+	}
+}
+
+// sqliteStringTypeRegexp 長さを指定する文字列の型
+var sqliteStringTypeRegexp = regexp.MustCompile(`^(?:var)?char\((\d+)\)$`)
+
+// sqliteIntRanges MySQLの整数型の範囲
+var sqliteIntRanges = map[string][2]int64{
+	"int(11)":    {math.MinInt32, math.MaxInt32},
+	"tinyint(1)": {math.MinInt8, math.MaxInt8},
+	"tinyint(4)": {math.MinInt8, math.MaxInt8},
+}
+
+// DataTypeOf MySQL向けのカラムの型をSQLiteの型にする
+// SQLiteは型の長さや範囲を検査しないため、MySQLと同じ値を弾くようにCHECK制約を付ける
+// また、MySQLのutf8mb4_general_ciに合わせて、文字列は大文字と小文字を区別せずに比較する
+func (dialector *sqliteDialector) DataTypeOf(field *schema.Field) string {
+	dataType := strings.ToLower(string(field.DataType))
+	column := quoteColumn(dialector, field.DBName)
+
+	if strings.Contains(dataType, "auto_increment") {
+		// SQLiteではAUTOINCREMENTはINTEGER PRIMARY KEYの1カラムにしか付けられない
+		if field.PrimaryKey && len(field.Schema.PrimaryFields) == 1 {
+			return "integer PRIMARY KEY AUTOINCREMENT"
+		}
+		return "integer"
+	}
+	if strings.HasPrefix(dataType, "timestamp") {
+		// go-sqlite3は宣言された型がtimestampの場合のみ時刻として読み込む
+		return "timestamp"
+	}
+	if strings.HasPrefix(dataType, "varbinary(") {
+		// varbinaryのままでは数値として解釈できる値が数値に変換されてしまう
+		return "blob"
+	}
+	if matches := sqliteStringTypeRegexp.FindStringSubmatch(dataType); matches != nil {
+		return fmt.Sprintf("%s COLLATE NOCASE CHECK (length(%s) <= %s)", dataType, column, matches[1])
+	}
+	if maxBytes, ok := mysqlTextMaxBytes[dataType]; ok {
+		return fmt.Sprintf("%s COLLATE NOCASE CHECK (length(CAST(%s AS BLOB)) <= %d)", dataType, column, maxBytes)
+	}
+	if intRange, ok := sqliteIntRanges[dataType]; ok {
+		return fmt.Sprintf("%s CHECK (%s BETWEEN %d AND %d)", dataType, column, intRange[0], intRange[1])
+	}
+
+	return dialector.Dialector.DataTypeOf(field)
+}
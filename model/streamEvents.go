@@ -0,0 +1,16 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// IStreamEvent StreamEventのRepository
+type IStreamEvent interface {
+	InsertStreamEvent(ctx context.Context, topic string, payload string) (int, error)
+	GetStreamEventsAfter(ctx context.Context, lastID int, limit int) ([]StreamEvents, error)
+	GetLastStreamEventID(ctx context.Context) (int, error)
+	DeleteStreamEventsBefore(ctx context.Context, before time.Time) error
+}
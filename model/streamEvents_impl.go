@@ -0,0 +1,109 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// StreamEvent StreamEventRepositoryの実装
+type StreamEvent struct{}
+
+// NewStreamEvent StreamEventのコンストラクター
+func NewStreamEvent() *StreamEvent {
+	return new(StreamEvent)
+}
+
+// StreamEvents stream_eventsテーブルの構造体
+// 複数のインスタンスの間でリアルタイムに配信するイベント
+type StreamEvents struct {
+	ID        int       `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	Topic     string    `gorm:"type:varchar(64);size:64;not null"`
+	Payload   string    `gorm:"type:mediumtext;not null"`
+	CreatedAt time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP;index"`
+}
+
+// BeforeCreate insert時に自動でcreated_atを現在時刻に
+func (streamEvent *StreamEvents) BeforeCreate(_ *gorm.DB) error {
+	streamEvent.CreatedAt = time.Now()
+
+	return nil
+}
+
+// InsertStreamEvent イベントの追加
+func (*StreamEvent) InsertStreamEvent(ctx context.Context, topic string, payload string) (int, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	streamEvent := StreamEvents{
+		Topic:   topic,
+		Payload: payload,
+	}
+	err = db.Create(&streamEvent).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert stream event: %w", err)
+	}
+
+	return streamEvent.ID, nil
+}
+
+// GetStreamEventsAfter lastIDより後に追加されたイベントを古い順に取得
+func (*StreamEvent) GetStreamEventsAfter(ctx context.Context, lastID int, limit int) ([]StreamEvents, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	streamEvents := []StreamEvents{}
+	err = db.
+		Where("id > ?", lastID).
+		Order("id").
+		Limit(limit).
+		Find(&streamEvents).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream events: %w", err)
+	}
+
+	return streamEvents, nil
+}
+
+// GetLastStreamEventID 最後に追加されたイベントのIDを取得
+// イベントが1つもない場合は0を返す
+func (*StreamEvent) GetLastStreamEventID(ctx context.Context) (int, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	var lastID int
+	err = db.
+		Model(&StreamEvents{}).
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&lastID).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last stream event id: %w", err)
+	}
+
+	return lastID, nil
+}
+
+// DeleteStreamEventsBefore beforeより前に追加されたイベントの削除
+func (*StreamEvent) DeleteStreamEventsBefore(ctx context.Context, before time.Time) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = db.
+		Where("created_at < ?", before).
+		Delete(&StreamEvents{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete stream events: %w", err)
+	}
+
+	return nil
+}
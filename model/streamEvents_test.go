@@ -0,0 +1,57 @@
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamEvents(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+	streamEventImpl := NewStreamEvent()
+
+	lastID, err := streamEventImpl.GetLastStreamEventID(ctx)
+	if !assertion.NoError(err) {
+		return
+	}
+
+	firstID, err := streamEventImpl.InsertStreamEvent(ctx, "questionnaires/1/responses", `{"event":"response.submitted"}`)
+	if !assertion.NoError(err) {
+		return
+	}
+	secondID, err := streamEventImpl.InsertStreamEvent(ctx, "questionnaires/2/responses", `{"event":"response.deleted"}`)
+	if !assertion.NoError(err) {
+		return
+	}
+	assertion.Greater(firstID, lastID)
+	assertion.Greater(secondID, firstID)
+
+	currentLastID, err := streamEventImpl.GetLastStreamEventID(ctx)
+	if assertion.NoError(err) {
+		assertion.Equal(secondID, currentLastID)
+	}
+
+	streamEvents, err := streamEventImpl.GetStreamEventsAfter(ctx, lastID, 10)
+	if assertion.NoError(err) && assertion.Len(streamEvents, 2) {
+		assertion.Equal(firstID, streamEvents[0].ID)
+		assertion.Equal("questionnaires/1/responses", streamEvents[0].Topic)
+		assertion.Equal(`{"event":"response.submitted"}`, streamEvents[0].Payload)
+		assertion.Equal(secondID, streamEvents[1].ID)
+	}
+
+	streamEvents, err = streamEventImpl.GetStreamEventsAfter(ctx, lastID, 1)
+	if assertion.NoError(err) && assertion.Len(streamEvents, 1) {
+		assertion.Equal(firstID, streamEvents[0].ID)
+	}
+
+	err = streamEventImpl.DeleteStreamEventsBefore(ctx, time.Now().Add(time.Minute))
+	assertion.NoError(err)
+
+	streamEvents, err = streamEventImpl.GetStreamEventsAfter(ctx, lastID, 10)
+	if assertion.NoError(err) {
+		assertion.Empty(streamEvents)
+	}
+}
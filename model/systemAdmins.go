@@ -0,0 +1,13 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package model
+
+import "context"
+
+// ISystemAdmin SystemAdminのRepository
+type ISystemAdmin interface {
+	InsertSystemAdmin(ctx context.Context, userID string, grantedBy string) error
+	DeleteSystemAdmin(ctx context.Context, userID string) error
+	GetSystemAdmins(ctx context.Context) ([]SystemAdmins, error)
+	CheckSystemAdmin(ctx context.Context, userID string) (bool, error)
+}
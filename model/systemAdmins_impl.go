@@ -0,0 +1,171 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SystemAdmin SystemAdminRepositoryの実装
+type SystemAdmin struct{}
+
+// NewSystemAdmin SystemAdminのコンストラクター
+func NewSystemAdmin() *SystemAdmin {
+	return new(SystemAdmin)
+}
+
+// SystemAdmins system_adminsテーブルの構造体
+type SystemAdmins struct {
+	UserTraqid string    `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+	GrantedBy  string    `gorm:"type:varchar(32);size:32;not null"`
+	CreatedAt  time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// BeforeCreate insert時に自動でcreated_atを現在時刻に
+func (systemAdmin *SystemAdmins) BeforeCreate(_ *gorm.DB) error {
+	systemAdmin.CreatedAt = time.Now()
+
+	return nil
+}
+
+// InsertSystemAdmin システム管理者の追加
+func (*SystemAdmin) InsertSystemAdmin(ctx context.Context, userID string, grantedBy string) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = db.
+		Where("user_traqid = ?", userID).
+		Select("user_traqid").
+		First(&SystemAdmins{}).Error
+	if err == nil {
+		return ErrDuplicatedSystemAdmin
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to check system admin: %w", err)
+	}
+
+	err = db.Create(&SystemAdmins{
+		UserTraqid: userID,
+		GrantedBy:  grantedBy,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to insert system admin: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSystemAdmin システム管理者の削除
+// 最後の1人は削除できない
+func (*SystemAdmin) DeleteSystemAdmin(ctx context.Context, userID string) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	var count int64
+	err = db.
+		Model(&SystemAdmins{}).
+		Where("user_traqid != ?", userID).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("failed to count system admins: %w", err)
+	}
+	if count == 0 {
+		return ErrLastSystemAdmin
+	}
+
+	result := db.
+		Where("user_traqid = ?", userID).
+		Delete(&SystemAdmins{})
+	err = result.Error
+	if err != nil {
+		return fmt.Errorf("failed to delete system admin: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNoRecordDeleted
+	}
+
+	return nil
+}
+
+// GetSystemAdmins システム管理者の一覧の取得
+func (*SystemAdmin) GetSystemAdmins(ctx context.Context) ([]SystemAdmins, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	systemAdmins := []SystemAdmins{}
+	err = db.
+		Order("created_at, user_traqid").
+		Find(&systemAdmins).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system admins: %w", err)
+	}
+
+	return systemAdmins, nil
+}
+
+// CheckSystemAdmin システム管理者か判定
+func (*SystemAdmin) CheckSystemAdmin(ctx context.Context, userID string) (bool, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = db.
+		Where("user_traqid = ?", userID).
+		Select("user_traqid").
+		First(&SystemAdmins{}).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get a system admin: %w", err)
+	}
+
+	return true, nil
+}
+
+// SetupSystemAdmins システム管理者が1人もいない場合に、初期のシステム管理者を追加する
+func SetupSystemAdmins(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	var count int64
+	err = db.
+		Model(&SystemAdmins{}).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("failed to count system admins: %w", err)
+	}
+	if count != 0 {
+		return nil
+	}
+
+	systemAdmins := make([]SystemAdmins, 0, len(userIDs))
+	for _, userID := range userIDs {
+		systemAdmins = append(systemAdmins, SystemAdmins{
+			UserTraqid: userID,
+			GrantedBy:  userID,
+		})
+	}
+	err = db.Create(&systemAdmins).Error
+	if err != nil {
+		return fmt.Errorf("failed to insert system admins: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemAdmins(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+	systemAdminImpl := NewSystemAdmin()
+
+	grantedUser := fmt.Sprintf("sysadmin%d", time.Now().UnixNano()%1000000000)
+	existingAdmins, err := systemAdminImpl.GetSystemAdmins(ctx)
+	if err != nil {
+		t.Fatalf("failed to get system admins: %v", err)
+	}
+
+	err = systemAdminImpl.InsertSystemAdmin(ctx, grantedUser, userOne)
+	assertion.NoError(err)
+	err = systemAdminImpl.InsertSystemAdmin(ctx, grantedUser, userOne)
+	if !errors.Is(err, ErrDuplicatedSystemAdmin) {
+		t.Errorf("invalid error(duplicated insert): expected: %+v, actual: %+v", ErrDuplicatedSystemAdmin, err)
+	}
+
+	isSystemAdmin, err := systemAdminImpl.CheckSystemAdmin(ctx, grantedUser)
+	if assertion.NoError(err) {
+		assertion.True(isSystemAdmin)
+	}
+	isSystemAdmin, err = systemAdminImpl.CheckSystemAdmin(ctx, userTwo)
+	if assertion.NoError(err) {
+		assertion.False(isSystemAdmin)
+	}
+
+	systemAdmins, err := systemAdminImpl.GetSystemAdmins(ctx)
+	if assertion.NoError(err) {
+		assertion.Len(systemAdmins, len(existingAdmins)+1)
+		for _, systemAdmin := range systemAdmins {
+			if systemAdmin.UserTraqid == grantedUser {
+				assertion.Equal(userOne, systemAdmin.GrantedBy)
+			}
+		}
+	}
+
+	err = systemAdminImpl.DeleteSystemAdmin(ctx, userTwo)
+	if !errors.Is(err, ErrNoRecordDeleted) {
+		t.Errorf("invalid error(not system admin): expected: %+v, actual: %+v", ErrNoRecordDeleted, err)
+	}
+
+	err = systemAdminImpl.DeleteSystemAdmin(ctx, grantedUser)
+	if len(existingAdmins) == 0 {
+		if !errors.Is(err, ErrLastSystemAdmin) {
+			t.Errorf("invalid error(last system admin): expected: %+v, actual: %+v", ErrLastSystemAdmin, err)
+		}
+	} else {
+		assertion.NoError(err)
+	}
+}
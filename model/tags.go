@@ -0,0 +1,16 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package model
+
+import "context"
+
+// ITag TagのRepository
+type ITag interface {
+	InsertTag(ctx context.Context, name string, createdBy string) (int, error)
+	UpdateTag(ctx context.Context, tagID int, name string) error
+	DeleteTag(ctx context.Context, tagID int) error
+	GetTag(ctx context.Context, tagID int) (*Tags, error)
+	GetTags(ctx context.Context) ([]TagCount, error)
+	GetQuestionnaireTags(ctx context.Context, questionnaireIDs []int) ([]QuestionnaireTag, error)
+	SetQuestionnaireTags(ctx context.Context, questionnaireID int, tagIDs []int) error
+}
@@ -0,0 +1,271 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Tag TagRepositoryの実装
+type Tag struct{}
+
+// NewTag Tagのコンストラクター
+func NewTag() *Tag {
+	return new(Tag)
+}
+
+// Tags tagsテーブルの構造体
+type Tags struct {
+	ID        int       `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	Name      string    `gorm:"type:varchar(64);size:64;not null;uniqueIndex"`
+	CreatedBy string    `gorm:"type:varchar(32);size:32;not null"`
+	CreatedAt time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+// QuestionnaireTags questionnaire_tagsテーブルの構造体
+type QuestionnaireTags struct {
+	QuestionnaireID int `gorm:"type:int(11);not null;primaryKey"`
+	TagID           int `gorm:"type:int(11);not null;primaryKey;index"`
+}
+
+// TagCount タグとそのタグが付いたアンケートの件数
+type TagCount struct {
+	Tags
+	Count int `gorm:"column:count"`
+}
+
+// QuestionnaireTag アンケートに付いているタグ
+type QuestionnaireTag struct {
+	QuestionnaireID int `gorm:"column:questionnaire_id"`
+	Tags
+}
+
+// BeforeCreate insert時に自動でcreated_atを現在時刻に
+func (tag *Tags) BeforeCreate(_ *gorm.DB) error {
+	tag.CreatedAt = time.Now()
+
+	return nil
+}
+
+// InsertTag タグの追加
+func (*Tag) InsertTag(ctx context.Context, name string, createdBy string) (int, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = checkTagNameNotUsed(db, name, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	tag := Tags{
+		Name:      name,
+		CreatedBy: createdBy,
+	}
+	err = db.Create(&tag).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert tag: %w", err)
+	}
+
+	return tag.ID, nil
+}
+
+// UpdateTag タグの名前の変更
+func (*Tag) UpdateTag(ctx context.Context, tagID int, name string) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = checkTagNameNotUsed(db, name, tagID)
+	if err != nil {
+		return err
+	}
+
+	result := db.
+		Model(&Tags{}).
+		Where("id = ?", tagID).
+		Update("name", name)
+	err = result.Error
+	if err != nil {
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to update tag: %w", ErrNoRecordUpdated)
+	}
+
+	return nil
+}
+
+// DeleteTag タグの削除
+// アンケートとの紐付けも削除される
+func (*Tag) DeleteTag(ctx context.Context, tagID int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	err = db.
+		Where("tag_id = ?", tagID).
+		Delete(&QuestionnaireTags{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete questionnaire tags: %w", err)
+	}
+
+	result := db.
+		Where("id = ?", tagID).
+		Delete(&Tags{})
+	err = result.Error
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to delete tag: %w", ErrNoRecordDeleted)
+	}
+
+	return nil
+}
+
+// GetTag タグの取得
+func (*Tag) GetTag(ctx context.Context, tagID int) (*Tags, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	var tag Tags
+	err = db.
+		Where("id = ?", tagID).
+		Take(&tag).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	return &tag, nil
+}
+
+// GetTags すべてのタグと、タグが付いた(削除されていない)アンケートの件数の取得
+func (*Tag) GetTags(ctx context.Context) ([]TagCount, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	tagCounts := []TagCount{}
+	err = db.
+		Table("tags").
+		Joins("LEFT OUTER JOIN questionnaire_tags ON tags.id = questionnaire_tags.tag_id").
+		Joins("LEFT OUTER JOIN questionnaires ON questionnaire_tags.questionnaire_id = questionnaires.id AND questionnaires.deleted_at IS NULL").
+		Select("tags.id, tags.name, tags.created_by, tags.created_at, COUNT(questionnaires.id) AS count").
+		Group("tags.id, tags.name, tags.created_by, tags.created_at").
+		Order("tags.name").
+		Find(&tagCounts).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	return tagCounts, nil
+}
+
+// GetQuestionnaireTags アンケートに付いているタグの取得
+func (*Tag) GetQuestionnaireTags(ctx context.Context, questionnaireIDs []int) ([]QuestionnaireTag, error) {
+	db, err := getTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	questionnaireTags := []QuestionnaireTag{}
+	if len(questionnaireIDs) == 0 {
+		return questionnaireTags, nil
+	}
+
+	err = db.
+		Table("questionnaire_tags").
+		Joins("INNER JOIN tags ON questionnaire_tags.tag_id = tags.id").
+		Where("questionnaire_tags.questionnaire_id IN (?)", questionnaireIDs).
+		Select("questionnaire_tags.questionnaire_id, tags.id, tags.name, tags.created_by, tags.created_at").
+		Order("tags.name").
+		Find(&questionnaireTags).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get questionnaire tags: %w", err)
+	}
+
+	return questionnaireTags, nil
+}
+
+// SetQuestionnaireTags アンケートに付けるタグの置き換え
+func (*Tag) SetQuestionnaireTags(ctx context.Context, questionnaireID int, tagIDs []int) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tx: %w", err)
+	}
+
+	tagIDSet := make(map[int]struct{}, len(tagIDs))
+	questionnaireTags := make([]QuestionnaireTags, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		if _, ok := tagIDSet[tagID]; ok {
+			continue
+		}
+		tagIDSet[tagID] = struct{}{}
+		questionnaireTags = append(questionnaireTags, QuestionnaireTags{
+			QuestionnaireID: questionnaireID,
+			TagID:           tagID,
+		})
+	}
+
+	if len(questionnaireTags) > 0 {
+		var count int64
+		err = db.
+			Model(&Tags{}).
+			Where("id IN (?)", tagIDs).
+			Count(&count).Error
+		if err != nil {
+			return fmt.Errorf("failed to count tags: %w", err)
+		}
+		if int(count) != len(questionnaireTags) {
+			return fmt.Errorf("failed to set questionnaire tags: %w", ErrTagNotFound)
+		}
+	}
+
+	err = db.
+		Where("questionnaire_id = ?", questionnaireID).
+		Delete(&QuestionnaireTags{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete questionnaire tags: %w", err)
+	}
+
+	if len(questionnaireTags) == 0 {
+		return nil
+	}
+
+	err = db.Create(&questionnaireTags).Error
+	if err != nil {
+		return fmt.Errorf("failed to insert questionnaire tags: %w", err)
+	}
+
+	return nil
+}
+
+func checkTagNameNotUsed(db *gorm.DB, name string, tagID int) error {
+	var count int64
+	err := db.
+		Session(&gorm.Session{NewDB: true}).
+		Model(&Tags{}).
+		Where("name = ? AND id <> ?", name, tagID).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("failed to count tags: %w", err)
+	}
+	if count != 0 {
+		return ErrDuplicatedTagName
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestTags(t *testing.T) {
+	assertion := assert.New(t)
+	ctx := context.Background()
+	tagImpl := NewTag()
+
+	campTagID, err := tagImpl.InsertTag(ctx, "tagsTest合宿", userOne)
+	if err != nil {
+		t.Fatalf("failed to insert tag: %v", err)
+	}
+	accountingTagID, err := tagImpl.InsertTag(ctx, "tagsTest会計", userTwo)
+	if err != nil {
+		t.Fatalf("failed to insert tag: %v", err)
+	}
+
+	_, err = tagImpl.InsertTag(ctx, "tagsTest合宿", userTwo)
+	if !errors.Is(err, ErrDuplicatedTagName) {
+		t.Errorf("invalid error(duplicated insert): expected: %+v, actual: %+v", ErrDuplicatedTagName, err)
+	}
+	err = tagImpl.UpdateTag(ctx, accountingTagID, "tagsTest合宿")
+	if !errors.Is(err, ErrDuplicatedTagName) {
+		t.Errorf("invalid error(duplicated update): expected: %+v, actual: %+v", ErrDuplicatedTagName, err)
+	}
+	err = tagImpl.UpdateTag(ctx, accountingTagID, "tagsTest会計2026")
+	assertion.NoError(err)
+
+	tag, err := tagImpl.GetTag(ctx, accountingTagID)
+	if assertion.NoError(err) {
+		assertion.Equal("tagsTest会計2026", tag.Name)
+		assertion.Equal(userTwo, tag.CreatedBy)
+	}
+
+	bothID, err := questionnaireImpl.InsertQuestionnaire(ctx, "tagsTestQuestionnaire", "tags test", null.Time{}, "public", true, false, false)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+	campOnlyID, err := questionnaireImpl.InsertQuestionnaire(ctx, "tagsTestQuestionnaire", "tags test", null.Time{}, "public", true, false, false)
+	if err != nil {
+		t.Fatalf("failed to insert questionnaire: %v", err)
+	}
+
+	err = tagImpl.SetQuestionnaireTags(ctx, bothID, []int{campTagID, accountingTagID, campTagID})
+	assertion.NoError(err)
+	err = tagImpl.SetQuestionnaireTags(ctx, campOnlyID, []int{campTagID})
+	assertion.NoError(err)
+	err = tagImpl.SetQuestionnaireTags(ctx, campOnlyID, []int{campTagID, -1})
+	if !errors.Is(err, ErrTagNotFound) {
+		t.Errorf("invalid error(tag not found): expected: %+v, actual: %+v", ErrTagNotFound, err)
+	}
+
+	questionnaireTags, err := tagImpl.GetQuestionnaireTags(ctx, []int{bothID, campOnlyID})
+	if assertion.NoError(err) {
+		assertion.Len(questionnaireTags, 3)
+	}
+
+	questionnaires, totalRecords, _, _, err := questionnaireImpl.GetQuestionnaires(ctx, questionnairesTestUserID, "", "^tagsTestQuestionnaire$", "", []int{campTagID, accountingTagID}, 1, 20, nil, false, false, false, nil, nil, nil, false)
+	if assertion.NoError(err) {
+		assertion.Equal(1, totalRecords)
+		if assertion.Len(questionnaires, 1) {
+			assertion.Equal(bothID, questionnaires[0].ID)
+		}
+	}
+
+	tagCounts, err := questionnaireImpl.GetQuestionnaireTagCounts(ctx, questionnairesTestUserID, "^tagsTestQuestionnaire$", "", nil, false, false, false, nil, nil, nil)
+	if assertion.NoError(err) {
+		counts := map[int]int{}
+		for _, tagCount := range tagCounts {
+			counts[tagCount.ID] = tagCount.Count
+		}
+		assertion.Equal(map[int]int{campTagID: 2, accountingTagID: 1}, counts)
+	}
+
+	err = tagImpl.DeleteTag(ctx, accountingTagID)
+	assertion.NoError(err)
+	_, err = tagImpl.GetTag(ctx, accountingTagID)
+	if !errors.Is(err, ErrRecordNotFound) {
+		t.Errorf("invalid error(deleted tag): expected: %+v, actual: %+v", ErrRecordNotFound, err)
+	}
+	questionnaireTags, err = tagImpl.GetQuestionnaireTags(ctx, []int{bothID})
+	if assertion.NoError(err) && assertion.Len(questionnaireTags, 1) {
+		assertion.Equal(campTagID, questionnaireTags[0].ID)
+	}
+
+	tags, err := tagImpl.GetTags(ctx)
+	if assertion.NoError(err) {
+		for _, tag := range tags {
+			if tag.ID == campTagID {
+				assertion.Equal(2, tag.Count)
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/traPtitech/anke-to/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// tracingSpanKey スパンとスパンを開始する前のcontextをgorm.DBに保存するキー
+const tracingSpanKey = "anke-to:tracing_span"
+
+// tracingSpan クエリのスパンとスパンを開始する前のcontext
+type tracingSpan struct {
+	span   trace.Span
+	parent context.Context
+}
+
+// tracingPlugin クエリごとにOpenTelemetryのスパンを作るgormのプラグイン
+type tracingPlugin struct{}
+
+func (*tracingPlugin) Name() string {
+	return "anke-to:tracing"
+}
+
+func (p *tracingPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	err := errors.Join(
+		callback.Create().Before("gorm:create").Register("anke-to:tracing_before_create", p.before("create")),
+		callback.Create().After("gorm:create").Register("anke-to:tracing_after_create", p.after),
+		callback.Query().Before("gorm:query").Register("anke-to:tracing_before_query", p.before("query")),
+		callback.Query().After("gorm:query").Register("anke-to:tracing_after_query", p.after),
+		callback.Update().Before("gorm:update").Register("anke-to:tracing_before_update", p.before("update")),
+		callback.Update().After("gorm:update").Register("anke-to:tracing_after_update", p.after),
+		callback.Delete().Before("gorm:delete").Register("anke-to:tracing_before_delete", p.before("delete")),
+		callback.Delete().After("gorm:delete").Register("anke-to:tracing_after_delete", p.after),
+		callback.Row().Before("gorm:row").Register("anke-to:tracing_before_row", p.before("row")),
+		callback.Row().After("gorm:row").Register("anke-to:tracing_after_row", p.after),
+		callback.Raw().Before("gorm:raw").Register("anke-to:tracing_before_raw", p.before("raw")),
+		callback.Raw().After("gorm:raw").Register("anke-to:tracing_after_raw", p.after),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register tracing callbacks: %w", err)
+	}
+
+	return nil
+}
+
+func (*tracingPlugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement.Context == nil {
+			return
+		}
+
+		parent := db.Statement.Context
+		ctx, span := tracing.Start(parent, "gorm."+operation, trace.WithSpanKind(trace.SpanKindClient))
+		span.SetAttributes(
+			attribute.String("db.system.name", db.Dialector.Name()),
+			attribute.String("db.operation.name", operation),
+		)
+		db.Statement.Context = ctx
+		db.InstanceSet(tracingSpanKey, tracingSpan{span: span, parent: parent})
+	}
+}
+
+func (*tracingPlugin) after(db *gorm.DB) {
+	v, ok := db.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+	s, ok := v.(tracingSpan)
+	if !ok {
+		return
+	}
+	span := s.span
+	defer span.End()
+
+	// 同じgorm.DBで続けて実行したクエリが、終了したスパンの子にならないようにする
+	db.Statement.Context = s.parent
+
+	span.SetAttributes(
+		attribute.String("db.collection.name", db.Statement.Table),
+		// 値は個人情報を含むことがあるため、プレースホルダーのままのSQLを記録する
+		attribute.String("db.query.text", db.Statement.SQL.String()),
+		attribute.Int64("db.response.returned_rows", db.RowsAffected),
+	)
+	// 見つからないことは正常な結果として扱う
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		tracing.RecordError(span, db.Error)
+	}
+}
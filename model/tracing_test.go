@@ -0,0 +1,51 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/tracing"
+	"github.com/traPtitech/anke-to/tracing/tracingtest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/gorm"
+)
+
+func TestTracingPlugin(t *testing.T) {
+	assertion := assert.New(t)
+
+	exporter := tracingtest.Setup(t)
+
+	ctx, parent := tracing.Start(context.Background(), "parent")
+
+	err := db.WithContext(ctx).Take(&Questionnaires{}, 0).Error
+	assertion.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	err = db.WithContext(ctx).Exec("SELECT * FROM not_exist_table").Error
+	assertion.Error(err)
+
+	parent.End()
+
+	var children tracetest.SpanStubs
+	for _, span := range exporter.GetSpans() {
+		if span.Parent.SpanID() == parent.SpanContext().SpanID() {
+			children = append(children, span)
+		}
+	}
+	if !assertion.Len(children, 2, "spans of queries") {
+		return
+	}
+
+	query := children[0]
+	assertion.Equal("gorm.query", query.Name)
+	assertion.Equal(codes.Unset, query.Status.Code, "record not found is not an error")
+	assertion.Contains(query.Attributes, attribute.String("db.collection.name", "questionnaires"))
+	assertion.Contains(query.Attributes, attribute.String("db.system.name", db.Dialector.Name()))
+
+	raw := children[1]
+	assertion.Equal("gorm.raw", raw.Name)
+	assertion.Equal(codes.Error, raw.Status.Code, "query error")
+	assertion.Contains(raw.Attributes, attribute.String("db.query.text", "SELECT * FROM not_exist_table"))
+}
@@ -48,11 +48,13 @@ func v3() *gormigrate.Migration {
 				return err
 			}
 			if err := tx.Exec(`
-				DELETE responses
-				FROM responses
-				INNER JOIN question ON responses.question_id = question.id
-				WHERE question.type NOT IN ('Checkbox', 'MultipleChoice')
-				  AND responses.body = ''
+				DELETE FROM responses
+				WHERE body = ''
+				  AND question_id IN (
+					SELECT id
+					FROM question
+					WHERE type NOT IN ('Checkbox', 'MultipleChoice')
+				  )
 			`).Error; err != nil {
 				return err
 			}
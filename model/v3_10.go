@@ -0,0 +1,94 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+type v3_10Respondents struct {
+	ResponseID      int            `gorm:"column:response_id;type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	QuestionnaireID int            `gorm:"type:int(11);not null"`
+	UserTraqid      string         `gorm:"type:varchar(32);size:32;default:NULL"`
+	AnonymousKey    null.String    `gorm:"type:char(64);size:64;default:NULL;index"`
+	ModifiedAt      time.Time      `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	SubmittedAt     null.Time      `gorm:"type:TIMESTAMP NULL;default:NULL"`
+	DeletedAt       gorm.DeletedAt `gorm:"type:TIMESTAMP NULL;default:NULL"`
+}
+
+func (*v3_10Respondents) TableName() string {
+	return "respondents"
+}
+
+// v3_10 匿名のアンケートの回答からユーザーIDを取り除き、アンケートごとのハッシュに置き換える
+// ハッシュの鍵はANONYMOUS_RESPONSE_SECRETで、変更すると回答者が自分の回答を見つけられなくなる
+func v3_10() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.10",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&v3_10Respondents{}, "AnonymousKey"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().CreateIndex(&v3_10Respondents{}, "AnonymousKey"); err != nil {
+				return err
+			}
+
+			anonymousQuestionnaireIDs := []int{}
+			err := tx.
+				Table("questionnaires").
+				Where("is_anonymous IS TRUE").
+				Pluck("id", &anonymousQuestionnaireIDs).Error
+			if err != nil {
+				return err
+			}
+
+			for _, questionnaireID := range anonymousQuestionnaireIDs {
+				respondents := []v3_10Respondents{}
+				err = tx.
+					Unscoped().
+					Where("questionnaire_id = ? AND user_traqid IS NOT NULL", questionnaireID).
+					Select("response_id", "user_traqid").
+					Find(&respondents).Error
+				if err != nil {
+					return err
+				}
+
+				for _, respondent := range respondents {
+					err = tx.
+						Unscoped().
+						Model(&v3_10Respondents{}).
+						Where("response_id = ?", respondent.ResponseID).
+						UpdateColumns(map[string]interface{}{
+							"user_traqid":   gorm.Expr("NULL"),
+							"anonymous_key": v3_10AnonymousRespondentKey(questionnaireID, respondent.UserTraqid),
+						}).Error
+					if err != nil {
+						return err
+					}
+				}
+
+				err = tx.Exec("DELETE FROM quick_poll_votes WHERE questionnaire_id = ?", questionnaireID).Error
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// v3_10AnonymousRespondentKey 3.10の時点の匿名の回答者のハッシュの計算処理
+// 適用済みのMigrationの結果が変わらないよう、現在のAnonymousRespondentKeyは使わない
+func v3_10AnonymousRespondentKey(questionnaireID int, userID string) string {
+	mac := hmac.New(sha256.New, anonymousResponseSecret)
+	fmt.Fprintf(mac, "%d:%s", questionnaireID, userID)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
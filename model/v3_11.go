@@ -0,0 +1,73 @@
+package model
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type v3_11Questionnaires struct {
+	ID                       int  `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	IsResponseHiddenUntilDue bool `gorm:"type:boolean;not null;default:false"`
+	IsResponseAggregateOnly  bool `gorm:"type:boolean;not null;default:false"`
+}
+
+func (*v3_11Questionnaires) TableName() string {
+	return "questionnaires"
+}
+
+type v3_11Questions struct {
+	ID               int  `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	IsResponseHidden bool `gorm:"type:boolean;not null;default:false"`
+}
+
+func (*v3_11Questions) TableName() string {
+	return "question"
+}
+
+type v3_11ResponseViewers struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	UserTraqid      string `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+}
+
+func (*v3_11ResponseViewers) TableName() string {
+	return "response_viewers"
+}
+
+type v3_11ResponseViewerUsers struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	UserTraqid      string `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+}
+
+func (*v3_11ResponseViewerUsers) TableName() string {
+	return "response_viewer_users"
+}
+
+type v3_11ResponseViewerGroups struct {
+	QuestionnaireID int       `gorm:"type:int(11);not null;primaryKey"`
+	GroupID         uuid.UUID `gorm:"type:char(36);size:36;not null;primaryKey"`
+}
+
+func (*v3_11ResponseViewerGroups) TableName() string {
+	return "response_viewer_groups"
+}
+
+// v3_11 結果の公開範囲の細かい設定と、結果を閲覧できるユーザー・グループのテーブルを追加
+func v3_11() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.11",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&v3_11Questionnaires{}, "IsResponseHiddenUntilDue"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().AddColumn(&v3_11Questionnaires{}, "IsResponseAggregateOnly"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().AddColumn(&v3_11Questions{}, "IsResponseHidden"); err != nil {
+				return err
+			}
+
+			return tx.Migrator().CreateTable(&v3_11ResponseViewers{}, &v3_11ResponseViewerUsers{}, &v3_11ResponseViewerGroups{})
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+type v3_12Respondents struct {
+	ResponseID int         `gorm:"column:response_id;type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	EnteredBy  null.String `gorm:"type:varchar(32);size:32;default:NULL"`
+}
+
+func (*v3_12Respondents) TableName() string {
+	return "respondents"
+}
+
+// v3_12 運営が代理で入力した回答に、入力した運営を記録する
+func v3_12() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.12",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Migrator().AddColumn(&v3_12Respondents{}, "EnteredBy")
+		},
+	}
+}
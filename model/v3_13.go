@@ -0,0 +1,36 @@
+package model
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+type v3_13Respondents struct {
+	ResponseID    int         `gorm:"column:response_id;type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	ReviewStatus  string      `gorm:"type:varchar(20);size:20;not null;default:pending"`
+	ReviewComment null.String `gorm:"type:text;default:NULL"`
+	ReviewedBy    null.String `gorm:"type:varchar(32);size:32;default:NULL"`
+	ReviewedAt    null.Time   `gorm:"type:TIMESTAMP NULL;default:NULL"`
+}
+
+func (*v3_13Respondents) TableName() string {
+	return "respondents"
+}
+
+// v3_13 運営が回答を審査できるよう、審査状況とコメントを追加する
+// 既存の回答はすべて未審査になる
+func v3_13() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.13",
+		Migrate: func(tx *gorm.DB) error {
+			for _, column := range []string{"ReviewStatus", "ReviewComment", "ReviewedBy", "ReviewedAt"} {
+				if err := tx.Migrator().AddColumn(&v3_13Respondents{}, column); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+type v3_14Questionnaires struct {
+	ID                         int  `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	IsQuiz                     bool `gorm:"type:boolean;not null;default:false"`
+	IsQuizAnswerHiddenUntilDue bool `gorm:"type:boolean;not null;default:false"`
+}
+
+func (*v3_14Questionnaires) TableName() string {
+	return "questionnaires"
+}
+
+type v3_14Questions struct {
+	ID     int `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	Points int `gorm:"type:int(11);not null;default:1"`
+}
+
+func (*v3_14Questions) TableName() string {
+	return "question"
+}
+
+type v3_14Options struct {
+	ID        int  `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	IsCorrect bool `gorm:"type:boolean;not null;default:false"`
+}
+
+func (*v3_14Options) TableName() string {
+	return "options"
+}
+
+type v3_14Validations struct {
+	QuestionID         int    `gorm:"type:int(11);not null;primaryKey"`
+	AnswerRegexPattern string `gorm:"type:text;default:NULL"`
+	AnswerMinBound     string `gorm:"type:text;default:NULL"`
+	AnswerMaxBound     string `gorm:"type:text;default:NULL"`
+}
+
+func (*v3_14Validations) TableName() string {
+	return "validations"
+}
+
+type v3_14Respondents struct {
+	ResponseID int      `gorm:"column:response_id;type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	Score      null.Int `gorm:"type:int(11);default:NULL"`
+}
+
+func (*v3_14Respondents) TableName() string {
+	return "respondents"
+}
+
+// v3_14 アンケートをクイズにできるよう、質問の正解と配点、回答の得点を追加する
+func v3_14() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.14",
+		Migrate: func(tx *gorm.DB) error {
+			columns := []struct {
+				model  interface{}
+				fields []string
+			}{
+				{&v3_14Questionnaires{}, []string{"IsQuiz", "IsQuizAnswerHiddenUntilDue"}},
+				{&v3_14Questions{}, []string{"Points"}},
+				{&v3_14Options{}, []string{"IsCorrect"}},
+				{&v3_14Validations{}, []string{"AnswerRegexPattern", "AnswerMinBound", "AnswerMaxBound"}},
+				{&v3_14Respondents{}, []string{"Score"}},
+			}
+			for _, column := range columns {
+				for _, field := range column.fields {
+					if err := tx.Migrator().AddColumn(column.model, field); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v3_15Questionnaires struct {
+	ID       int    `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	Language string `gorm:"type:varchar(8);size:8;not null;default:ja"`
+}
+
+func (*v3_15Questionnaires) TableName() string {
+	return "questionnaires"
+}
+
+// v3_15 traQに投稿するメッセージの言語をアンケートごとに選べるようにする
+func v3_15() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.15",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Migrator().AddColumn(&v3_15Questionnaires{}, "Language")
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+type v3_16Questionnaires struct {
+	ID                   int         `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	AnnouncementTemplate null.String `gorm:"type:text;default:NULL"`
+	ReminderTemplate     null.String `gorm:"type:text;default:NULL"`
+}
+
+func (*v3_16Questionnaires) TableName() string {
+	return "questionnaires"
+}
+
+// v3_16 アンケートの作成とリマインダーのメッセージのテンプレートをアンケートごとに設定できるようにする
+func v3_16() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.16",
+		Migrate: func(tx *gorm.DB) error {
+			for _, field := range []string{"AnnouncementTemplate", "ReminderTemplate"} {
+				if err := tx.Migrator().AddColumn(&v3_16Questionnaires{}, field); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+type v3_17WebhookDeliveries struct {
+	ID            int       `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	Status        string    `gorm:"type:varchar(16);size:16;not null"`
+	NextAttemptAt null.Time `gorm:"type:TIMESTAMP NULL;default:NULL;index"`
+}
+
+func (*v3_17WebhookDeliveries) TableName() string {
+	return "webhook_deliveries"
+}
+
+// v3_17 Webhookの再送の時刻を保存し、再起動しても再送できるようにする
+func v3_17() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.17",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&v3_17WebhookDeliveries{}, "NextAttemptAt"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().CreateIndex(&v3_17WebhookDeliveries{}, "NextAttemptAt"); err != nil {
+				return err
+			}
+
+			// 再送を待っていた送信はすぐに送信する
+			return tx.
+				Model(&v3_17WebhookDeliveries{}).
+				Where("status = ?", "pending").
+				Update("next_attempt_at", time.Now()).Error
+		},
+	}
+}
@@ -0,0 +1,167 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"golang.org/x/text/unicode/norm"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+type v3_2QuestionnaireSearchTokens struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	Token           string `gorm:"type:varbinary(16);size:16;not null;primaryKey;index"`
+	Weight          int    `gorm:"type:int(11);not null;default:0"`
+}
+
+func (*v3_2QuestionnaireSearchTokens) TableName() string {
+	return "questionnaire_search_tokens"
+}
+
+type v3_2Questionnaires struct {
+	ID          int
+	Title       string
+	Description string
+}
+
+func (*v3_2Questionnaires) TableName() string {
+	return "questionnaires"
+}
+
+func v3_2() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.2",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().CreateTable(&v3_2QuestionnaireSearchTokens{}); err != nil {
+				return err
+			}
+
+			// 既存のアンケートの検索インデックスを作成
+			var questionnaireIDs []int
+			err := tx.
+				Table("questionnaires").
+				Where("deleted_at IS NULL").
+				Pluck("id", &questionnaireIDs).Error
+			if err != nil {
+				return err
+			}
+			for _, questionnaireID := range questionnaireIDs {
+				if err := v3_2UpdateSearchIndex(tx, questionnaireID); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// v3_2UpdateSearchIndex 3.2の時点の検索インデックスの作成処理
+// 適用済みのMigrationの結果が変わらないよう、現在のupdateSearchIndexやトークンの分割処理は使わない
+func v3_2UpdateSearchIndex(tx *gorm.DB, questionnaireID int) error {
+	const (
+		titleTokenWeight = 2
+		bodyTokenWeight  = 1
+	)
+
+	var questionnaire v3_2Questionnaires
+	err := tx.
+		Session(&gorm.Session{NewDB: true}).
+		Select("id", "title", "description").
+		Where("id = ?", questionnaireID).
+		Take(&questionnaire).Error
+	if err != nil {
+		return fmt.Errorf("failed to get questionnaire: %w", err)
+	}
+
+	var bodies []null.String
+	err = tx.
+		Session(&gorm.Session{NewDB: true}).
+		Table("question").
+		Where("questionnaire_id = ? AND deleted_at IS NULL", questionnaireID).
+		Pluck("body", &bodies).Error
+	if err != nil {
+		return fmt.Errorf("failed to get question bodies: %w", err)
+	}
+
+	weights := map[string]int{}
+	for _, token := range v3_2SearchTokens(questionnaire.Title) {
+		weights[token] += titleTokenWeight
+	}
+	texts := []string{questionnaire.Description}
+	for _, body := range bodies {
+		texts = append(texts, body.String)
+	}
+	for _, text := range texts {
+		for _, token := range v3_2SearchTokens(text) {
+			weights[token] += bodyTokenWeight
+		}
+	}
+
+	err = tx.
+		Session(&gorm.Session{NewDB: true}).
+		Where("questionnaire_id = ?", questionnaireID).
+		Delete(&v3_2QuestionnaireSearchTokens{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete search tokens: %w", err)
+	}
+
+	if len(weights) == 0 {
+		return nil
+	}
+
+	tokens := make([]v3_2QuestionnaireSearchTokens, 0, len(weights))
+	for token, weight := range weights {
+		tokens = append(tokens, v3_2QuestionnaireSearchTokens{
+			QuestionnaireID: questionnaireID,
+			Token:           token,
+			Weight:          weight,
+		})
+	}
+
+	err = tx.
+		Session(&gorm.Session{NewDB: true}).
+		CreateInBatches(tokens, 500).Error
+	if err != nil {
+		return fmt.Errorf("failed to insert search tokens: %w", err)
+	}
+
+	return nil
+}
+
+// v3_2SearchTokens 3.2の時点のトークンの分割処理
+// NFKCで正規化して小文字にし、文字・数字の連続ごとに1-gramと2-gramを出現回数だけ返す
+func v3_2SearchTokens(text string) []string {
+	text = strings.ToLower(norm.NFKC.String(text))
+
+	runs := [][]rune{}
+	run := []rune{}
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) {
+			run = append(run, r)
+			continue
+		}
+		if len(run) != 0 {
+			runs = append(runs, run)
+			run = []rune{}
+		}
+	}
+	if len(run) != 0 {
+		runs = append(runs, run)
+	}
+
+	tokens := []string{}
+	for _, run := range runs {
+		for i := range run {
+			tokens = append(tokens, string(run[i]))
+			if i+1 < len(run) {
+				tokens = append(tokens, string(run[i:i+2]))
+			}
+		}
+	}
+
+	return tokens
+}
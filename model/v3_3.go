@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v3_3Tags struct {
+	ID        int       `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	Name      string    `gorm:"type:varchar(64);size:64;not null;uniqueIndex"`
+	CreatedBy string    `gorm:"type:varchar(32);size:32;not null"`
+	CreatedAt time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (*v3_3Tags) TableName() string {
+	return "tags"
+}
+
+type v3_3QuestionnaireTags struct {
+	QuestionnaireID int `gorm:"type:int(11);not null;primaryKey"`
+	TagID           int `gorm:"type:int(11);not null;primaryKey;index"`
+}
+
+func (*v3_3QuestionnaireTags) TableName() string {
+	return "questionnaire_tags"
+}
+
+func v3_3() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.3",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().CreateTable(&v3_3Tags{}); err != nil {
+				return err
+			}
+			return tx.Migrator().CreateTable(&v3_3QuestionnaireTags{})
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type v3_4Administrators struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	UserTraqid      string `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+	Role            string `gorm:"type:varchar(16);size:16;not null;default:owner"`
+}
+
+func (*v3_4Administrators) TableName() string {
+	return "administrators"
+}
+
+type v3_4AdministratorUsers struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	UserTraqid      string `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+	Role            string `gorm:"type:varchar(16);size:16;not null;default:owner"`
+}
+
+func (*v3_4AdministratorUsers) TableName() string {
+	return "administrator_users"
+}
+
+type v3_4AdministratorGroups struct {
+	QuestionnaireID int       `gorm:"type:int(11);not null;primaryKey"`
+	GroupID         uuid.UUID `gorm:"type:varchar(36);size:36;not null;primaryKey"`
+	Role            string    `gorm:"type:varchar(16);size:16;not null;default:owner"`
+}
+
+func (*v3_4AdministratorGroups) TableName() string {
+	return "administrator_groups"
+}
+
+// v3_4 既存の管理者はすべてownerとして扱う
+func v3_4() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.4",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&v3_4Administrators{}, "Role"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().AddColumn(&v3_4AdministratorUsers{}, "Role"); err != nil {
+				return err
+			}
+			return tx.Migrator().AddColumn(&v3_4AdministratorGroups{}, "Role")
+		},
+	}
+}
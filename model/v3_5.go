@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v3_5SystemAdmins struct {
+	UserTraqid string    `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+	GrantedBy  string    `gorm:"type:varchar(32);size:32;not null"`
+	CreatedAt  time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (*v3_5SystemAdmins) TableName() string {
+	return "system_admins"
+}
+
+// v3_5 これまでハードコーディングしていたシステム管理者をsystem_adminsテーブルに移す
+func v3_5() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.5",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().CreateTable(&v3_5SystemAdmins{}); err != nil {
+				return err
+			}
+
+			now := time.Now()
+			systemAdmins := []v3_5SystemAdmins{}
+			for _, userID := range []string{"ryoha", "xxarupakaxx", "kaitoyama", "cp20", "itzmeowww"} {
+				systemAdmins = append(systemAdmins, v3_5SystemAdmins{
+					UserTraqid: userID,
+					GrantedBy:  userID,
+					CreatedAt:  now,
+				})
+			}
+			return tx.Create(&systemAdmins).Error
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+type v3_6AccessTokens struct {
+	ID         int       `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	UserTraqid string    `gorm:"type:varchar(32);size:32;not null;index"`
+	Name       string    `gorm:"type:varchar(50);size:50;not null"`
+	TokenHash  string    `gorm:"type:char(64);size:64;not null;uniqueIndex"`
+	Scopes     string    `gorm:"type:varchar(255);size:255;not null"`
+	RateLimit  int       `gorm:"type:int(11);not null"`
+	ExpiresAt  null.Time `gorm:"type:TIMESTAMP NULL;default:NULL;"`
+	LastUsedAt null.Time `gorm:"type:TIMESTAMP NULL;default:NULL;"`
+	CreatedAt  time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (*v3_6AccessTokens) TableName() string {
+	return "access_tokens"
+}
+
+// v3_6 個人用アクセストークンのテーブルを追加
+func v3_6() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.6",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&v3_6AccessTokens{})
+		},
+	}
+}
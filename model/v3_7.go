@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gopkg.in/guregu/null.v4"
+	"gorm.io/gorm"
+)
+
+type v3_7QuestionnaireWebhooks struct {
+	ID              int       `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	QuestionnaireID int       `gorm:"type:int(11);not null;index"`
+	URL             string    `gorm:"type:varchar(500);size:500;not null"`
+	Secret          string    `gorm:"type:varchar(64);size:64;not null"`
+	Events          string    `gorm:"type:varchar(255);size:255;not null"`
+	CreatedBy       string    `gorm:"type:varchar(32);size:32;not null"`
+	CreatedAt       time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (*v3_7QuestionnaireWebhooks) TableName() string {
+	return "questionnaire_webhooks"
+}
+
+type v3_7WebhookDeliveries struct {
+	ID           int       `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	WebhookID    int       `gorm:"type:int(11);not null;index"`
+	Event        string    `gorm:"type:varchar(32);size:32;not null"`
+	Payload      string    `gorm:"type:text;not null"`
+	Status       string    `gorm:"type:varchar(16);size:16;not null"`
+	Attempts     int       `gorm:"type:int(11);not null;default:0"`
+	StatusCode   null.Int  `gorm:"type:int(11);default:NULL"`
+	ErrorMessage string    `gorm:"type:varchar(1024);size:1024;not null;default:''"`
+	CreatedAt    time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt    time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (*v3_7WebhookDeliveries) TableName() string {
+	return "webhook_deliveries"
+}
+
+// v3_7 アンケートごとのWebhookと送信履歴のテーブルを追加
+func v3_7() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.7",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().CreateTable(&v3_7QuestionnaireWebhooks{}); err != nil {
+				return err
+			}
+			return tx.Migrator().CreateTable(&v3_7WebhookDeliveries{})
+		},
+	}
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v3_8QuickPolls struct {
+	QuestionnaireID int       `gorm:"type:int(11);not null;primaryKey"`
+	QuestionID      int       `gorm:"type:int(11);not null"`
+	ChannelID       string    `gorm:"type:char(36);size:36;not null"`
+	MessageID       string    `gorm:"type:char(36);size:36;not null;uniqueIndex"`
+	CreatedBy       string    `gorm:"type:varchar(32);size:32;not null"`
+	CreatedAt       time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (*v3_8QuickPolls) TableName() string {
+	return "quick_polls"
+}
+
+type v3_8QuickPollStamps struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	StampID         string `gorm:"type:char(36);size:36;not null;primaryKey"`
+	OptionNum       int    `gorm:"type:int(11);not null"`
+}
+
+func (*v3_8QuickPollStamps) TableName() string {
+	return "quick_poll_stamps"
+}
+
+type v3_8QuickPollVotes struct {
+	QuestionnaireID int    `gorm:"type:int(11);not null;primaryKey"`
+	UserTraqid      string `gorm:"type:varchar(32);size:32;not null;primaryKey"`
+	ResponseID      int    `gorm:"type:int(11);not null"`
+	OptionNum       int    `gorm:"type:int(11);not null"`
+}
+
+func (*v3_8QuickPollVotes) TableName() string {
+	return "quick_poll_votes"
+}
+
+// v3_8 スタンプで回答するアンケートのテーブルを追加
+func v3_8() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.8",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().CreateTable(&v3_8QuickPolls{}); err != nil {
+				return err
+			}
+			if err := tx.Migrator().CreateTable(&v3_8QuickPollStamps{}); err != nil {
+				return err
+			}
+			return tx.Migrator().CreateTable(&v3_8QuickPollVotes{})
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+type v3_9StreamEvents struct {
+	ID        int       `gorm:"type:int(11) AUTO_INCREMENT;not null;primaryKey"`
+	Topic     string    `gorm:"type:varchar(64);size:64;not null"`
+	Payload   string    `gorm:"type:mediumtext;not null"`
+	CreatedAt time.Time `gorm:"type:timestamp;not null;default:CURRENT_TIMESTAMP;index"`
+}
+
+func (*v3_9StreamEvents) TableName() string {
+	return "stream_events"
+}
+
+// v3_9 複数のインスタンスの間で回答の変更を配信するためのテーブルを追加
+func v3_9() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "3.9",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&v3_9StreamEvents{})
+		},
+	}
+}
@@ -8,6 +8,7 @@ import "context"
 type IValidation interface {
 	InsertValidation(ctx context.Context, lastID int, validation Validations) error
 	UpdateValidation(ctx context.Context, questionID int, validation Validations) error
+	UpdateValidationAnswer(ctx context.Context, questionID int, validation Validations) error
 	DeleteValidation(ctx context.Context, questionID int) error
 	GetValidations(ctx context.Context, questionIDs []int) ([]Validations, error)
 	CheckNumberValidation(validation Validations, Body string) error
@@ -17,10 +17,13 @@ func NewValidation() *Validation {
 
 // Validations validationsテーブルの構造体
 type Validations struct {
-	QuestionID   int    `json:"questionID"    gorm:"type:int(11);not null;primaryKey"`
-	RegexPattern string `json:"regex_pattern" gorm:"type:text;default:NULL"`
-	MinBound     string `json:"min_bound"     gorm:"type:text;default:NULL"`
-	MaxBound     string `json:"max_bound"     gorm:"type:text;default:NULL"`
+	QuestionID         int    `json:"questionID"           gorm:"type:int(11);not null;primaryKey"`
+	RegexPattern       string `json:"regex_pattern"        gorm:"type:text;default:NULL"`
+	MinBound           string `json:"min_bound"            gorm:"type:text;default:NULL"`
+	MaxBound           string `json:"max_bound"            gorm:"type:text;default:NULL"`
+	AnswerRegexPattern string `json:"answer_regex_pattern" gorm:"type:text;default:NULL"`
+	AnswerMinBound     string `json:"answer_min_bound"     gorm:"type:text;default:NULL"`
+	AnswerMaxBound     string `json:"answer_max_bound"     gorm:"type:text;default:NULL"`
 }
 
 // InsertValidation IDを指定してvalidationsを挿入する
@@ -66,6 +69,32 @@ func (*Validation) UpdateValidation(ctx context.Context, questionID int, validat
 	return nil
 }
 
+// UpdateValidationAnswer questionIDを指定してvalidationのクイズの正解の条件を更新する
+func (*Validation) UpdateValidationAnswer(ctx context.Context, questionID int, validation Validations) error {
+	db, err := getTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the transaction: %w", err)
+	}
+
+	result := db.
+		Model(&Validations{}).
+		Where("question_id = ?", questionID).
+		Updates(map[string]interface{}{
+			"answer_regex_pattern": validation.AnswerRegexPattern,
+			"answer_min_bound":     validation.AnswerMinBound,
+			"answer_max_bound":     validation.AnswerMaxBound,
+		})
+	err = result.Error
+	if err != nil {
+		return fmt.Errorf("failed to update the validation answer (questionID: %d): %w", questionID, err)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to update a validation answer: %w", ErrNoRecordUpdated)
+	}
+
+	return nil
+}
+
 // DeleteValidation questionIDを指定してvalidationを削除する
 func (*Validation) DeleteValidation(ctx context.Context, questionID int) error {
 	db, err := getTx(ctx)
@@ -23,7 +23,7 @@ func TestInsertValidation(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -215,7 +215,7 @@ func TestUpdateValidation(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -363,7 +363,7 @@ func TestDeleteValidation(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -461,7 +461,7 @@ func TestGetValidations(t *testing.T) {
 	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
 	require.NoError(t, err)
 
-	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne})
+	err = administratorImpl.InsertAdministrators(ctx, questionnaireID, []string{userOne}, AdministratorRoleOwner)
 	require.NoError(t, err)
 
 	type args struct {
@@ -851,3 +851,34 @@ func TestCheckNumberValid(t *testing.T) {
 		}
 	}
 }
+
+func TestUpdateValidationAnswer(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	questionnaireID, err := questionnaireImpl.InsertQuestionnaire(ctx, "第1回集会らん☆ぷろ募集アンケート", "第1回メンバー集会でのらん☆ぷろで発表したい人を募集します らん☆ぷろで発表したい人あつまれー！", null.NewTime(time.Now(), false), "public", true, false, true)
+	require.NoError(t, err)
+
+	questionID, err := questionImpl.InsertQuestion(ctx, questionnaireID, 1, 1, "Number", "答えは？", "", true)
+	require.NoError(t, err)
+
+	err = validationImpl.InsertValidation(ctx, questionID, Validations{MinBound: "0", MaxBound: "100"})
+	require.NoError(t, err)
+
+	err = validationImpl.UpdateValidationAnswer(ctx, questionID, Validations{AnswerMinBound: "10", AnswerMaxBound: "20"})
+	require.NoError(t, err)
+
+	validations, err := validationImpl.GetValidations(ctx, []int{questionID})
+	require.NoError(t, err)
+	if assertion.Len(validations, 1) {
+		assertion.Equal("0", validations[0].MinBound, "min bound is not changed")
+		assertion.Equal("100", validations[0].MaxBound, "max bound is not changed")
+		assertion.Equal("10", validations[0].AnswerMinBound, "answer min bound")
+		assertion.Equal("20", validations[0].AnswerMaxBound, "answer max bound")
+	}
+
+	err = validationImpl.UpdateValidationAnswer(ctx, -1, Validations{AnswerRegexPattern: "^a$"})
+	assertion.ErrorIs(err, ErrNoRecordUpdated, "validation not found")
+}
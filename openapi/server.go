@@ -14,6 +14,15 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /accessTokens)
+	GetAccessTokens(ctx echo.Context) error
+
+	// (POST /accessTokens)
+	PostAccessToken(ctx echo.Context) error
+
+	// (DELETE /accessTokens/{accessTokenID})
+	DeleteAccessToken(ctx echo.Context, accessTokenID AccessTokenIDInPath) error
+
 	// (GET /questionnaires)
 	GetQuestionnaires(ctx echo.Context, params GetQuestionnairesParams) error
 
@@ -32,18 +41,45 @@ type ServerInterface interface {
 	// (POST /questionnaires/{questionnaireID}/close)
 	CloseQuestionnaire(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
 
+	// (POST /questionnaires/{questionnaireID}/messagePreview)
+	PreviewQuestionnaireMessage(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
+
 	// (GET /questionnaires/{questionnaireID}/myRemindStatus)
 	GetQuestionnaireMyRemindStatus(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
 
 	// (PATCH /questionnaires/{questionnaireID}/myRemindStatus)
 	EditQuestionnaireMyRemindStatus(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
 
+	// (GET /questionnaires/{questionnaireID}/quickPoll)
+	GetQuestionnaireQuickPoll(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
+
+	// (POST /questionnaires/{questionnaireID}/quickPoll)
+	PostQuestionnaireQuickPoll(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
+
 	// (GET /questionnaires/{questionnaireID}/responses)
 	GetQuestionnaireResponses(ctx echo.Context, questionnaireID QuestionnaireIDInPath, params GetQuestionnaireResponsesParams) error
 
 	// (POST /questionnaires/{questionnaireID}/responses)
 	PostQuestionnaireResponse(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
 
+	// (GET /questionnaires/{questionnaireID}/responses/stream)
+	GetQuestionnaireResponsesStream(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
+
+	// (GET /questionnaires/{questionnaireID}/responses/summary)
+	GetQuestionnaireResponsesSummary(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
+
+	// (GET /questionnaires/{questionnaireID}/webhooks)
+	GetQuestionnaireWebhooks(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
+
+	// (POST /questionnaires/{questionnaireID}/webhooks)
+	PostQuestionnaireWebhook(ctx echo.Context, questionnaireID QuestionnaireIDInPath) error
+
+	// (DELETE /questionnaires/{questionnaireID}/webhooks/{webhookID})
+	DeleteQuestionnaireWebhook(ctx echo.Context, questionnaireID QuestionnaireIDInPath, webhookID WebhookIDInPath) error
+
+	// (GET /questionnaires/{questionnaireID}/webhooks/{webhookID}/deliveries)
+	GetQuestionnaireWebhookDeliveries(ctx echo.Context, questionnaireID QuestionnaireIDInPath, webhookID WebhookIDInPath) error
+
 	// (GET /responses/myResponses)
 	GetMyResponses(ctx echo.Context, params GetMyResponsesParams) error
 
@@ -56,6 +92,30 @@ type ServerInterface interface {
 	// (PATCH /responses/{responseID})
 	EditResponse(ctx echo.Context, responseID ResponseIDInPath) error
 
+	// (PUT /responses/{responseID}/review)
+	EditResponseReview(ctx echo.Context, responseID ResponseIDInPath) error
+
+	// (GET /systemAdmins)
+	GetSystemAdmins(ctx echo.Context) error
+
+	// (POST /systemAdmins)
+	PostSystemAdmin(ctx echo.Context) error
+
+	// (DELETE /systemAdmins/{traqID})
+	DeleteSystemAdmin(ctx echo.Context, traqID TraqIDInPath) error
+
+	// (GET /tags)
+	GetTags(ctx echo.Context) error
+
+	// (POST /tags)
+	PostTag(ctx echo.Context) error
+
+	// (DELETE /tags/{tagID})
+	DeleteTag(ctx echo.Context, tagID TagIDInPath) error
+
+	// (PATCH /tags/{tagID})
+	EditTag(ctx echo.Context, tagID TagIDInPath) error
+
 	// (GET /traq/channels)
 	GetTraqChannels(ctx echo.Context) error
 
@@ -77,6 +137,40 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAccessTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccessTokens(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccessTokens(ctx)
+	return err
+}
+
+// PostAccessToken converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAccessToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAccessToken(ctx)
+	return err
+}
+
+// DeleteAccessToken converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAccessToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "accessTokenID" -------------
+	var accessTokenID AccessTokenIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "accessTokenID", ctx.Param("accessTokenID"), &accessTokenID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter accessTokenID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAccessToken(ctx, accessTokenID)
+	return err
+}
+
 // GetQuestionnaires converts echo context to params.
 func (w *ServerInterfaceWrapper) GetQuestionnaires(ctx echo.Context) error {
 	var err error
@@ -97,6 +191,20 @@ func (w *ServerInterfaceWrapper) GetQuestionnaires(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter search: %s", err))
 	}
 
+	// ------------- Optional query parameter "keyword" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keyword", ctx.QueryParams(), &params.Keyword)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyword: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
@@ -104,6 +212,20 @@ func (w *ServerInterfaceWrapper) GetQuestionnaires(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// ------------- Optional query parameter "onlyTargetingMe" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "onlyTargetingMe", ctx.QueryParams(), &params.OnlyTargetingMe)
@@ -231,6 +353,22 @@ func (w *ServerInterfaceWrapper) CloseQuestionnaire(ctx echo.Context) error {
 	return err
 }
 
+// PreviewQuestionnaireMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewQuestionnaireMessage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "questionnaireID" -------------
+	var questionnaireID QuestionnaireIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionnaireID", ctx.Param("questionnaireID"), &questionnaireID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter questionnaireID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreviewQuestionnaireMessage(ctx, questionnaireID)
+	return err
+}
+
 // GetQuestionnaireMyRemindStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) GetQuestionnaireMyRemindStatus(ctx echo.Context) error {
 	var err error
@@ -263,6 +401,38 @@ func (w *ServerInterfaceWrapper) EditQuestionnaireMyRemindStatus(ctx echo.Contex
 	return err
 }
 
+// GetQuestionnaireQuickPoll converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQuestionnaireQuickPoll(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "questionnaireID" -------------
+	var questionnaireID QuestionnaireIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionnaireID", ctx.Param("questionnaireID"), &questionnaireID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter questionnaireID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetQuestionnaireQuickPoll(ctx, questionnaireID)
+	return err
+}
+
+// PostQuestionnaireQuickPoll converts echo context to params.
+func (w *ServerInterfaceWrapper) PostQuestionnaireQuickPoll(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "questionnaireID" -------------
+	var questionnaireID QuestionnaireIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionnaireID", ctx.Param("questionnaireID"), &questionnaireID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter questionnaireID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostQuestionnaireQuickPoll(ctx, questionnaireID)
+	return err
+}
+
 // GetQuestionnaireResponses converts echo context to params.
 func (w *ServerInterfaceWrapper) GetQuestionnaireResponses(ctx echo.Context) error {
 	var err error
@@ -297,6 +467,27 @@ func (w *ServerInterfaceWrapper) GetQuestionnaireResponses(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter isDraft: %s", err))
 	}
 
+	// ------------- Optional query parameter "review_status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "review_status", ctx.QueryParams(), &params.ReviewStatus)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter review_status: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetQuestionnaireResponses(ctx, questionnaireID, params)
 	return err
@@ -318,6 +509,118 @@ func (w *ServerInterfaceWrapper) PostQuestionnaireResponse(ctx echo.Context) err
 	return err
 }
 
+// GetQuestionnaireResponsesStream converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQuestionnaireResponsesStream(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "questionnaireID" -------------
+	var questionnaireID QuestionnaireIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionnaireID", ctx.Param("questionnaireID"), &questionnaireID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter questionnaireID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetQuestionnaireResponsesStream(ctx, questionnaireID)
+	return err
+}
+
+// GetQuestionnaireResponsesSummary converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQuestionnaireResponsesSummary(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "questionnaireID" -------------
+	var questionnaireID QuestionnaireIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionnaireID", ctx.Param("questionnaireID"), &questionnaireID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter questionnaireID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetQuestionnaireResponsesSummary(ctx, questionnaireID)
+	return err
+}
+
+// GetQuestionnaireWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQuestionnaireWebhooks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "questionnaireID" -------------
+	var questionnaireID QuestionnaireIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionnaireID", ctx.Param("questionnaireID"), &questionnaireID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter questionnaireID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetQuestionnaireWebhooks(ctx, questionnaireID)
+	return err
+}
+
+// PostQuestionnaireWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) PostQuestionnaireWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "questionnaireID" -------------
+	var questionnaireID QuestionnaireIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionnaireID", ctx.Param("questionnaireID"), &questionnaireID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter questionnaireID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostQuestionnaireWebhook(ctx, questionnaireID)
+	return err
+}
+
+// DeleteQuestionnaireWebhook converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteQuestionnaireWebhook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "questionnaireID" -------------
+	var questionnaireID QuestionnaireIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionnaireID", ctx.Param("questionnaireID"), &questionnaireID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter questionnaireID: %s", err))
+	}
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", ctx.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteQuestionnaireWebhook(ctx, questionnaireID, webhookID)
+	return err
+}
+
+// GetQuestionnaireWebhookDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQuestionnaireWebhookDeliveries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "questionnaireID" -------------
+	var questionnaireID QuestionnaireIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionnaireID", ctx.Param("questionnaireID"), &questionnaireID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter questionnaireID: %s", err))
+	}
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID WebhookIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", ctx.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhookID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetQuestionnaireWebhookDeliveries(ctx, questionnaireID, webhookID)
+	return err
+}
+
 // GetMyResponses converts echo context to params.
 func (w *ServerInterfaceWrapper) GetMyResponses(ctx echo.Context) error {
 	var err error
@@ -331,6 +634,20 @@ func (w *ServerInterfaceWrapper) GetMyResponses(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
 	// ------------- Optional query parameter "questionnaireIDs" -------------
 
 	err = runtime.BindQueryParameter("form", false, false, "questionnaireIDs", ctx.QueryParams(), &params.QuestionnaireIDs)
@@ -398,6 +715,106 @@ func (w *ServerInterfaceWrapper) EditResponse(ctx echo.Context) error {
 	return err
 }
 
+// EditResponseReview converts echo context to params.
+func (w *ServerInterfaceWrapper) EditResponseReview(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "responseID" -------------
+	var responseID ResponseIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "responseID", ctx.Param("responseID"), &responseID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter responseID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EditResponseReview(ctx, responseID)
+	return err
+}
+
+// GetSystemAdmins converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSystemAdmins(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSystemAdmins(ctx)
+	return err
+}
+
+// PostSystemAdmin converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSystemAdmin(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSystemAdmin(ctx)
+	return err
+}
+
+// DeleteSystemAdmin converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSystemAdmin(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "traqID" -------------
+	var traqID TraqIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "traqID", ctx.Param("traqID"), &traqID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter traqID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSystemAdmin(ctx, traqID)
+	return err
+}
+
+// GetTags converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTags(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTags(ctx)
+	return err
+}
+
+// PostTag converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTag(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTag(ctx)
+	return err
+}
+
+// DeleteTag converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tagID" -------------
+	var tagID TagIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagID", ctx.Param("tagID"), &tagID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTag(ctx, tagID)
+	return err
+}
+
+// EditTag converts echo context to params.
+func (w *ServerInterfaceWrapper) EditTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tagID" -------------
+	var tagID TagIDInPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagID", ctx.Param("tagID"), &tagID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tagID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EditTag(ctx, tagID)
+	return err
+}
+
 // GetTraqChannels converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTraqChannels(ctx echo.Context) error {
 	var err error
@@ -471,20 +888,40 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/accessTokens", wrapper.GetAccessTokens)
+	router.POST(baseURL+"/accessTokens", wrapper.PostAccessToken)
+	router.DELETE(baseURL+"/accessTokens/:accessTokenID", wrapper.DeleteAccessToken)
 	router.GET(baseURL+"/questionnaires", wrapper.GetQuestionnaires)
 	router.POST(baseURL+"/questionnaires", wrapper.PostQuestionnaire)
 	router.DELETE(baseURL+"/questionnaires/:questionnaireID", wrapper.DeleteQuestionnaire)
 	router.GET(baseURL+"/questionnaires/:questionnaireID", wrapper.GetQuestionnaire)
 	router.PATCH(baseURL+"/questionnaires/:questionnaireID", wrapper.EditQuestionnaire)
 	router.POST(baseURL+"/questionnaires/:questionnaireID/close", wrapper.CloseQuestionnaire)
+	router.POST(baseURL+"/questionnaires/:questionnaireID/messagePreview", wrapper.PreviewQuestionnaireMessage)
 	router.GET(baseURL+"/questionnaires/:questionnaireID/myRemindStatus", wrapper.GetQuestionnaireMyRemindStatus)
 	router.PATCH(baseURL+"/questionnaires/:questionnaireID/myRemindStatus", wrapper.EditQuestionnaireMyRemindStatus)
+	router.GET(baseURL+"/questionnaires/:questionnaireID/quickPoll", wrapper.GetQuestionnaireQuickPoll)
+	router.POST(baseURL+"/questionnaires/:questionnaireID/quickPoll", wrapper.PostQuestionnaireQuickPoll)
 	router.GET(baseURL+"/questionnaires/:questionnaireID/responses", wrapper.GetQuestionnaireResponses)
 	router.POST(baseURL+"/questionnaires/:questionnaireID/responses", wrapper.PostQuestionnaireResponse)
+	router.GET(baseURL+"/questionnaires/:questionnaireID/responses/stream", wrapper.GetQuestionnaireResponsesStream)
+	router.GET(baseURL+"/questionnaires/:questionnaireID/responses/summary", wrapper.GetQuestionnaireResponsesSummary)
+	router.GET(baseURL+"/questionnaires/:questionnaireID/webhooks", wrapper.GetQuestionnaireWebhooks)
+	router.POST(baseURL+"/questionnaires/:questionnaireID/webhooks", wrapper.PostQuestionnaireWebhook)
+	router.DELETE(baseURL+"/questionnaires/:questionnaireID/webhooks/:webhookID", wrapper.DeleteQuestionnaireWebhook)
+	router.GET(baseURL+"/questionnaires/:questionnaireID/webhooks/:webhookID/deliveries", wrapper.GetQuestionnaireWebhookDeliveries)
 	router.GET(baseURL+"/responses/myResponses", wrapper.GetMyResponses)
 	router.DELETE(baseURL+"/responses/:responseID", wrapper.DeleteResponse)
 	router.GET(baseURL+"/responses/:responseID", wrapper.GetResponse)
 	router.PATCH(baseURL+"/responses/:responseID", wrapper.EditResponse)
+	router.PUT(baseURL+"/responses/:responseID/review", wrapper.EditResponseReview)
+	router.GET(baseURL+"/systemAdmins", wrapper.GetSystemAdmins)
+	router.POST(baseURL+"/systemAdmins", wrapper.PostSystemAdmin)
+	router.DELETE(baseURL+"/systemAdmins/:traqID", wrapper.DeleteSystemAdmin)
+	router.GET(baseURL+"/tags", wrapper.GetTags)
+	router.POST(baseURL+"/tags", wrapper.PostTag)
+	router.DELETE(baseURL+"/tags/:tagID", wrapper.DeleteTag)
+	router.PATCH(baseURL+"/tags/:tagID", wrapper.EditTag)
 	router.GET(baseURL+"/traq/channels", wrapper.GetTraqChannels)
 	router.GET(baseURL+"/traq/groups", wrapper.GetTraqGroups)
 	router.GET(baseURL+"/traq/stamps", wrapper.GetTraqStamps)
@@ -18,100 +18,229 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9XVMUx7v4V9ma//9C6yyCL7k4nCsMqRRVMYnBnHMRKWrYbWFydmfWmVmVsqjamY0K",
-	"YQkc4xtiRCIBlAjmZ2IQUb/LaWYXrvwKp7p7Xnp6enZ6ll1ifvWrsix2pl+e9+fpp5/uuSrltGJJU4Fq",
-	"GlLvVakk63IRmEDHv3JaWTW/UAvjA+rZMtDH0bM8MHK6UjIVTZV6JVMvA2htOI9+d+YmYcW6WAYGeqXK",
-	"ig6MDLQ2G0+296/NOJN3obW69+4WtO7CirX7+mX99vN69Zrz6DdobUDrnTN7x3l7F1rz0J6GFbskjwLc",
-	"+7ulvZU70LoN7Rp5c16VspKC5r6IQcpKqlwEUm8ArJSVjNwYKMoIXHO8hF6OaFoByKo0MZGVxmTjzHi/",
-	"Ll8whdHau/HUmbyOniw8bDy7Ba2N3a3p+sIWtGagVXOe3XMerLmw2z/D6gto/warO7A6SZCD9k0Gv/Pq",
-	"BblgtDDHXWg9hdZ3wtMw/fzZUBPrFbRWUFdmMM4wMTQPSClE9K+AUdJUA7RK9/c7kwFN7Jv788vQmn2/",
-	"M9UpHgjM9wHyw6NyEksUI50SUOLIxy5CTqrHKrQ2PVLZeAD+GHz6oL4ufURJ4WKXRARVM7+4BPT+crxQ",
-	"ElmoP1jcn5+DVm3f+gGifysIlwhGBLzMEUS8o9lMs75IUHFH23bm1qFt4echNDNHME2PwooNqzdg9Ta0",
-	"n8DqOpawTULuJiQIcEuigqYWxvvyRUVVDFOXTZA/PX4mniCeltQaG0uNuet7lWvQWsekeMyixqNJbC+a",
-	"mJ2iCRdTEfIIWK+oBY8iz7TZff2Ls3yno9iKGwTU+pysjwJTUUdF+A/td8hE2f+A1SqGKIUUiPbtJGko",
-	"ZJNogwKSWILsvrkNq/cxOluNhQ1oTWeO1B88dTbuN94+CQyitXmcbnY0BjI0FQ8cRTXBKNAxOKE4a6B/",
-	"QP1SNseigDGeZKA/IEcJdfDnZMaTspIOLpYVHeSlXsSwdOAY8bbUtd53obUIre+CCDDi8jA/HyN+IkF5",
-	"it8+d82+tYgFYgNW/wdWn8DqEqbpO1ix95ZvoMCydsPZuO/Mbu5V33gyAK6UCloeSL1YMviUZ9EIcUEx",
-	"QdHg4Z/1nsi6Lo9H6ZHWz/LM6yq0bGhP+w71/c4kErBrv+7fmcYByUbrQQ8Zpb41iTqkGCgulEkab00E",
-	"Qb5MdCgI0D3LHqtIxFrH608wQlrV8XoOanq8iOxurUDrxf6j65kju28e1Cfn6nd/qc/b0KrV7zzHHPgu",
-	"c14yyiNFxTRBflg2z0vZDNPUmV0m7brYhud0+eJAP7Q26vduoEnOS6YuX1TyoXf78zPkXVfwsr7we/3O",
-	"cy4wRS2vXFD8KZiWASyhdpk4m2hoepiL/18HF6Re6f91B+vXbvLW6P6KIuk5RHFEZwPIem4slsLIfNjL",
-	"SMyq64gQyw8av/8cBwweiidUhqkr6iiZ7+D8zOlAFuBmuBmLiM9RxSwATgOKrV6LD5OrFDcnvD7YHn+S",
-	"V8yztMFFD+VC4YsLUu83zcc8y7i9iWyK9qdlAyT2iABHYg6jT83jMNTAI5R0rQR0UwEYIc97GCG3IwIX",
-	"1xkF5ugbauihiaGJrJQMXi8LnYyeJwH0tQF0NMinulYuGRgsPHDafgE+2si3IGdKLsx+SBtidRhQz7IO",
-	"K3n0E1yRi6UCkHqPZ3l2mJ2mOZyfg8s+CJiQLsA0w8Iqjxtkvv7a9SEXNL0om1KvVC4reSnLGhCWjVnp",
-	"c3DZ53Jq+RYSVa/xIDBRTGycHifqNhSe/SAqlgqOzqkLTcu0GkNzPqIbI1p+PA0U3kinUb+JrFRU1AHS",
-	"9XhUAhRjOI+jGVqYSYTBjWkCHPyeWQLhEEerWGiaKJZHElexuEFNlICo9RBPzzQVCMgRDdw5cCVZRdkO",
-	"n2lYscQ7fV4ujuD4XrzLoKKOFsDHY5qSA6k6nikXTKXUUtfBnFzAWoqk8y8yERGdDGISTvrgxVPn9iy0",
-	"b+69e+N8/whab/FicLPx59r+wnUS1PsrZrVcKARLOFfopRM9J3q6eo539Rw/19PTi//9W8+/9/b00JY1",
-	"L5ugy1SKgGdeGRlOgDAZJJ5TCXEE0zdiMELzXo2CqRjDgS7xFyXOu2v7jyahNQ2tJ9C6Dq1pDBlrErIk",
-	"AuQHq7S+kmbZ0FxhQHjmI0YyekXVm+0vpOK8TkJqznYUVHW2Wyp1ZzunVPnI3J7aR2nPjJzaFCDOcaAL",
-	"S65WivrciPjG+7OsVFaVi2XgvkZujBVEb4aouPGQdlnYErIU+8NIFuUrw5fkQhkTMTAsWnmkQFkV1euO",
-	"8E3RfkIIMcLolvByZYSLVkEeAQU+02iko8kuhGOTzjQFEiKDoC09qRi7Q6rXGnFY5W1Bvjsr0tgGtoSb",
-	"bz05fAfqKMlwJS+C4oDCNrZlwHwL3T7gOBarNzZqNV3HBNRyETGF6TiUTXCQ4YGaOUPKtoiD43ZoMxi+",
-	"FRGEgrRvNxCM0orCQndrM0iejgmCgpt3AARPo1KAgbu0EZRggd7a2v4cjhtTLe77qRAzVUdv/dNfBv2y",
-	"Cc6hIL+lAf5TAZflkQI4PZ6u/4DRp2rqeFErG2k79pdLBSUnm6BPNS4Dva9Q0C6DfNpRviyPFBRjDHUc",
-	"Ypn4MVl89XEEO7wua9+CipE6appEkesPL4Garo8CgBu//nrcWXiI9/5ewOocrO7sL1zf3bmPt5I2oD0F",
-	"7R//9951aP0J7RlorTbmt/eW1vydx93tbWjfdKYX8ZLzLl6EzmeEulk2tJZRB7sGqzvvd6xEctBYCNDD",
-	"lJXCh5TDjhGuVN3OuBsCafsJ5P9kP1EdXh5Tu4sbu1uVvZVVWLHf70w6UzPOwkNn8+3eb0vorX3Tyzwg",
-	"Jtfn7Yb9Clqre6uP6w/nXM7bz3FlwQ6s3vX2rNedxW1o/YILV1bI5oxbp4ja29DaeL8zRfYLRbKBeJ8t",
-	"z8v8tnM7wNtvzAPVHMYVk3FpBYL47vZ2/fbz9zuTsLoCq9N4n30TWk9Jm73KNfx2ClZsp/bOmZuJbPau",
-	"Qtt2Nhb37y8gLuDRcF7lFrTmcRJl/8bM3vINb87a3tpvzuymR0i3FMRLvaDBYMVy5mq7WxUM0Q60X6L/",
-	"rc3jWDWJpq6gWaxJb3P4/c5kgLWR8cpRNwnIeOtqvfFk2y1B8esLKpYLr7UJrR+htYStw01n1m5cW/X3",
-	"nj0m+6bpJF4EKUXkqXuynAUUBUsc8RmhjaFtUFcSFNl6m+EHEzl/66SpiLzfmazPzjk3tkkFQuPPWUQt",
-	"MVE4kBxsxghBjZR/OAsPCVxEht0xkUq65QNh8SAz8+cMFyFmWOXJQGsNcenG7+FaBF8YPkoSBrItxhME",
-	"3z79na1X7GZKWA0COmQ9cz4UjWsG+uNDZNxAbKePB5DfPdE9D6gXtMOLkA8c6B6yux4w6Jq6iWRqUnF0",
-	"hLeKMSzTbxkFwVbGMxhM9jv1/lgwkQDMMSE8D4G813RYxm2H5aAxWxNSwS4sMGIebqu4/Hz64EjGAiOA",
-	"c7Dg4KFZot82rUGEVo0UiDG2/eDYBTAIoPMVKCpq/hMVLf74KOm4xTAImvCrYNedzbeu9a8+hdWHuLjm",
-	"BaxOQatWfzDlfP+KRg0Xq4Z8FmXoSRHc97j9MhWGRVxRxcIRyYZTWYZWjRQQks41aD2PwrFfsXbfLUWO",
-	"0STTlCGCAGFp/eeR1fTeDxfBgUqLDywvIUgSMftMMTjr6ZI8CoaL8hWOeZqb3FvD1ZNeyW/99vPEPUOm",
-	"dDR9yI+7DZaLRVkf50V2pmbKhWEd5DQ9z1szzb7FNZ1uAVz9p6Xd1y+htR4KciJxaOPPWXKiK4zfqROJ",
-	"/tenHwtZhBCJDKJcVoRNVDlaR/Me9DyJAPN8e29sEVO+DIYRIMOmwlOc0EGTik3Mg1uYZ9/0zp0sQnsq",
-	"5FWQTs26q56KjXe2qWVF+PTKU3x2r91b8ROiZKJSdfFUuuQ2Gh4ZF6gSHRyTdeBXiNKM5A6YyFFP7w4n",
-	"d/OvhOtflHA9QEB8+KE7kysrIBNLFl68WI06hUjFJTXXEHgl/cRAZJCjzdCreGj9BO2ae6bJ2szgowah",
-	"FpkjoWFjDvsxIx8V8e/4iOlwcTyokWPDa/6BXanJUDpV6MfPkSUPhdYW9Hmz4ZHx5pFPs0N1VMzDncxn",
-	"beAqgvla8GchkkbJEotdlhE0zmL+wys0DhVBkUHcbESy5T/n1VaFUfBLriIbF+5mRXi/wd2OCMdXiUwi",
-	"k/BADLm45IXZRuOPufrDB9C+mc3sW9POnZfQ2txbmcZQonVD5sh5Nz1zXgoOt3pWAa9eI+2pNM956Whm",
-	"7+lzkgmMjKuOayo4L7nK7m50uskgNltEGiOcA8K6zzi1fvxi8bR++QCVvmyZL6eyN5ToSDR1KUqBs50L",
-	"fmmuiNPWSxUOZZukvk1dPpsZ6PeTyf6JWt9HuHdJWG+htQDtH0lgSqeuk3OB2dCJqY4uDGioGH4zUIT5",
-	"lRWo3R6iRPxfpdt/29Jt+sVp2QADLlOifhGHsQLscxvGeIbQZHFVSsFcItWMLU4+SHQmGdEY5RKc6lDK",
-	"YgOY21c1GIsfKzZtqoEVFV9KcCKgtKVqNQ0gnrpEIWl/pWgauFzhjoDVhuLOdoHRpnLOg4LjH/CMRKqe",
-	"92zcWoPWhqHpJgpW1zb2lx5SsSLjRruautWu8E9ypBk9d/+KFNZlpStdaKauS7KuykWk7d9Ig94UfWbf",
-	"4MdSln7Q/wl+EizH+5jfbgMSD/VRf+MXNGX+SzHHIpuQyGDw0s41aN3jlAqQWJ3s/to3cdy0Rq7VoDbh",
-	"pWzz7VV371M8dEYdqKDMSB0yC28pUxDS8zVzCu2AhhqNy6XD2DLwg8vR6CFUEazixSuJ+FT6ngWCR/h4",
-	"Dfe4yF4hRzQe2m+CNSqr9lStYZY+DC9l/aNMXd4fTWyAmML7OTmir/5PT5fRPH3Bn2I2AKk60vyPx2RV",
-	"JecqmG2zfCgCizsyTE7VcwKOkhwqqo8Jo/CgeAwe7ygAxSWMxoqjOug1TsC0jHIRoPBDCI6vDaDjyc64",
-	"feIpFkuYYMI4En2aTgUDCsSQZyDPuzAGL46ZjZhc6UQPj0RolEFTLnKIfEEpeOvkREofTAabUNQDIo6i",
-	"GPZ0FCXoxlAUyQFH3nKaOtwBeijG8Ihm8u6dCWjF5W5iSoGmYQh6f9I4ijKa0LLy6VpBlNe4qSA86XjN",
-	"ItOE6+kH5o3mj8RkU6mqDnxfjl+0146CTCZdHeFY4PmbjR8kycuGgNUkmLL8JF2zUqyfx3dLuqFPTlNN",
-	"OYfl373zxdTlL9GiVy9IvdKYaZaM3u7uUcUcK48cy2nFbvTeVEyQG+uW1f8GXSYOIMO0dl9k+r4c8L08",
-	"+/QS0A3S+tJJNIJWAqpcUqRe6eSxnmOnJOIVMQ26ozUX7g4Cu4X0Ay4bnSJlkm5xhH2zvl3BpwPmT/Ts",
-	"vn65+/qX3a1p/kVa1afQfhW+0tM9egArtoSB1GU0GzL70qcgfDeLgYEOLuiNWagFTbrpS4jilmh089Ad",
-	"SQId6FvxBJrHXDAo2DP2gkqB/tFrPgU6ce+sFe0XuvNNoFOzK+MEukeuap4YYpZdJ3p6PI308vUlsjGu",
-	"aGr3twY5YSN2F1O0MgprfVhd6s8eO1tb0Fr3RJ1UnLx1i5IrdlRB3CJ2d+OXUo2JrHSKwN9cJb37BJ03",
-	"Pzs7s9CqNW4/x+Ut35Ox0EAf8QaKXjYXDz7eb0A/ER5kxJPREQfPfoYA2VjcW6rV5+39Oz9Cq3bSQMi9",
-	"vIaAtha9aj57d+s1tNbrzx7vrczuLa01Zt9Cq+b8sOgsPMLY423NUSOy3sVLh5JmcIyVf2tXFDOv3LuJ",
-	"6flSM5g7tYgTAIbpbTG0RZAi9wpNhN0NTodGBPl4ZwTZPXnVTJTjickKd6Smnu34IYl4BAlGxJvI30SW",
-	"9Z/dV5l7NCcILAVgAhGonKnv8U3bTcSzHw/GCmg638i/QjXObArLgwc9Kw+x/I1aQRQ+1hrfLeHS4dU0",
-	"LLU2vOlrLTEzyw98otP4icQWwpgOc+ovsQtxLu6gXD/Vc0qooj4opHL53QlH19wLyWZuLJ3oLE/VF36n",
-	"Rcc9OMa7+nX/p4cxx8rWvXHI1cA/4kOAEfGv2LiUpJsU9kCrxta6+mVX3fTZqjCM7kgRCY/eQNlOEW+/",
-	"143CK+R2UxpCl3TtV4mP4g4DdUJyYhUpDertdafduYLmXibGDf04Fyfbf+C/52B1pz5vO5OvobXqzLxw",
-	"tlehtd74w97dvt7Uhn+MZvwg/a0HfPvF7KRQyVzoCutN7wAPJTyHasIj1Giz5BXHycGpQVM2y/GZkhbP",
-	"RrUUTpwJg/R3Cy7Yo2j/JFFGmN17a8+cjfudjjVal7pIJJLs4jsodu13+MkSdxDf3zlPf/hi2WHPHaKq",
-	"4FrLubbmnshwLzLgWMm4KtmgQDeccYiLZFnjGpRKtEnAkzOZvA8iCGaIW8rWsrnWTlr+gJz/HGbel8g2",
-	"2fXmmUx/tmgCM4qFf4q+8eyW+yUYBEXoEPW+9YPzw2vqg001L31CsnbC2VHqC0sfshsI3Vp/uInW8Lxx",
-	"gs8yOCGp6rfvZC71QFpz6sQJ4Q/KLZJ7h8Lnc4VXob4IHzCX60sAjvWTvJX/ObPQyUH/82fUfiesWBGi",
-	"3cIr7nW6GNEvfXNm7u2+mUHLVKElwZnx1l1Vyl3MuI9dfai+hl+X2NKGnV+XiBVn9/U98qUm91t2pODh",
-	"L9jZ4Hx4j7PTLqAQ/slGVheuBh94arqbEYRo4U2MmB2Mlv1G5ItVKfMoEThDrBblmE9uj3V8+3lSgE5o",
-	"tVZfe0IsIpNF8WXolFC47APlGVh8fU4tYmDjTDY3vyhisjnUbGq7Aya2LKUxGzZNVgo849l5MTzEGCJt",
-	"8ExxQTAL6FN3/84/kMVrWXgTJLH9IXdIcmKyKIHsiGVF2i08ndnuSBf3iplOXtrjME0ntXsRJ31/C7t5",
-	"eOYSOXVTly9256hKdq4FNXX5LI4jLFh9jLNFM7C67oc4YnY1VDPfQcMYmqe1wC6EZkIlVkxqjU+pVswU",
-	"YhHNraC6tSmvqGsuW2CUWxzbYTb59020Ui4X4NcSh3j0aQd7DL9Cvzl7XuFL3V60xh73GECH2ePO0hp7",
-	"AvxaYw+HPu1gj1/r3dzQBeXrLXDna7covKPM8erRW7Fv9JWLrRg3DnXaxptucgyEvx2AFrKb0F5zl7Nh",
-	"RBDzUpZi+YQ8Aw6DXweI1U/FXYrMXvq8Mo0v5J8Ohd3h+twEnvo0PAhP8UeG9Ute9BueraRr+XLO/Zha",
-	"cPiht9s75XDM1OXSsW9L3XJJwamkcP88uAQKWqmIGMMfoCsPLuFBTOUYOT7BHUgulMbkzJE8KBW0cZDP",
-	"aGpG1YAxpl3OyQb4j4ycM8tyIVPWCxnFyOADGkfjZsRjEcDRADEzjgCzXROioRLnK2g5ucCOgB+OaYbZ",
-	"e/zkiZOk55DPw6vcL8DjsZkPfNPPMOMnhib+LwAA///iRXWoRYkAAA==",
+	"H4sIAAAAAAAC/+y9e3MTV7Yo/lVUmvMH1E/ExhBOxqemTjmQ3wynQkJi5pypO+Z62lJjdyK3RHcL8FCu",
+	"Urd4GFuOiROeJhjCwwYHmYSEGNvg73Lbku2/8hVurf3o3rt790uWBLk1VVMZo97Ptddee73X+XS2MFos",
+	"qLJq6One8+kRWcrJGvrzY0X9Ev4/J+tZTSkaSkFN96YbP9y3zZpduW1X1m1rxTar9Wc363cWbfOWbU3V",
+	"7/1cvzphl01fs9pfP//YtmZTmpz/00Balc8ZA+mUbS5sb3xrm7dSez7//w+nPuj54IO9A2o6k5bPSaPF",
+	"vJzuTQ+UursPZLukotJ1uiTrsApVUjRZ/89sSdML2p/ksf8qHv2icO7UZ9C051BeGVWMP/V0o47yf3AT",
+	"pjNpPTsij0qwL2OsCBPohqaow+nx8fFMuihp0qhsEABI2ays6ycKX8rq0SNH1eOSMeKHh219b1vLtrVm",
+	"W6/sygTa7rJdeXH0CNqIAm2K0DOTVqVRmJAbNp1Ja/LpkqLJuXSvoZVkwQoV1ZCHZS0NK8wWSqrxqZof",
+	"O6p+VpK1Mf+CYBDbrDknwUMtZZvLW09Wdy5O1yduUPDfsMvm5trLxrXnjcrF+r0f4eTMjfrM9fqbG/hc",
+	"7bJVlIZl1PvC/e3H123zmm1V8Rd3o6fRkpydOosVwX2oUMjLkop3hY4ycEv1K9MeZLIrPyB4f2dXXsAf",
+	"ZCNoTeY8HPYgHjO1xzbf2Oa8bS4DPtuVm3alYlfKMJJZw2322mWrUb1cr90GUJjzBHTmsm1+Z5u1rZe3",
+	"bXPaNqds64oHJgOqXtAM21z2rXCxfrVqmzdty4LfrVk6ProkGxe3H5u2WbVNKwqCaIGhaJtJj0j6sbEj",
+	"mnTKiI0U25ef1icuwS9zd7eefWubtc2VqcbcCtood6MBvwHGP8LOKhMYNWxr1geJU1Jeb2KOG7b51DYv",
+	"xJ7G08+ZDZqYr2zzMYI3P5hgmAB4u6CMQlnU8nNZLxZUXW4W7r+tT7gwsWZ3bj20zZnf1q+06wxizPcO",
+	"ngeFctSRKHqyS8Cgo3h3PnAyPRYQfcCgQnc8YAwxfMxlBz5xQUF2FwWEL+WxswUtFwgE29qwrYfopVqy",
+	"K2vbT39o3PwK/njxtH5tpnH9Muz34mLj+uXGwztbP39vl62tJ6tbt17Xq6v1icu2NWmbte2nd21r1t3C",
+	"1SXbKgtRJu7uyLIjKB162wO3tp+hvxbQfLRW/LCl9jTuPK3Xbm+9eeIeqLnc07259nJvwJrQbNyKRqVz",
+	"ymhpNN27v7s7kx5VVPKvjOipVgvGp2dk7UgpmDzgW9m4M79z66ptVnfMr2z432PAKh9uYVCm9gAa782k",
+	"wvpaU6SjZaGzMdHvHMKl9iDshrfPrly2K9ds6wlCCQAL+hR2XO7eovCxoObH+nIAKt3QJEPOfTh2LBgg",
+	"lF5Vt2r3t65e2i5ftM0lBIoH3q2JYBLYiwVmu2Ai3Gkc8MR4R/xvqX/znjaba4/qD6+3dbfxSTO0PiFp",
+	"w7KhqMNxzh/IFFzlnxC7NpEIC+L2bSdomM1GwQYY60CAbL6+5lC1rbmabU4FULL9bDNYOGYdbbNKWU/C",
+	"HDs9/Ix8wH5ggekIuYSTMsKlJeaNCBaSPOMlFZM83fVgCkzepxtIRrjgyj8+lgVhwQPAAkCvp+jrc/Kw",
+	"wVtjQq/K13bliV25j05iwy5b2w8vg1iFjqA+s7xdeU0xRz5XzBdycroX4ZMY8t5tcKegGPKoLtq/8xpJ",
+	"miaN+eGRlE8SEeUFLLs4DNFv6xOAlhd/2Lk+hRjKWvNMKx6lsTIBHRIMFMSKRo23GGeDYpxoExOn0fcg",
+	"8CJhGh98f9wRkl4d2rO/oAWjyObKY9t8sXPvUmrP5us7jYmrjRuPGrcsoDbXn6MTuJAaSOuloVHFMOTc",
+	"oGQMpDMpT9P6zEPcbp+34QlNOn30iG3WGjcvwyQDaUOTTis57tvOrWn8bZ/7sTH3c+P6c+FiRgs55ZTi",
+	"TOFp6a6Fa5cK4g1B7udO8d80+VS6N/2HLlef1oW/6l2fMyA9ARBn4ax/3DRTC3oL/1tQNgnpt9ivT23r",
+	"iiuTlc2A5wG/kFXbfI5YYx9eYzGBYUSWbGsC3RNAiMbchm3CjbLN5c3Xv2x9u4gu0jThv0Iuxi55bU0+",
+	"o8hn+w3JKAXTek7Hs/ykMb+2Nfmy8cL0sFV+0iLkvjzNMPAJ02Uu22Vzx5yqX39JGrtwxvB4A71C4IE3",
+	"NKijHSVANBcMCC66LGnZkXjCoFnDEl8QxqOhIgQ0ffdEI6vJUgySwTfzbsQhG4qRlwUNGNpBW7SadGRS",
+	"RKZF16G2c/37nfKD+upjkNWWbuIempyXz0hqVm4VpeEojCENh3FiG7b1PPgBQZ2Tvh2oU7wLyOuHNhAv",
+	"Vd1cu4mktfk4uoTdsVaGNNw8O2WgNygItIYmfZYKgSzqHAe0zMU6Kw+NFApfBk/6P7iBbdaCZ3YGSXau",
+	"7GMFDT7StIIGf2QLqiGrBvwpFYt5JSvBYrq+0GFF52OiLB4NzeLF0EXETK8DY3ZrdevbeYQ5bzD+YHKD",
+	"x4Ap+lyrCvyzqBWKsmYoMmPJGTTg66CSg58cC5PgQckwRIhrm+7p7une171/X/f+E93dveh//1/3H3u7",
+	"u9OZ9KmCNgrt0znJkPcZyqicznhPEuEm2GF2P7JayuelobxMD9A3U17SjcGSLuc6MBdGMHYOZJ65AXYa",
+	"EMKv2Nav+CrvlB80rq6KIAM6k0HMB7AjHeoWHZCeLRRlnbu3YUjGYEc/9BTeavdK/N2HMWSLzsTccrlT",
+	"9QCew6WTzqyFoS/krAHL8C0ttonRNhca30xvvr5DmApramv5Qn3upwF1X4q3+vVqspTrTfklH0p5azvX",
+	"f9p+vCDoeVZTDDm0K36i7cra1q+LO3OX7Mpa/crkzq2HMJhDN8gKHAbKnc9tQqZyzUWBAwPEVeAG/54W",
+	"bDSdSYs2kWbIGG3nmTx9UoCXzAH9j2KM9MtZTcY0L5//9FS69++xUS89nvFSJoMSrJhHXrZs8xvgRMsP",
+	"QYVza3X7fhWxIUv4jWTMoMBieozpkvqlbBQGT6sff9md7f/LJ0c/6P/4swO5z748cEz7YHg0X/z07N/6",
+	"DhT/dij/3+rg3/7Xp192+y+q56LgDZwcPzmeSX+UU4zPWMjHh9JnHn3PeCZB+w8lXY7s4VscVtHpfWoO",
+	"aW31ZHOeQKyO4EQp6sUnTnTcSKLkDh0Ab9+W/C8h/B7/WP6qyxoM92etUCrq6fGT/kf6KaIIk3ZlvbH4",
+	"BNkiaq4apWyhCbvknGIUtC6QTmQN0Pfhlcbczwhrl0VDVImNHBg6NIJtVuERQpSOU+54RnZbWViMBRs+",
+	"/HHXtqpbT1YRM37DNh83rvxom5fQBOhZRsO0EDCYZgWDxFmqq8LlJURXYkaMNRxsujfZojJpDJYWbgvT",
+	"7dZsa1zwGgJKO0YFbtk8HlPCHYObE0wTDoRP5LPOEpx7Rn/BIrb/YmULo6OEF/Zo6pCmAUk5L5Bm+AUQ",
+	"dM7jhDcMs82sWfzkUc73livikA2ndy5/vXMf1JP1Sxe3F1/Y1uz2xuvtxZsYzW1zxjbvwZNgXkhnQKny",
+	"sawOgwCxv7u7W8TJFQzl1FjQNqjCpFp/eMW2ZpBRZR6/P/jZRrrTFZCAbLN25JhtLuyUb2/NP6KK8qlo",
+	"k4qzNY7XdNSjmTRRiiTVhbCUlAwh4scc6cZ7vjk5lihzGBqOZ9Kjsq6D4aT3fLBcU9t+8mLrZ9Dj7Vyf",
+	"gse8fLF+9Wvb/Bqbp+C/5gJo4O8vbj1cxTBsXL+8vVjGpHPz9QY6BVa75mIGxwSl1IKROlUoqbnIJx1t",
+	"1d1AIJAOF3IR29tarO3cB1eB7fvgykKQxrpCVgyYvg7CAdLel6fqE4/oPUE/m1Xa0BkSFGt/OXHiOGr3",
+	"yq5cIpYW6xXTbam+/Ka+cYc6z5DfU3uGpNwgbFTWjQzAYxDBA83xZG+K2AldBT5lMZlu6Uy6pEolY6Sg",
+	"Kf+Uc1heGlJyORk6OEMC119QT+WVLO5R1ArABIIINSirhmKAIsIoFAZHJXWMjq0jod2QNVXKD+qydkbW",
+	"BmWEjJk0/FPJyoMlVTojKVgWg9ZnpLySG2SFlXSGl12wZOLjiQfZtTrElP2RDg5iDYiBjqxDPyDNWRop",
+	"fbiO/Dzu/MyAeLahQm6M0UQM0o5oNEnVz8p8R8cZzh2JV5LS30+XlH+SAZhfCT4PGvJoMS8ZGIB66dQp",
+	"JavIqjGIJDshnIqlobyijxAoOmPD/LKUl3Ppk4HXjoOMj9iSZ5ZlFPnLhBqk/vpXotNxhPNSSREOyLOP",
+	"mfTHkjpcElIhTKKXGpPXthY3gtzMiARmXUAGz7tIg/qCeE+WTY8OnRL6Gnq+KiC8UHfN7cUyuAyVLVCa",
+	"3vlh++nd1J4vJPBZmPoR/UNW9w6oQfPj98XHVtCh0L0lOkH/EIQPEw5BudAbHgpK7v0XEvoHf7roRx/c",
+	"j2HcOq4FcAcE9wQnTA+AWuE9sCtb9eU32z/eR+9qtf7wNssqYA2oQ7fSGReNIjDDQ/Gd5YloPb+3zwkh",
+	"9G3RuVb+F4Hogb6xK48QEUeHBaT7Bf20HsUTeY51aXPju/qzm45Tg2A0axZeR8Tibz1ZbVy/XH92A/k8",
+	"syj0PZquVn/9fX19xukSfLPC338CqRMEEoypj5GX4ccQMHOdBaD0Xi3yxJatoNuT2iOpaqGkZmXgT8E9",
+	"xneXU3s0eVRRc7K2l0F/thsi07iJUEfyiXw2VAHLaz09N+DOlfrkK+LIxlk0ieO299puXbiPW3v4nXdC",
+	"rclw2O9ju6XDcEfqPD2WX2RzdG2+cG6gE1rEOiF082ubK5M+sLmQOtTNgwh0qY5d9VCUXbUlWtZRRT2K",
+	"++6PIEK8glV0RT6Rzzq6ksSapVhKItq4XzbAeUv/cAxf4pP87LtRbiVax7ulqGLhn1RX5YUesVcJSMUZ",
+	"GosUa01knI+gVwS+ZdIlLe+/Zztlc3Pjfv3iBAlSKlsjhlHUsT7VQ2LQl96uLvLLe9nCaBcoVfcZhS5i",
+	"XfPSgO4ocQsWlaHbDkR7Jfvl8UI+LxBMRyRVlfNEERL+XFizHL9XMe3KA/QKTGOzeHxGUzek0aKAndkx",
+	"VxqTd7et731S2CvkN4feaDwRSL1uaxIwg6jaPZCAyybztbZz7xJwqy5/4Gi3HSyJXHMwaoCMppwuyeQz",
+	"vAY+odgFs7P7gMNi9Vf8WSGJJ8FtoyN9CP2ikFvRB3PIuYx9sIJ0KFgIy1HrbSwyhjyvciKlIPF0qTK8",
+	"am1z7QES9icd3RBosy4+qk/OcbGCZg2JEm5HYj5H3DhWy26uPdpcgSfQqx5GypF6daN+VeSfh2I0fG43",
+	"c7b1DRrbsTNhMQCJCMuuFsuaBXWFOQHqCosqIrCjJ7gUVhx3ILTqxa1rzzEXzjCtjtYCM+iTrArPi1/O",
+	"6WUwlkTg1ocEk4L0o44sjcmC0GXKT6yh9UmRurSgyjEQhF3cCflctKbV2+HjgjqcqNMnpdEhWUvUpV9R",
+	"h/Py4ZGCkpUTdTxWyhtKsamu/Vkpj7gI8hL2j+mGPNpHbTEeQUqTTpNji3MbvfIF6R2APyekYf+Mfja3",
+	"fnWiXtvgn7JDByPYWRE/J1rGp4huHIbgUJGelfzsLOZ9EWdaKFIu0F309ou5xsTVSPUm6UpiaYUrfEtc",
+	"po9F491QPKES2BMTqfvrk/ccaYkYyzkSi0WosrVbgcn3pHrITMQKo5ckMt+gS8PB14c03LwC5YeiD7oY",
+	"IHalrm9c3Lk3AQYK8wloDcwptDL/y4nGIhrMEaz59Q2JbfRk/+aK6zlqzQo82xj7J/BB9FFp3Plhc3WV",
+	"epQubT+egseLvEPuMhEM2TFojxp5lq0LW79cbdy9AwGfyHrnuItsrq4iB16Ljn0buK65S9uLE6SLucRE",
+	"yRAdGfU0w1pB63sSEgH6hHs7F6c3N+7z7sHe/gNqfOuvV/QnsQICG67oqIoFhYgSXhPFMizXWgUPmmcP",
+	"thceUJ/ERezpv3Nxest6JZh/f8DcLAI7IrXQcQlrzYU+fhwZJ8p1duE8HofRrcMFTZOzRh9SUx+XDDAq",
+	"xAPDInJWfgrGGsoewafHM2CCmnmDeHbAKgctsUmm/uYGAMxcJONYswSlwOb3xg3PD7BU/e89/6nsHS78",
+	"m4jEePaEHw899m6A5riCRNnC1F8HpvTqEqwNq11FcfrY4R3pWPEIzJ1ewPumE9XcKZiokB24+i9QMDQ4",
+	"IRCYWbPod8QumlPYhOrywuaGZ/FoFa0DekwNsUgiyqQDHq7euAyit38sJlHUKRaj6O0Yk1n0dkvEMHo7",
+	"J2QafXNTxjGTjhg5MacCJydYnZcbQ5dusODeujhje+6qw6/pIkfrFgnodAY/XRRBj+BCU1Bj8EgMrVHp",
+	"3OAZKV+Sk9LcxrXnyLHPVeu27uK7DF2hhM3HBEwq3Y67fkXd9fqn3sL6ObjHaa+oCdqPx0IsfGObwity",
+	"2X1oBdvKS0NyXnxp2E37+Q3YY0hnFgIRSgK3LTtpvOvG0dDmgOOlwm+TULWXNqFXsSkgOe+pGDjEbaHo",
+	"MoQJYMQzkwTx8kQZcD6G113QVtFb3vR2HU7gnd9yaXRUwlFR/FLJEh3FR5goSTWpOIbR4dCvPedkEKHg",
+	"IZ2RNaEniDNM/dWL+neXwZaIyXhlbWuutjU3R3K+eJdA0lexrKvLUiNNJ7uoA++9H4uG46uCoRFuX/gW",
+	"B9bh5eBo2Pr0zc2VMm4DOW3EvLt4pbF08qzqSuBu41GHRHilBmlgMzxKhAl6AiauN1AVTF0YqIHf0/Fk",
+	"lOKMHyhqWS6HFXM5pEOLl+G8xzFXgdu3ehGe5y/uWthuLV4SfWRiLgU1b8MSKPFPsAzUpYVLca3yzRn0",
+	"TyBVTSKb/BGGpiXqSI0KR0ryEXASUkbl5gb4b0U+C64vH44l639U71ML6thooaQn7XikhENEZfys9uXz",
+	"hbNyLukoxx1fzCb3rStDSh48cBP1/6yk/DNZD8f1MlEvjxeYzvMQqMlhbBToExlP2hS26rWFh0c0BqJ6",
+	"lN7eXfDWDz/sr8/dpYEQV+3K+s7cpc11bMitIZvsN//n5iXb/NW2IBsfioRbdPL4gGbbmq1PzSNTCHEX",
+	"SMXqZlq2+ZDwBpX139bNSHCwu4gBD0NS8u9SYFwAciVDW5L5oM/osGuTyLFJcmLfPCFZbnaf2uZKGSwh",
+	"Zeu39Yn6len63F3XBcGapTa0Gzi6ZQtnmF140Lh7Ff8Ika8QvLJuV27QnFFL9flV23yEzDOPiee045+K",
+	"9Mu/rV/hdbBx7LvBXGYrIgxZT5AgEYSVOTZXVxvXnv+2PmFXHtuVKRKYaj51rVbwFSI6iFuG3yfDsuq1",
+	"+Z3bc3AKaDRkIfyWekbsXJ7efnjZETK2F3+szyzz6WkcmQMGA33S1ermShmtaN22XuJAkf3oMlNrDXhn",
+	"TFBt+m/rE+6u9RRNhryMl4x9xVGsIA0IoW4fZL2QvfMb27yP6MlsfcbaurjgKOvpIbuST5RJiFlLEPA9",
+	"SBsAW8ZF1knx7FX7N4dyjsUzFEUgCdjM1frlVZwBbOvXGSKWxUCFXeHBcgASVLEEWJ+7i9fFyc2W5Rhq",
+	"ePTAM4vn5P3SU97Lk7LNRTilyz/zucBYZ4YI+yCmiKIAPMa36ndLvQK9RPlr4MIhQ8n5ST8ndPRIsOiA",
+	"GjQvhzvdIx/0o+qpQuckh10LAB1+4I/qbCbM8WhoMvKF72wVfVBiv3ouCKIylGCwfhz8FybRIENbqdeh",
+	"ucyHltx22rG+GXDLfrkKxl3gFb9Gb8Q3OO8ZG+jsm1roosj7xpKEarQLivE0J+h40fGxfr9CF2YxwB8g",
+	"pYnOIkebUi2r5Db2Zv4qo9fYpcd0e8QTxed3k3iTgYuJsWdXphRts8h+DU1nCp4rKNek55na/e7cNcTY",
+	"zucoUucjFeR78ZZwLM+g7DYRp+EFF27qK84GDUFoLg7b8XgieZ5f5s3CGRkmUfuHDEfpe1XLJlZ5I0Ni",
+	"FeciZdI5+NaB3fd99SiiYeoBQgzAsqRMBFaDfh8clXeV23jX+MKtJHJnbJQov6k88yWM5jsjjI9HTqaI",
+	"ogeZkh3RNWc8CRqd7LsW4mms18DclK3gSjXe8ELGGMBanH1mP0iEDMZ9wdtzdWJ7cYKdrnHteaRrozdP",
+	"UWJ5DnWjhiUB2w6h2UGWlK2ZNwgYJOtj47v7m2svIbKSZV/9cZckQx/FVJIEahGuLfmb2GVwitIEHKM0",
+	"DOmVAm0rRsGQ8oOanC1ouVZtB0QUZp3OWR3siWQUHVzwrsx3qNwxRN5FnwJQYC904zIHg6NukwR4i2K2",
+	"hQG6fy4AqySfM7roxG70LArG8Cz/iGRIhJaT4FpHyooblGvWIqKYWhX1TQ8//Yc//CF1/vx7iDsfH09B",
+	"XkpOHiIZCGkECY5F7YUuR2Qpl1dUeXx8QD1//j3KrEPlIfLTMVlF8s74uDAFHomxTXis/uD8fx1o6IHW",
+	"+OIZ4HLUqE3Z1iQ6tFFJURV1GGSr8XHEfNxq5jgjX0JGpvInDHBTybZVlc/OE0mbkBFExPicJh8CfcWW",
+	"3Pw/LLfoNgA5yPEwcBzLiB+2Nbu9+MyN4iqbVJtDSxpQWQlYrKlrcJpf3YeOHFPYWqQiqWWF8QFsjhAc",
+	"IjBYUg0lP5iLcKmr+T3j/JjKSK1hQQGetPo0tA0uG6MLc/VDjgde2eLnrEYkYwCF0z3bMlF1IOrAx67L",
+	"dWAmHoCw1odY/8dNK3QLxOF38HhNoL05zPI8A4hlV0rn9V+2NUkzOc+w+0aiiCcjWUcQJJIqiDQ8vYHp",
+	"z3IleRBuO0rRE1FgiOaOJJmrrVlaM2geKtuxAjnsaMZJhuTbOYceT1H9wFaHFsUGE2PIDobSGdJocGgs",
+	"Rq7+/hFJEyfvEA54Mv5SHduzWCgng0vDw5o8DIcKlWREPlBen9p6ecpGWiznDgRF9KT2eMpodp33FBkZ",
+	"76IL0bt0LFrsTWGnKd5R1xeC1Cny6gm/CqOtHKo6CE+plJOkn4WlEywVQlvbQCA7BD4Og2md09akhhRd",
+	"DtuskomY1S9xIHboO6s6qqyxNopWASiaqjBOmh0wz//Lc+h34DnksWC8084UYneIfH6Q2tZEOmymEAOX",
+	"awzTOFo1Cd+sFCggU6yhlgttM5dTiPJwLVJ7uGEDdHCekffGSwU6IumDo2Nu3guv2UFc0zYdMpTGJO8Q",
+	"u0FEDwU2F7YQ4ODQWLhGOKzaIfPypMMyeeRcPtCdrwmJkAOpHyyBu8t4EE1gryWpiPwZD6ThQSWnx7Cv",
+	"YP3j14zmsYZqQTkG8bA3gXSxZoWPQyZZBZDQZwTdRNE24+/Rr2Mlm0ykUY00wKM1nYzezzuXVzyIHYCo",
+	"BRK7XqPh7kso+wCy+OJsytYF1heMokPVNUe+jbTggTsyF307Eiz195AiXLBF785ItSzv/nxoizZLnENi",
+	"IDCNuvfcSPqzz/OUeJvyDqPEn5TfRHSFBDRJ5BKZhGjxDiIoo1pUGpFW5v+gIw+NxXcIInnSkvvmMB25",
+	"mfkSK/5nhwCmmeIdMQGsO8N6bvvrn3Bqqp3pX3ylO9Z2qj/FL91x4NShoZ5st/xB7t+lg0P7s3+U3z/V",
+	"Ix3KfZDtHvp3+eCp/dKB7Pvyvw/9Mddz6qB0KPuB3D3Ukzt46pD0QbZb7hmKRFOyBwq/mPnmIpOuvVu4",
+	"R3NAx1x8UmcyNi9eTEMugXM/9IvtLuesJ8PnpWO25ywl5KoISRK3IN/pJ8m7RJYQD9j+BP24p5PqSbRa",
+	"TlkXzVnVHMVDJkUV0susDiK1Z4C4Gw6k3RLrVARCLky+9ozbIu7kCju+tsSpEdoNqF5VDJ/fcD5MH+If",
+	"mYyBVrD99LmrXOe2po4VVHkgvdfVxy8j4aLGOlQLtTNcYmwMohCXTbKadIZMyafQJr8J8EVc+SOp/mUX",
+	"ORe9CRe9TgiyasiaQ3NaljaR9fFm0yaSbIk3uAwxZZP9nQ7CpE30ZJBCXmn+gZbRe3ODz4lIpVfWyzJS",
+	"CE+UeLJths0W57QU+YZST3avJ2kwLBOUqaFFbOOv3VOSJmgPSK/DZOqnSTn8ucZ4VoSrOIOTQWvxzJfM",
+	"DOHZPij74zXoRS2HNxzjEiG+WAi8eesCowVaCDqqJOEabLHqttrmWeTxXEvPKvhrlYmRTPQkQ23/lUv0",
+	"d5tLlP0AwX5HyaEEJJmIcXykYQC3xU0WFOHvzhUnp06Tk/fjOxO90YDLFXOqjmTZctfcupQzgfvzok2L",
+	"MmHFRV8GcXxLaUnupCQLodfFv5LW5ytKsi6C3L5ltSAzUKuW0aKsPbtdToxyfE2WyhOwlzBVQDbexp1y",
+	"/U0VRUwwrBZnapgnfje4VgetChjOQmZazCCTHbREfImzZYF0En/zbS/1R5HIqZrvO1bKgm19C67lekEz",
+	"nJI7jDzs4cX2hfJm+/h/Qr5uxN/tI3/5Mptk0uf2wUz7zkiaKo0Cfv893U+n6DP6+g+nM+wPRz5Cv7hm",
+	"5D7Pv0kDfLJ9zN/oA3u9+g1NlkZxcY2QxFH4xK3ZflS1bl+/rBop1EmHApAkJzB2ACUZdnOSIaUzotIf",
+	"/nm4AcDL+RaujgnVo7FbfW+q8dWjrZe3kTxRY2PRHSEH+fi71abfc87ILThd9biVcu3BEgSNcRvXLE77",
+	"UluNqG9OzsuhnWmRT6czd/H9axZd7kI2W9K0tgjWydWg0UkF2IOEO4UK/CN6UuNzCURkGohOfMZa9OM8",
+	"N3Hi3SNXLwyF59baE7ZWYT51jOv81At8+BKhn1Ep1XkKia9dRqRdZpFKcKo+QIVRWbC/+CKngYMVhVNB",
+	"LZggmZ6ErEOi5TeoEL1pm4+ZzAE+uuLZGAnYjq9WhA7M4eiJ1YlJFfswITtfGFhbsRpmtMA0hcmJQPN5",
+	"SkJC2qJuZgCJjXkDo+0tnvnZXYaekhD7/xUH6aV7w/6KqHFQOpi2RN08Jo7PuwjxeTKsZghHxCtcHZ43",
+	"tacoqzlFHd6bSTWubGw/nU7tgXK9RUPOge1o+ufNlSkoxwjzoZ+2H5ubG5CDP7VHleWcPggGv2FZ38tZ",
+	"ZMiopPhvEbMIdJR0Js11FVZwDOaBKYLbZZO7D5gnRmhGzWtexpgxNmbS+7h/0QIH++gfoVyyJuflM5Ka",
+	"lWOyx47nJeZunX9Szhfm7HP/jMsxf+4uA0DGV/GJ7bXB9uuks8awJqnJDOaeu8IMIPC5IKWFYoMBu6eJ",
+	"fQETeoaQTs463EDi+GKucDX8AxDIDGIHvSp13JsXBNoKoozhAQozNYQ+RoTfwjvWpNOHsR+AILIknqsD",
+	"rQElIPbGiOCDZ3Fo0MCKT8wC49N2dlcCRgA+Ix+0prc8KoMaMtY6wOkNTXaM9AmGWCBg3AmDQPTnZI+f",
+	"0yUIPEdz4vjh1NEjPCams8WebhGIYJQAT5BTSj62H83ucDAEonQRQRDtT+aO4243AKKABwJ8yxbUwTbA",
+	"Q9EHhwoGAxHGjk5hJTzdSNMiC0Nu9c6kQRD13ISmL59WyMc9a9Q05nqSnbV3MyGnnnxg0WjOSN46ra6v",
+	"D+/P3orkfR6PXd+JuTx32Piux3BJj0E18U6954m7ZtIhHDbxsjwi55UzsjBfvgGpCoTJ4XEqIGt2+8lj",
+	"JP6BR0E8AaR9XFeObCSWyCxrmlDwI6r2GtmhWXOLi5atxsTV+uS8x7uHzcbgIfaiVTqq1yS1lovSWL4g",
+	"5QIPgvixcWXL7codu3LZth6IRUs9QLLCI2KBCnSrRODpTeEPmyvPUnvql6Z3wN3kIkrNOYvKzpX3Qmu9",
+	"lM3Kck7O0fYYZPDplKTk4XfcmViCLIvuwAmHJoW6AqQuZwJACzRi+mTg7gazhZwc45AXGI/g+b+cOHEc",
+	"QfASohQb8Lf1Av6uXEGJt35AX79DfOcrSLW28S147wmKjKHSizxO9ECZ6oBy+Ax6loq5TuSXZq9MxlFP",
+	"UmxzkCTjkgIetPQeZXgBlFl9COEJMHaQr2DPKN/emn8kNkckMS+k9myulBu3LJpvkKpfkO5l71s0PuxL",
+	"caLGe9l8QYcBAn2szCoT4HyFtWGQiyK0YHh2x/5C1uzVSZOViJL/A7dEFGrZgmpIWcMtbAtc0fE0qf7u",
+	"lHAfVoyR0hCq4A7fDcWQsyO0mDsm3Oz5kw+pvuNHB9QB1aW/bIBZ2fTeQ0rtbHMp9REgJR88WblmV54R",
+	"Xb61SHPaLacAjVM0ww4KBnTm8+XOgcqMD1f5fLMksIVdNbjlypqOd3PmAK61IqtSUYHogve63zuYxsIe",
+	"or5doEfS9ROFL2Wsvx2WjbAARsijXiVPUBkKi4J2CKohLsNSCeVHFpzKC5e/cRWYrncf23L78tPN198I",
+	"TRwkmkg4PgO3+tfrtvkCXbtbvhSd7lDIzVzWJNgXSE3pP8tGHwsBj/a/p7ubohp1Ny3iGGWloHZ9oWMX",
+	"efxuxmYcmQkFaksfRjaePaivrID9ncDQ2RR6dkPggwqcQpYmgoou8GHe9/He/L70AbAOXgcCrrmAF4Sv",
+	"1CmplA/kM9zEE+iq4EBLFDT59zSDj0j5VizoIpN0JO5ZswyuClCOJveu+V7UBW+YDvAaVXhdrStb369u",
+	"P52mevhANBtQ2YvimTb1j76SMVLQlH8iLOpNfShLmqylBkrd3QeyBuwc/Sn/I2VXbqLckTjX1wIwLu68",
+	"kNaCn4bNyUkSouvZQlEG6zzNcQ0t+44fhSvpXpk5zue2ZbdtQPXdt+MFnb1wacwQyLpBnVNjX7UIrSN3",
+	"yXjGgxQ59Vz0/S2bnZmaiUULu9kxsNh36flmmAkOGUdAAA6KCMDmylco1xt53mnacCepW3XrGqpoa03i",
+	"sXZFSXybax8lGc/wb13XeeZfR4+M4w0AO9Ky58+a3bpwvz75ql69zlGhNj5nR9AO+AtWlDRpVDaQLB+g",
+	"HHebdHFQOaoeB60w+ISJnsUmsNkFiBehMUYeTIBInIGTAGV36ChYXXsx0p8XVsh/eS8lTnpqzTZWywA8",
+	"81ZeGVWMzbWXqT3Ahlau2dYTFEUG3GpP9+bay72pzbVHmytTotzkNSS6v8IpjPyM2oAabFnmK/27Nm3b",
+	"XITsiez7BdbO/J8GkOV7IL035adJgufiz7LxmTfLajJ8Bl++o+pnJVkLzmPDNpclLTuSoMOX8tjZgpZL",
+	"0MNA2TESdADTdYLm+AQSdEDIk6A9ZCtjcuUk7NnH5hT5cCxRf7VgfHpG1o6UknQakfRjY9SBIGm/IxBe",
+	"k6ATd6EVPWl3ZO37VM2POX1O7lIiiV/HTNGNpsUPnp4ESh2Z9Igs5YhWGQhE0ApJsy7UZny8I8wKn8ot",
+	"gbTzfvcB/4j9n30MC6nNb9+vNm5ZO9e/sc3qAR2A8vIiLNrR05WtzZU1kg318QxI+TNvgMR+NV+fu0ce",
+	"xl08OhxGhohUNH3mBT8saFpk7iT9fD2HTu3j7PlpOszbi2q8RXH2YmB6r5GvFo+341vh4AMuhW8TrWST",
+	"vBjrZ5T8ySzD2Hf/PqgqlGXKQ/MQ0XQ1tKOz71vBXLj3OiTjWzwbbJ4TF+9czH13x0muAIbLKmaV0Ti3",
+	"cN8DcfpGgRmVySEFROJw9PzodHPVDiFmRsyt+xfmeDL7uetIlrfNmPNWqGIQK7F7LDwYq3hPMrGxacag",
+	"ta+2ZGRHkiGbG6BFkI0U6PPLgNbsznd3A8r3LdFxWKVn1a+MQNlCunByENuserPyObe8i61hx6+RjMQm",
+	"T0Ni66SfGNOOJFO8KM/bckyiHkXOP8opbbySreeR/OuNxSQlfEhY+Hf6IbFmWZykR1sVvBwHu98PqiDX",
+	"jmsQSEeSQPJt81JdyPwKs4klBf8WbOsX9DfUb27csuoTa1C/YPpFfXUBpYG2NlcvsXQozgUH3CAdw6/n",
+	"YVjtO8ls+dbfWWYrCsoBzFZHH1EfiN468pPcasfdLD3xboFbZOkN0skuxyrXY82iilq+o3OqXBHpbYHW",
+	"8/nGrjxCQ7FmTX5Mc5mW2fFEWgsqA1mzTC4yPru9YF1LfM3MeeGAyIhxgdHqxrztvh1y115kS8RHJCqr",
+	"9Y6/0Mc4HPscz5DglW7DIsK1GIFHE0nR/BhiVjdXphvPHvx+6VkMuLx9MjaGy4O6IWyhnj0JK4A2Jcke",
+	"45f0e5NrvQVX/x8RcPnjJgW43jkxt3k89QnB0fJdGxG19W9JNI7uRvBrn5jXeUR+5+Ss02z25ZiqRL6I",
+	"+AIb+u5s2U+bBZps77WORcLdfNG/C+pNF9s8qRYwJk1zHEEHVyUHxxYTjyFTReNBp2g4BXOIv+b0zc2V",
+	"8o650pi8CytEFSD3o+qA96DOB3KuxIXocGV1x31doGwomx9+egLVDIcSqTW7YtqVBwgS0+D04hZNvYFk",
+	"GA5O3nr0brFBr3Ziu3wxRFjhClW6MgrOxQ0m8HuXBCoBs4ZBsG19j5+y+sYdj6sNWbojYHlEuyVuP1BQ",
+	"dR26ogSsO+Xb6LgdTCA+oNuLN3eqP7E55+2ytX9zdXW/C42yiUv2eykMmYELOXISgjmfeCBX6bBLxMzo",
+	"OQV4lK/jVZOWlsUHJ4TIfF47c3soUpsM1xw96qTROg4hDK7WG/vxj216RuWOwi+nON1vqMgYenEDafUf",
+	"A2plLXhvWyyiHWJO98OXgt4H6HbR51hsCYeHMdkSpyCln/cIyqXtZpninRscdRXyS7PNRepSWEN8/X2E",
+	"XlaQ2qp+cZHUOHNKZG5825Q7Y1scGN1sTi0iV9E+ZRqT/TChr15TfnPJvd40JtlOOx0dHcT+mPN4bCcP",
+	"6p53Uzxoqx3mdmNg4EuoVrnUR+bC1i93bWty+826bVmIpXKkNkrCWi/yuZWu35ayItyTzlmf34HOv2/M",
+	"f+Eu9Znl7cprJ+k9dRQ0d8yv6l+tBQd1OnSIo62UVNLEnGUTlztzXkliQIWWpm0+dOtVuCVO+HoVDrEn",
+	"9SYiNfVeru1zt8zgO860OSvtMM/GzxtEN7wIFuFUGPQmttSXUEg8NtcebF295PJ4Lu5EW/B3QTYO9vRE",
+	"FIs2q251dMKThpXpdzhDrqQxysjHc6+xbQjOVX7nfCqZAXWUHzg5P2jWSDB8Zc0p20hrM4qzCKNYlO9B",
+	"jofcBw/tyj3bXKKZgRmmkqIRrSdIBXouCJIK6y/Wt58+85EpJpfwkmNwDUoqbFuzwrysKCYTibjmdwhj",
+	"qk7lfMBKonO85iKK+cI2lwR5l2EveCx3hf39H9lmDSVGQEE9DxB8l3DwuGgM2thcJKlV0ZOOXMzZFATO",
+	"8SzzkHVYbwwaICwb3yEtDSC4XTYPdG8tzNom1i0socQU9zEoIC7Ot4P6zA00enV74zUSkB4i7bynirrr",
+	"O4L1QPWJy43rz+h7VcPqifqlabIoXxL3hJw3hlfnFJiGfM7oQseyz71GyR4B5ohF7wE5RLgMU/WFKZbx",
+	"ape3ie/+tZWMCymob9fvJOF00+zG9RUWpu+wZrHO1Ll7oOhYnMBbdCq5iYL3FN0p6jwoDQ9r8jCUjwbp",
+	"DqlQS7IoJBDqw4FXJR04lMouC20JAyrrfknFhiXsWeLuCpeIQiSB7rCG9+ZMHlAxIe51JyfwOzBY+Nb8",
+	"dkzMIs2aFxHewnXnkeLtiHyxbj+pX5tEfeZkHQrNmMLcp13a8f6HLvEduRWJsoZ7ivTuPoGKC/2wfCkB",
+	"XkrsmSQJX6GTdgqVCVaG6C2C0dKaxWWMI7GRNvO8BDLl7rnCpxwjXd3+5VfyuCAVRUnLIw6TbVP7r/5P",
+	"P7HN6vFP+0/4VB5B+edss/aXY32H9/X/pa/n/UPYfsgWZXbzvFhTuDYykLnpXxjz2cRW7Qa2Jv7jb/v6",
+	"INXSicK+fmVYlYySJvem9BGp5/1Df8IJXPYf2in/BMkIhTlclqjZgH2ie86do++jLx2NdcEVhCCFwWMi",
+	"XzFumiS8FITTScRlP2aS3CVQytAr9TswpIkIwVsMBPVXHg8jQ/5b5SVIDh7itC5uh47rbVpK33zbbQ99",
+	"S/RId50nfyUMImWJoz+cFKd2rP/4qPHs5yDrtvg5bzbAtMWXN9qY4oCtuWgJP/RiO+DEwkkhA0onTcR4",
+	"ih5q75LfMUTuIpk1lRAbLsP0cMg665gvsA8LeHs8XNjfDVlkOsGTHnGX/s7hcmv5WW8G5FawstxZtouf",
+	"bd3d8qJehzlhuGBuy9GxzyM9H6gfcg3gaL7iXA34REqi+BmqN2XqWTluCOAi9xpqV8fzrD821rwrwbuW",
+	"UMhzb/VdOBt0RD0jrvHUzHV1yychDg0VFpl2MMrRBfxu8ug410N4Jdp6q+k337U+T/+M4PBcyyTPzgVw",
+	"X02bsN3lNMc5+dbJIVVckcA10JHDji0S+OYP0wVGJfYLshpS4yxsDa2Xt7EGmXuF0d6B5l5mRAE0Qx8u",
+	"9xA7iNcB+UZCfO9EL0f7EbeDDhBJleDMubXD0hWG7hG4+y54OHG4FhCJ5WKbL7JqQI3nWIQsQCQHw0KA",
+	"o29tcw3UYZurq4wRzHLdSLzBPAFKLojtajXCtydjRzJHo3gPhCh4q5MPROwkHe/i69BBZ6C38poEc0ld",
+	"TO6DkhG3/KWIIsSjB1RtIL7TasFQTjHGascbkpsddbetGVwCBav2HV8e21whwUJHjjGVPQSJkZjQlWXa",
+	"LEZac/YC49Khvw9i8zkT+9+5zAOi2RPGn+LfSSVuHgs7riNvLuykVRwCv/nORLt6qIjuFjoNM3r/Susa",
+	"3WPj6UIN3uIuCbWM/ezyOqHY4wu/tqLAhxgOTWj3hAMly7ooGqJTDCqDaWGGbNY9yZoVL5mP+Qxos8yq",
+	"+WKlsyRv2/2YSLy98bo+eS8KicFUyyJV2yyrHOZ21qDqmzowzZYYAX2QJJeH/u7k1Q3q3nHLapK7KAxT",
+	"bNz4PhAgjlDb3C32QbN9t9j7gnSdN6DEZYQ51uPnHXDJnEtZn7luW5ONlxOJ3hfaxa2P4xTvCyIYvl7h",
+	"pTr4W52MY8RgajZZXXyAzcW0yibD54OxhwgU5/4YUlwx0fkkvCesIMM8e/5x23dh8JcgVot7tHDR8kUS",
+	"ogBU6y76by1+PfPYusUTsKxOsFhc8ffW8FgYGM2UTEM9O8UCGdJwvCz+ZFUxk/dDXfy2sRUweIfZCWfK",
+	"QALogU9kPn7Svr2MgoCk4UgeCKG/Ms3eWvz687TxVgIsbWu8GcZSSqm6zqOiNxEOVmSBouT8vjyUhGKh",
+	"tAvWFO1rgQ8l52LlvwS+OnnhTEACfyx8hRK+4dJw80+4B2AJHKg8PZtU0BJETJQJ1kcr2+lK5dDKoNzp",
+	"hOaTyyXSYLYAhURqTaEGsTUI9HYpeDyMTZpVroVSl2cJ7UD9jpLxtir6GDKuSae7siOSqsr5YM5TnJIr",
+	"RMUnZCI16fRhOlMb1dDcPM1573DbbIp5DIBUh3hJTTrNnu+wVigVo0/Xeg67hbf4RhNH+2c8SZsPlszS",
+	"nCjg7q85gUAAn7dzoDgfXfSBulmumjjQfjxJmw+UzNLcgbr7a+5ABfB5Owda0mUt+jwZ9qSJ8/wrmqPN",
+	"x4knaY7qcqq/JkiuADpv8TS7RuXAA/UFtXFbh+NOWFrKAf0xuRMnvAvnLZF9FOUj4oFQRRHkKK8E64fF",
+	"12eMwAIHhp3FgvFMWkcpUDCjz6+vqBVypSz6RyZd0vLp3vSIYRR7u7okCEA0Cu8ZmlR874til1RUkKc0",
+	"3z8nn5HzheIoHKV4gH05+QwaxFDeM+TsSMBAUr44IqX25ORivjAm51IFNaUWZH2kcDYr6fJ/pKSsUZLy",
+	"qZKWTyl6CqbQ9wbNiMbCC4cBAmYcko1WTQhDRc6XL2SlvHcE9ONIQTd69x/oOYB7nnTO8HxaleDaegLE",
+	"xzPOB8dEzvwG3DTzT50zEzs/s1WimZ/POgHPzOROXk9mEsCu8ZPj/3cAG0N9YPI3AQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
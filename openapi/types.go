@@ -12,6 +12,56 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AccessTokenScope.
+const (
+	QuestionnairesRead  AccessTokenScope = "questionnaires:read"
+	QuestionnairesWrite AccessTokenScope = "questionnaires:write"
+	ResponsesRead       AccessTokenScope = "responses:read"
+	ResponsesWrite      AccessTokenScope = "responses:write"
+)
+
+// Defines values for ErrorCode.
+const (
+	AccessTokenExpired          ErrorCode = "access_token_expired"
+	BadRequest                  ErrorCode = "bad_request"
+	Conflict                    ErrorCode = "conflict"
+	Forbidden                   ErrorCode = "forbidden"
+	InsufficientScope           ErrorCode = "insufficient_scope"
+	InternalServerError         ErrorCode = "internal_server_error"
+	InvalidAccessToken          ErrorCode = "invalid_access_token"
+	InvalidCursor               ErrorCode = "invalid_cursor"
+	InvalidMessageTemplate      ErrorCode = "invalid_message_template"
+	InvalidQuizAnswer           ErrorCode = "invalid_quiz_answer"
+	InvalidResTimeLimit         ErrorCode = "invalid_res_time_limit"
+	InvalidResponseBody         ErrorCode = "invalid_response_body"
+	InvalidReviewStatus         ErrorCode = "invalid_review_status"
+	InvalidTitle                ErrorCode = "invalid_title"
+	NotFound                    ErrorCode = "not_found"
+	QuestionnaireExpired        ErrorCode = "questionnaire_expired"
+	QuestionnaireNotFound       ErrorCode = "questionnaire_not_found"
+	QuestionnaireNotPublished   ErrorCode = "questionnaire_not_published"
+	QuizAnswerRevealed          ErrorCode = "quiz_answer_revealed"
+	RequiredQuestionNotAnswered ErrorCode = "required_question_not_answered"
+	ResponseNotFound            ErrorCode = "response_not_found"
+	ServiceUnavailable          ErrorCode = "service_unavailable"
+	TagNotFound                 ErrorCode = "tag_not_found"
+	TooManyRequests             ErrorCode = "too_many_requests"
+	Unauthorized                ErrorCode = "unauthorized"
+	UnprocessableEntity         ErrorCode = "unprocessable_entity"
+)
+
+// Defines values for Language.
+const (
+	En Language = "en"
+	Ja Language = "ja"
+)
+
+// Defines values for MessageTemplateType.
+const (
+	Announcement MessageTemplateType = "announcement"
+	Reminder     MessageTemplateType = "reminder"
+)
+
 // Defines values for QuestionSettingsMultipleChoiceQuestionType.
 const (
 	QuestionSettingsMultipleChoiceQuestionTypeMultipleChoice QuestionSettingsMultipleChoiceQuestionType = "MultipleChoice"
@@ -77,6 +127,8 @@ const (
 	Admins      ResShareType = "admins"
 	Anyone      ResShareType = "anyone"
 	Respondents ResShareType = "respondents"
+	Targets     ResShareType = "targets"
+	Viewers     ResShareType = "viewers"
 )
 
 // Defines values for ResponseBodyMultipleChoiceQuestionType.
@@ -119,95 +171,348 @@ const (
 	ResponseSortTypeTraqIdDESC      ResponseSortType = "-traqid"
 )
 
+// Defines values for ReviewStatus.
+const (
+	ReviewStatusAccepted     ReviewStatus = "accepted"
+	ReviewStatusNeedsChanges ReviewStatus = "needs_changes"
+	ReviewStatusPending      ReviewStatus = "pending"
+	ReviewStatusRejected     ReviewStatus = "rejected"
+)
+
 // Defines values for SortType.
 const (
 	SortTypeCreatedAtASC   SortType = "created_at"
 	SortTypeCreatedAtDESC  SortType = "-created_at"
 	SortTypeModifiedAtASC  SortType = "modified_at"
 	SortTypeModifiedAtDESC SortType = "-modified_at"
+	SortTypeRelevance      SortType = "relevance"
 	SortTypeTitleASC       SortType = "title"
 	SortTypeTitleDESC      SortType = "-title"
 )
 
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEvent.
+const (
+	QuestionnaireClosed WebhookEvent = "questionnaire.closed"
+	ResponseDeleted     WebhookEvent = "response.deleted"
+	ResponseEdited      WebhookEvent = "response.edited"
+	ResponseSubmitted   WebhookEvent = "response.submitted"
+)
+
+// AccessToken defines model for AccessToken.
+type AccessToken struct {
+	AccessTokenId int                `json:"access_token_id"`
+	CreatedAt     time.Time          `json:"created_at"`
+	ExpiresAt     *time.Time         `json:"expires_at"`
+	LastUsedAt    *time.Time         `json:"last_used_at"`
+	Name          string             `json:"name"`
+	RateLimit     int                `json:"rate_limit"`
+	Scopes        []AccessTokenScope `json:"scopes"`
+}
+
+// AccessTokenScope アクセストークンで操作できる範囲
+// - questionnaires:read: アンケートとタグの閲覧
+// - questionnaires:write: アンケートとタグの作成・編集・削除
+// - responses:read: 回答の閲覧
+// - responses:write: 回答の作成・編集・削除
+type AccessTokenScope string
+
+// AccessTokenWithSecret defines model for AccessTokenWithSecret.
+type AccessTokenWithSecret struct {
+	AccessTokenId int                `json:"access_token_id"`
+	CreatedAt     time.Time          `json:"created_at"`
+	ExpiresAt     *time.Time         `json:"expires_at"`
+	LastUsedAt    *time.Time         `json:"last_used_at"`
+	Name          string             `json:"name"`
+	RateLimit     int                `json:"rate_limit"`
+	Scopes        []AccessTokenScope `json:"scopes"`
+
+	// Token アクセストークン。この値は発行時にのみ返されます
+	Token string `json:"token"`
+}
+
 // EditQuestionnaire defines model for EditQuestionnaire.
 type EditQuestionnaire struct {
-	Admin       *UsersAndGroups `json:"admin,omitempty"`
-	Description string          `json:"description"`
+	// Admin オーナー権限の管理者。admin/editor/viewerの変更にはオーナー権限が必要。
+	// adminがnullでない場合、editor/viewerがnullであればそれぞれ空として扱う。
+	Admin *UsersAndGroups `json:"admin,omitempty"`
+
+	// AnnouncementTemplate traQに投稿するアンケートの作成のメッセージのテンプレート。Goのtext/templateの形式で、MessageTemplateDataの値を使える。
+	// 空文字列の場合は既定の形式のメッセージを投稿する。アンケートの編集時にnullの場合は変更しない。
+	AnnouncementTemplate *string `json:"announcement_template,omitempty"`
+	Description          string  `json:"description"`
+
+	// Editor 編集権限の管理者。adminがnullの場合は指定できない。
+	Editor *UsersAndGroups `json:"editor,omitempty"`
 
 	// IsAnonymous 匿名回答かどうか
+	// 匿名回答の場合、回答者のtraQ IDは保存されず、回答と回答者を結びつけることはできない
+	// 匿名回答のアンケートではスタンプによる回答は使えない
 	IsAnonymous bool `json:"is_anonymous"`
 
 	// IsDuplicateAnswerAllowed 一人が複数回回答できるかどうか
 	IsDuplicateAnswerAllowed bool `json:"is_duplicate_answer_allowed"`
 
 	// IsPublished アンケートが公開されているかどうか
-	IsPublished     bool       `json:"is_published"`
+	IsPublished bool `json:"is_published"`
+
+	// IsQuiz クイズにするかどうか。クイズでは質問に正解と配点を設定でき、提出された回答は自動で採点される。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsQuiz *bool `json:"is_quiz,omitempty"`
+
+	// IsQuizAnswerHiddenUntilDue クイズの得点と正解を回答期限まで回答者に見せないかどうか。falseの場合は回答を提出した時点で見せる。回答期限がない場合はアンケートを締め切るまで見せない。
+	// 運営にはいつでも見せる。得点と正解を見られるようになった回答者は、回答を編集したり新しく提出したりできない。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsQuizAnswerHiddenUntilDue *bool `json:"is_quiz_answer_hidden_until_due,omitempty"`
+
+	// IsResponseAggregateOnly 運営以外には個々の回答を見せず、集計結果 (/questionnaires/{questionnaireID}/responses/summary) のみを見せるかどうか。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsResponseAggregateOnly *bool `json:"is_response_aggregate_only,omitempty"`
+
+	// IsResponseHiddenUntilDue 回答期限を過ぎるまで、運営以外には結果を見せないかどうか。回答期限がない場合はアンケートを締め切るまで見せない。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsResponseHiddenUntilDue *bool `json:"is_response_hidden_until_due,omitempty"`
+
+	// Language traQに投稿するアンケートの作成やリマインダー、審査状況の通知のメッセージの言語。日本語 (ja), 英語 (en)
+	// アンケートの作成時にnullの場合は日本語にする。アンケートの編集時にnullの場合は変更しない。
+	Language        *Language  `json:"language,omitempty"`
 	QuestionnaireId int        `json:"questionnaire_id"`
 	Questions       []Question `json:"questions"`
 
+	// ReminderTemplate traQに投稿するリマインダーのメッセージのテンプレート。Goのtext/templateの形式で、MessageTemplateDataの値を使える。
+	// 空文字列の場合は既定の形式のメッセージを投稿する。アンケートの編集時にnullの場合は変更しない。
+	ReminderTemplate *string `json:"reminder_template,omitempty"`
+
 	// ResponseDueDateTime 回答期限。この日時を過ぎたら回答できなくなる。nullの場合は回答期限なし。
 	ResponseDueDateTime *time.Time `json:"response_due_date_time,omitempty"`
 
-	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents") 誰でも見られる ("anyone")
-	ResponseViewableBy ResShareType    `json:"response_viewable_by"`
-	Target             *UsersAndGroups `json:"target,omitempty"`
-	Title              string          `json:"title"`
+	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents"), 対象者は見られる ("targets"),
+	// response_viewersに指定したユーザー・グループは見られる ("viewers"), 誰でも見られる ("anyone")
+	// 運営はどの場合でも結果を見られる
+	ResponseViewableBy ResShareType `json:"response_viewable_by"`
+
+	// ResponseViewers response_viewable_byがviewersの場合に結果を見られるユーザー・グループ。
+	// アンケートの編集時にnullの場合は変更しない。
+	ResponseViewers *UsersAndGroups `json:"response_viewers,omitempty"`
+
+	// TagIds アンケートに付けるタグのIDの一覧。編集時にnullの場合はタグを変更しない。
+	TagIds *[]int          `json:"tag_ids,omitempty"`
+	Target *UsersAndGroups `json:"target,omitempty"`
+	Title  string          `json:"title"`
+
+	// Viewer 閲覧権限の管理者。adminがnullの場合は指定できない。
+	Viewer *UsersAndGroups `json:"viewer,omitempty"`
 }
 
 // EditQuestionnaireTargetsAndAdmins defines model for EditQuestionnaireTargetsAndAdmins.
 type EditQuestionnaireTargetsAndAdmins struct {
-	Admin  *UsersAndGroups `json:"admin,omitempty"`
+	// Admin オーナー権限の管理者。admin/editor/viewerの変更にはオーナー権限が必要。
+	// adminがnullでない場合、editor/viewerがnullであればそれぞれ空として扱う。
+	Admin *UsersAndGroups `json:"admin,omitempty"`
+
+	// Editor 編集権限の管理者。adminがnullの場合は指定できない。
+	Editor *UsersAndGroups `json:"editor,omitempty"`
 	Target *UsersAndGroups `json:"target,omitempty"`
+
+	// Viewer 閲覧権限の管理者。adminがnullの場合は指定できない。
+	Viewer *UsersAndGroups `json:"viewer,omitempty"`
 }
 
 // EditResponse defines model for EditResponse.
 type EditResponse struct {
-	Body       []NewResponseBody `json:"body"`
-	IsDraft    bool              `json:"is_draft"`
-	ResponseId *int              `json:"response_id,omitempty"`
+	Body    []NewResponseBody `json:"body"`
+	IsDraft bool              `json:"is_draft"`
+
+	// Respondent 運営が対象者の代わりに回答を入力する場合の、対象者のtraQ ID。
+	// editor以上の権限が必要で、匿名のアンケートでは指定できません。
+	// 回答の変更時は回答者を変えられないため、元の回答者と異なる場合はエラーになります。
+	Respondent *TraqId `json:"respondent,omitempty"`
+	ResponseId *int    `json:"response_id,omitempty"`
 }
 
+// EditResponseReview defines model for EditResponseReview.
+type EditResponseReview struct {
+	// Comment 審査のコメント。指定しない場合はコメントを削除します。
+	Comment *string `json:"comment,omitempty"`
+
+	// Notify 審査状況が変わった時に回答者へtraQのDMで通知するか。デフォルトはfalse。
+	Notify *bool `json:"notify,omitempty"`
+
+	// Status 回答の審査状況。未審査 (pending), 承認 (accepted), 却下 (rejected), 要修正 (needs_changes)
+	Status ReviewStatus `json:"status"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	// Code エラーの種類を表す変わらないコード。
+	// 個別のコードがないエラーは、HTTPのステータスコードに対応するコード (bad_request, not_foundなど) になる。
+	Code ErrorCode `json:"code"`
+
+	// Message エラーの詳細。開発者向けのもので、表示する文言には使わない。
+	Message string `json:"message"`
+}
+
+// ErrorCode エラーの種類を表す変わらないコード。
+// 個別のコードがないエラーは、HTTPのステータスコードに対応するコード (bad_request, not_foundなど) になる。
+type ErrorCode string
+
 // Groups defines model for Groups.
 type Groups = []openapi_types.UUID
 
+// Language traQに投稿するアンケートの作成やリマインダー、審査状況の通知のメッセージの言語。日本語 (ja), 英語 (en)
+// アンケートの作成時にnullの場合は日本語にする。アンケートの編集時にnullの場合は変更しない。
+type Language string
+
+// MessagePreview defines model for MessagePreview.
+type MessagePreview struct {
+	// Messages 投稿されるメッセージ。対象者が多い場合は複数になる
+	Messages []string `json:"messages"`
+}
+
+// MessagePreviewRequest defines model for MessagePreviewRequest.
+type MessagePreviewRequest struct {
+	// Template プレビューするテンプレート。指定しない場合はアンケートに保存されたテンプレートを使う。空文字列の場合は既定の形式を使う
+	Template *string `json:"template,omitempty"`
+
+	// Type メッセージの種類。アンケートの作成 (announcement), リマインダー (reminder)
+	Type MessageTemplateType `json:"type"`
+}
+
+// MessageTemplateType メッセージの種類。アンケートの作成 (announcement), リマインダー (reminder)
+type MessageTemplateType string
+
+// NewAccessToken defines model for NewAccessToken.
+type NewAccessToken struct {
+	// ExpiresAt 有効期限。未定義またはnullの場合は無期限
+	ExpiresAt *time.Time `json:"expires_at"`
+	Name      string     `json:"name"`
+
+	// RateLimit 1分あたりのリクエスト数の上限。未定義の場合は60
+	RateLimit *int               `json:"rate_limit,omitempty"`
+	Scopes    []AccessTokenScope `json:"scopes"`
+}
+
 // NewQuestion defines model for NewQuestion.
 type NewQuestion struct {
 	Description string `json:"description"`
 
 	// IsRequired 回答必須かどうか
-	IsRequired bool   `json:"is_required"`
-	Title      string `json:"title"`
-	union      json.RawMessage
+	IsRequired bool `json:"is_required"`
+
+	// IsResponseHidden この質問への回答をアンケートのオーナーと回答者本人以外に見せないかどうか。
+	// オーナー以外の運営や結果を閲覧できる人にも見せず、集計結果にも含めない。Webhookやリアルタイム配信の回答にも含めない。
+	// 変更にはオーナー権限が必要。nullの場合はfalseとして扱う。
+	IsResponseHidden *bool `json:"is_response_hidden,omitempty"`
+
+	// Points クイズで正解したときの配点。nullの場合は1として扱う。
+	Points *int   `json:"points,omitempty"`
+	Title  string `json:"title"`
+	union  json.RawMessage
 }
 
 // NewQuestionnaire defines model for NewQuestionnaire.
 type NewQuestionnaire struct {
-	Admin       UsersAndGroups `json:"admin"`
-	Description string         `json:"description"`
+	// Admin オーナー権限の管理者。アンケートの編集・結果の閲覧に加え、削除や管理者の変更ができる。
+	Admin UsersAndGroups `json:"admin"`
+
+	// AnnouncementTemplate traQに投稿するアンケートの作成のメッセージのテンプレート。Goのtext/templateの形式で、MessageTemplateDataの値を使える。
+	// 空文字列の場合は既定の形式のメッセージを投稿する。アンケートの編集時にnullの場合は変更しない。
+	AnnouncementTemplate *string `json:"announcement_template,omitempty"`
+	Description          string  `json:"description"`
+
+	// Editor 編集権限の管理者。アンケートの編集と結果の閲覧ができる。
+	Editor *UsersAndGroups `json:"editor,omitempty"`
 
 	// IsAnonymous 匿名回答かどうか
+	// 匿名回答の場合、回答者のtraQ IDは保存されず、回答と回答者を結びつけることはできない
+	// 匿名回答のアンケートではスタンプによる回答は使えない
 	IsAnonymous bool `json:"is_anonymous"`
 
 	// IsDuplicateAnswerAllowed 一人が複数回回答できるかどうか
 	IsDuplicateAnswerAllowed bool `json:"is_duplicate_answer_allowed"`
 
 	// IsPublished アンケートが公開されているかどうか
-	IsPublished bool          `json:"is_published"`
-	Questions   []NewQuestion `json:"questions"`
+	IsPublished bool `json:"is_published"`
+
+	// IsQuiz クイズにするかどうか。クイズでは質問に正解と配点を設定でき、提出された回答は自動で採点される。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsQuiz *bool `json:"is_quiz,omitempty"`
+
+	// IsQuizAnswerHiddenUntilDue クイズの得点と正解を回答期限まで回答者に見せないかどうか。falseの場合は回答を提出した時点で見せる。回答期限がない場合はアンケートを締め切るまで見せない。
+	// 運営にはいつでも見せる。得点と正解を見られるようになった回答者は、回答を編集したり新しく提出したりできない。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsQuizAnswerHiddenUntilDue *bool `json:"is_quiz_answer_hidden_until_due,omitempty"`
+
+	// IsResponseAggregateOnly 運営以外には個々の回答を見せず、集計結果 (/questionnaires/{questionnaireID}/responses/summary) のみを見せるかどうか。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsResponseAggregateOnly *bool `json:"is_response_aggregate_only,omitempty"`
+
+	// IsResponseHiddenUntilDue 回答期限を過ぎるまで、運営以外には結果を見せないかどうか。回答期限がない場合はアンケートを締め切るまで見せない。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsResponseHiddenUntilDue *bool `json:"is_response_hidden_until_due,omitempty"`
+
+	// Language traQに投稿するアンケートの作成やリマインダー、審査状況の通知のメッセージの言語。日本語 (ja), 英語 (en)
+	// アンケートの作成時にnullの場合は日本語にする。アンケートの編集時にnullの場合は変更しない。
+	Language  *Language     `json:"language,omitempty"`
+	Questions []NewQuestion `json:"questions"`
+
+	// ReminderTemplate traQに投稿するリマインダーのメッセージのテンプレート。Goのtext/templateの形式で、MessageTemplateDataの値を使える。
+	// 空文字列の場合は既定の形式のメッセージを投稿する。アンケートの編集時にnullの場合は変更しない。
+	ReminderTemplate *string `json:"reminder_template,omitempty"`
 
 	// ResponseDueDateTime 回答期限。この日時を過ぎたら回答できなくなる。nullの場合は回答期限なし。
 	ResponseDueDateTime *time.Time `json:"response_due_date_time,omitempty"`
 
-	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents") 誰でも見られる ("anyone")
-	ResponseViewableBy ResShareType   `json:"response_viewable_by"`
-	Target             UsersAndGroups `json:"target"`
-	Title              string         `json:"title"`
+	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents"), 対象者は見られる ("targets"),
+	// response_viewersに指定したユーザー・グループは見られる ("viewers"), 誰でも見られる ("anyone")
+	// 運営はどの場合でも結果を見られる
+	ResponseViewableBy ResShareType `json:"response_viewable_by"`
+
+	// ResponseViewers response_viewable_byがviewersの場合に結果を見られるユーザー・グループ。
+	// アンケートの編集時にnullの場合は変更しない。
+	ResponseViewers *UsersAndGroups `json:"response_viewers,omitempty"`
+
+	// TagIds アンケートに付けるタグのIDの一覧。編集時にnullの場合はタグを変更しない。
+	TagIds *[]int         `json:"tag_ids,omitempty"`
+	Target UsersAndGroups `json:"target"`
+	Title  string         `json:"title"`
+
+	// Viewer 閲覧権限の管理者。アンケートの結果の閲覧のみができる。
+	Viewer *UsersAndGroups `json:"viewer,omitempty"`
+}
+
+// NewQuestionnaireWebhook defines model for NewQuestionnaireWebhook.
+type NewQuestionnaireWebhook struct {
+	Events []WebhookEvent `json:"events"`
+
+	// Url 送信先のURL。httpsのみ
+	Url string `json:"url"`
+}
+
+// NewQuickPoll defines model for NewQuickPoll.
+type NewQuickPoll struct {
+	// ChannelId メッセージを投稿するチャンネルのUUID
+	ChannelId openapi_types.UUID `json:"channel_id"`
+
+	// Stamps 選択肢に対応するスタンプのUUID。選択肢と同じ数だけ、選択肢の順に指定します
+	Stamps []openapi_types.UUID `json:"stamps"`
 }
 
 // NewResponse defines model for NewResponse.
 type NewResponse struct {
 	Body    []NewResponseBody `json:"body"`
 	IsDraft bool              `json:"is_draft"`
+
+	// Respondent 運営が対象者の代わりに回答を入力する場合の、対象者のtraQ ID。
+	// editor以上の権限が必要で、匿名のアンケートでは指定できません。
+	// 回答の変更時は回答者を変えられないため、元の回答者と異なる場合はエラーになります。
+	Respondent *TraqId `json:"respondent,omitempty"`
 }
 
 // NewResponseBody defines model for NewResponseBody.
@@ -216,6 +521,23 @@ type NewResponseBody struct {
 	union      json.RawMessage
 }
 
+// NewSystemAdmin defines model for NewSystemAdmin.
+type NewSystemAdmin struct {
+	// TraqId traQ ID
+	TraqId TraqId `json:"traq_id"`
+}
+
+// NewTag defines model for NewTag.
+type NewTag struct {
+	Name string `json:"name"`
+}
+
+// OptionCount defines model for OptionCount.
+type OptionCount struct {
+	Count  int    `json:"count"`
+	Option string `json:"option"`
+}
+
 // Question defines model for Question.
 type Question struct {
 	// CreatedAt 質問を追加または編集する場合はnull。
@@ -225,6 +547,14 @@ type Question struct {
 	// IsRequired 回答必須かどうか
 	IsRequired bool `json:"is_required"`
 
+	// IsResponseHidden この質問への回答をアンケートのオーナーと回答者本人以外に見せないかどうか。
+	// オーナー以外の運営や結果を閲覧できる人にも見せず、集計結果にも含めない。Webhookやリアルタイム配信の回答にも含めない。
+	// 変更にはオーナー権限が必要。nullの場合はfalseとして扱う。
+	IsResponseHidden *bool `json:"is_response_hidden,omitempty"`
+
+	// Points クイズで正解したときの配点。nullの場合は1として扱う。
+	Points *int `json:"points,omitempty"`
+
 	// QuestionId 質問を追加する場合はnull。
 	QuestionId *int   `json:"question_id,omitempty"`
 	Title      string `json:"title"`
@@ -236,10 +566,26 @@ type QuestionBase struct {
 	Description string `json:"description"`
 
 	// IsRequired 回答必須かどうか
-	IsRequired bool   `json:"is_required"`
-	Title      string `json:"title"`
+	IsRequired bool `json:"is_required"`
+
+	// IsResponseHidden この質問への回答をアンケートのオーナーと回答者本人以外に見せないかどうか。
+	// オーナー以外の運営や結果を閲覧できる人にも見せず、集計結果にも含めない。Webhookやリアルタイム配信の回答にも含めない。
+	// 変更にはオーナー権限が必要。nullの場合はfalseとして扱う。
+	IsResponseHidden *bool `json:"is_response_hidden,omitempty"`
+
+	// Points クイズで正解したときの配点。nullの場合は1として扱う。
+	Points *int   `json:"points,omitempty"`
+	Title  string `json:"title"`
 }
 
+// QuestionCorrectAnswerPattern クイズで正解とみなす回答の正規表現。運営以外には、得点と正解を見せるまで返さない。
+type QuestionCorrectAnswerPattern = string
+
+// QuestionCorrectOptions クイズで正解とする選択肢。optionsに含まれるものを指定する。
+// 複数選択の質問では、正解の選択肢をすべて選び、それ以外を選ばなかった場合のみ正解とする。
+// 運営以外には、得点と正解を見せるまで返さない。
+type QuestionCorrectOptions = []string
+
 // QuestionSettingsByType defines model for QuestionSettingsByType.
 type QuestionSettingsByType struct {
 	union json.RawMessage
@@ -247,8 +593,12 @@ type QuestionSettingsByType struct {
 
 // QuestionSettingsMultipleChoice defines model for QuestionSettingsMultipleChoice.
 type QuestionSettingsMultipleChoice struct {
-	Options      []string                                   `json:"options"`
-	QuestionType QuestionSettingsMultipleChoiceQuestionType `json:"question_type"`
+	// CorrectOptions クイズで正解とする選択肢。optionsに含まれるものを指定する。
+	// 複数選択の質問では、正解の選択肢をすべて選び、それ以外を選ばなかった場合のみ正解とする。
+	// 運営以外には、得点と正解を見せるまで返さない。
+	CorrectOptions *QuestionCorrectOptions                    `json:"correct_options,omitempty"`
+	Options        []string                                   `json:"options"`
+	QuestionType   QuestionSettingsMultipleChoiceQuestionType `json:"question_type"`
 }
 
 // QuestionSettingsMultipleChoiceQuestionType defines model for QuestionSettingsMultipleChoice.QuestionType.
@@ -256,9 +606,14 @@ type QuestionSettingsMultipleChoiceQuestionType string
 
 // QuestionSettingsNumber defines model for QuestionSettingsNumber.
 type QuestionSettingsNumber struct {
-	MaxValue     *float64                           `json:"max_value,omitempty"`
-	MinValue     *float64                           `json:"min_value,omitempty"`
-	QuestionType QuestionSettingsNumberQuestionType `json:"question_type"`
+	// CorrectMaxValue クイズで正解とみなす数値の上限。運営以外には、得点と正解を見せるまで返さない。
+	CorrectMaxValue *float64 `json:"correct_max_value,omitempty"`
+
+	// CorrectMinValue クイズで正解とみなす数値の下限。運営以外には、得点と正解を見せるまで返さない。
+	CorrectMinValue *float64                           `json:"correct_min_value,omitempty"`
+	MaxValue        *float64                           `json:"max_value,omitempty"`
+	MinValue        *float64                           `json:"min_value,omitempty"`
+	QuestionType    QuestionSettingsNumberQuestionType `json:"question_type"`
 }
 
 // QuestionSettingsNumberQuestionType defines model for QuestionSettingsNumber.QuestionType.
@@ -278,8 +633,12 @@ type QuestionSettingsScaleQuestionType string
 
 // QuestionSettingsSingleChoice defines model for QuestionSettingsSingleChoice.
 type QuestionSettingsSingleChoice struct {
-	Options      []string                                 `json:"options"`
-	QuestionType QuestionSettingsSingleChoiceQuestionType `json:"question_type"`
+	// CorrectOptions クイズで正解とする選択肢。optionsに含まれるものを指定する。
+	// 複数選択の質問では、正解の選択肢をすべて選び、それ以外を選ばなかった場合のみ正解とする。
+	// 運営以外には、得点と正解を見せるまで返さない。
+	CorrectOptions *QuestionCorrectOptions                  `json:"correct_options,omitempty"`
+	Options        []string                                 `json:"options"`
+	QuestionType   QuestionSettingsSingleChoiceQuestionType `json:"question_type"`
 }
 
 // QuestionSettingsSingleChoiceQuestionType defines model for QuestionSettingsSingleChoice.QuestionType.
@@ -287,8 +646,10 @@ type QuestionSettingsSingleChoiceQuestionType string
 
 // QuestionSettingsText defines model for QuestionSettingsText.
 type QuestionSettingsText struct {
-	MaxLength    *int                             `json:"max_length,omitempty"`
-	QuestionType QuestionSettingsTextQuestionType `json:"question_type"`
+	// CorrectAnswerPattern クイズで正解とみなす回答の正規表現。運営以外には、得点と正解を見せるまで返さない。
+	CorrectAnswerPattern *QuestionCorrectAnswerPattern    `json:"correct_answer_pattern,omitempty"`
+	MaxLength            *int                             `json:"max_length,omitempty"`
+	QuestionType         QuestionSettingsTextQuestionType `json:"question_type"`
 }
 
 // QuestionSettingsTextQuestionType defines model for QuestionSettingsText.QuestionType.
@@ -296,13 +657,28 @@ type QuestionSettingsTextQuestionType string
 
 // QuestionSettingsTextLong defines model for QuestionSettingsTextLong.
 type QuestionSettingsTextLong struct {
-	MaxLength    *int                                 `json:"max_length,omitempty"`
-	QuestionType QuestionSettingsTextLongQuestionType `json:"question_type"`
+	// CorrectAnswerPattern クイズで正解とみなす回答の正規表現。運営以外には、得点と正解を見せるまで返さない。
+	CorrectAnswerPattern *QuestionCorrectAnswerPattern        `json:"correct_answer_pattern,omitempty"`
+	MaxLength            *int                                 `json:"max_length,omitempty"`
+	QuestionType         QuestionSettingsTextLongQuestionType `json:"question_type"`
 }
 
 // QuestionSettingsTextLongQuestionType defines model for QuestionSettingsTextLong.QuestionType.
 type QuestionSettingsTextLongQuestionType string
 
+// QuestionSummary defines model for QuestionSummary.
+type QuestionSummary struct {
+	// AnswerCount この質問に回答した回答の数
+	AnswerCount int `json:"answer_count"`
+
+	// Average 回答の平均。数値・目盛りの質問に回答がある場合のみ含まれます
+	Average *float64 `json:"average,omitempty"`
+
+	// OptionCounts 選択肢ごとの回答数。単一選択・複数選択の質問のみ含まれます
+	OptionCounts *[]OptionCount `json:"option_counts,omitempty"`
+	QuestionId   int            `json:"question_id"`
+}
+
 // QuestionTypeMultipleChoice defines model for QuestionTypeMultipleChoice.
 type QuestionTypeMultipleChoice struct {
 	QuestionType QuestionTypeMultipleChoiceQuestionType `json:"question_type"`
@@ -353,9 +729,14 @@ type QuestionTypeTextLongQuestionType string
 
 // QuestionnaireBase defines model for QuestionnaireBase.
 type QuestionnaireBase struct {
-	Description string `json:"description"`
+	// AnnouncementTemplate traQに投稿するアンケートの作成のメッセージのテンプレート。Goのtext/templateの形式で、MessageTemplateDataの値を使える。
+	// 空文字列の場合は既定の形式のメッセージを投稿する。アンケートの編集時にnullの場合は変更しない。
+	AnnouncementTemplate *string `json:"announcement_template,omitempty"`
+	Description          string  `json:"description"`
 
 	// IsAnonymous 匿名回答かどうか
+	// 匿名回答の場合、回答者のtraQ IDは保存されず、回答と回答者を結びつけることはできない
+	// 匿名回答のアンケートではスタンプによる回答は使えない
 	IsAnonymous bool `json:"is_anonymous"`
 
 	// IsDuplicateAnswerAllowed 一人が複数回回答できるかどうか
@@ -364,12 +745,43 @@ type QuestionnaireBase struct {
 	// IsPublished アンケートが公開されているかどうか
 	IsPublished bool `json:"is_published"`
 
+	// IsQuiz クイズにするかどうか。クイズでは質問に正解と配点を設定でき、提出された回答は自動で採点される。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsQuiz *bool `json:"is_quiz,omitempty"`
+
+	// IsQuizAnswerHiddenUntilDue クイズの得点と正解を回答期限まで回答者に見せないかどうか。falseの場合は回答を提出した時点で見せる。回答期限がない場合はアンケートを締め切るまで見せない。
+	// 運営にはいつでも見せる。得点と正解を見られるようになった回答者は、回答を編集したり新しく提出したりできない。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsQuizAnswerHiddenUntilDue *bool `json:"is_quiz_answer_hidden_until_due,omitempty"`
+
+	// IsResponseAggregateOnly 運営以外には個々の回答を見せず、集計結果 (/questionnaires/{questionnaireID}/responses/summary) のみを見せるかどうか。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsResponseAggregateOnly *bool `json:"is_response_aggregate_only,omitempty"`
+
+	// IsResponseHiddenUntilDue 回答期限を過ぎるまで、運営以外には結果を見せないかどうか。回答期限がない場合はアンケートを締め切るまで見せない。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsResponseHiddenUntilDue *bool `json:"is_response_hidden_until_due,omitempty"`
+
+	// Language traQに投稿するアンケートの作成やリマインダー、審査状況の通知のメッセージの言語。日本語 (ja), 英語 (en)
+	// アンケートの作成時にnullの場合は日本語にする。アンケートの編集時にnullの場合は変更しない。
+	Language *Language `json:"language,omitempty"`
+
+	// ReminderTemplate traQに投稿するリマインダーのメッセージのテンプレート。Goのtext/templateの形式で、MessageTemplateDataの値を使える。
+	// 空文字列の場合は既定の形式のメッセージを投稿する。アンケートの編集時にnullの場合は変更しない。
+	ReminderTemplate *string `json:"reminder_template,omitempty"`
+
 	// ResponseDueDateTime 回答期限。この日時を過ぎたら回答できなくなる。nullの場合は回答期限なし。
 	ResponseDueDateTime *time.Time `json:"response_due_date_time,omitempty"`
 
-	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents") 誰でも見られる ("anyone")
+	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents"), 対象者は見られる ("targets"),
+	// response_viewersに指定したユーザー・グループは見られる ("viewers"), 誰でも見られる ("anyone")
+	// 運営はどの場合でも結果を見られる
 	ResponseViewableBy ResShareType `json:"response_viewable_by"`
-	Title              string       `json:"title"`
+
+	// ResponseViewers response_viewable_byがviewersの場合に結果を見られるユーザー・グループ。
+	// アンケートの編集時にnullの場合は変更しない。
+	ResponseViewers *UsersAndGroups `json:"response_viewers,omitempty"`
+	Title           string          `json:"title"`
 }
 
 // QuestionnaireCreatedAt defines model for QuestionnaireCreatedAt.
@@ -384,25 +796,60 @@ type QuestionnaireDescription struct {
 
 // QuestionnaireDetail defines model for QuestionnaireDetail.
 type QuestionnaireDetail struct {
+	// Admin オーナー権限の管理者。アンケートの編集・結果の閲覧に加え、削除や管理者の変更ができる。
 	Admin UsersAndGroups `json:"admin"`
 
 	// Admins 管理者の一覧。（前回対象者を編集した時点で解析したグループ情報に基づいて作成されたもの）
-	Admins      []TraqId  `json:"admins"`
-	CreatedAt   time.Time `json:"created_at"`
-	Description string    `json:"description"`
+	Admins []TraqId `json:"admins"`
+
+	// AnnouncementTemplate traQに投稿するアンケートの作成のメッセージのテンプレート。Goのtext/templateの形式で、MessageTemplateDataの値を使える。
+	// 空文字列の場合は既定の形式のメッセージを投稿する。アンケートの編集時にnullの場合は変更しない。
+	AnnouncementTemplate *string   `json:"announcement_template,omitempty"`
+	CreatedAt            time.Time `json:"created_at"`
+	Description          string    `json:"description"`
+
+	// Editor 編集権限の管理者。アンケートの編集と結果の閲覧ができる。
+	Editor *UsersAndGroups `json:"editor,omitempty"`
 
 	// IsAnonymous 匿名回答かどうか
+	// 匿名回答の場合、回答者のtraQ IDは保存されず、回答と回答者を結びつけることはできない
+	// 匿名回答のアンケートではスタンプによる回答は使えない
 	IsAnonymous bool `json:"is_anonymous"`
 
 	// IsDuplicateAnswerAllowed 一人が複数回回答できるかどうか
 	IsDuplicateAnswerAllowed bool `json:"is_duplicate_answer_allowed"`
 
 	// IsPublished アンケートが公開されているかどうか
-	IsPublished     bool       `json:"is_published"`
+	IsPublished bool `json:"is_published"`
+
+	// IsQuiz クイズにするかどうか。クイズでは質問に正解と配点を設定でき、提出された回答は自動で採点される。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsQuiz *bool `json:"is_quiz,omitempty"`
+
+	// IsQuizAnswerHiddenUntilDue クイズの得点と正解を回答期限まで回答者に見せないかどうか。falseの場合は回答を提出した時点で見せる。回答期限がない場合はアンケートを締め切るまで見せない。
+	// 運営にはいつでも見せる。得点と正解を見られるようになった回答者は、回答を編集したり新しく提出したりできない。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsQuizAnswerHiddenUntilDue *bool `json:"is_quiz_answer_hidden_until_due,omitempty"`
+
+	// IsResponseAggregateOnly 運営以外には個々の回答を見せず、集計結果 (/questionnaires/{questionnaireID}/responses/summary) のみを見せるかどうか。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsResponseAggregateOnly *bool `json:"is_response_aggregate_only,omitempty"`
+
+	// IsResponseHiddenUntilDue 回答期限を過ぎるまで、運営以外には結果を見せないかどうか。回答期限がない場合はアンケートを締め切るまで見せない。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsResponseHiddenUntilDue *bool `json:"is_response_hidden_until_due,omitempty"`
+
+	// Language traQに投稿するアンケートの作成やリマインダー、審査状況の通知のメッセージの言語。日本語 (ja), 英語 (en)
+	// アンケートの作成時にnullの場合は日本語にする。アンケートの編集時にnullの場合は変更しない。
+	Language        *Language  `json:"language,omitempty"`
 	ModifiedAt      time.Time  `json:"modified_at"`
 	QuestionnaireId int        `json:"questionnaire_id"`
 	Questions       []Question `json:"questions"`
 
+	// ReminderTemplate traQに投稿するリマインダーのメッセージのテンプレート。Goのtext/templateの形式で、MessageTemplateDataの値を使える。
+	// 空文字列の場合は既定の形式のメッセージを投稿する。アンケートの編集時にnullの場合は変更しない。
+	ReminderTemplate *string `json:"reminder_template,omitempty"`
+
 	// RespondentCount 回答した人数（ユニークな回答者数）。匿名アンケートでも実際の人数を返す。
 	// 重複回答が許可されている場合でも、同一ユーザーは1人として数える。
 	// （respondents 配列は匿名時に空になるため、人数はこちらを参照する。）
@@ -419,13 +866,25 @@ type QuestionnaireDetail struct {
 	// ResponseDueDateTime 回答期限。この日時を過ぎたら回答できなくなる。nullの場合は回答期限なし。
 	ResponseDueDateTime *time.Time `json:"response_due_date_time,omitempty"`
 
-	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents") 誰でも見られる ("anyone")
-	ResponseViewableBy ResShareType   `json:"response_viewable_by"`
-	Target             UsersAndGroups `json:"target"`
+	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents"), 対象者は見られる ("targets"),
+	// response_viewersに指定したユーザー・グループは見られる ("viewers"), 誰でも見られる ("anyone")
+	// 運営はどの場合でも結果を見られる
+	ResponseViewableBy ResShareType `json:"response_viewable_by"`
+
+	// ResponseViewers response_viewable_byがviewersの場合に結果を見られるユーザー・グループ。
+	// アンケートの編集時にnullの場合は変更しない。
+	ResponseViewers *UsersAndGroups `json:"response_viewers,omitempty"`
+
+	// Tags アンケートに付いているタグの一覧
+	Tags   []Tag          `json:"tags"`
+	Target UsersAndGroups `json:"target"`
 
 	// Targets 対象者の一覧。（前回対象者を編集した時点で解析したグループ情報に基づいて作成されたもの）
 	Targets []TraqId `json:"targets"`
 	Title   string   `json:"title"`
+
+	// Viewer 閲覧権限の管理者。アンケートの結果の閲覧のみができる。
+	Viewer *UsersAndGroups `json:"viewer,omitempty"`
 }
 
 // QuestionnaireID defines model for QuestionnaireID.
@@ -449,6 +908,8 @@ type QuestionnaireInfo struct {
 // QuestionnaireIsAnonymous defines model for QuestionnaireIsAnonymous.
 type QuestionnaireIsAnonymous struct {
 	// IsAnonymous 匿名回答かどうか
+	// 匿名回答の場合、回答者のtraQ IDは保存されず、回答と回答者を結びつけることはできない
+	// 匿名回答のアンケートではスタンプによる回答は使えない
 	IsAnonymous bool `json:"is_anonymous"`
 }
 
@@ -476,21 +937,57 @@ type QuestionnaireIsTargetingMe struct {
 	IsTargetingMe bool `json:"is_targeting_me"`
 }
 
+// QuestionnaireLanguage defines model for QuestionnaireLanguage.
+type QuestionnaireLanguage struct {
+	// Language traQに投稿するアンケートの作成やリマインダー、審査状況の通知のメッセージの言語。日本語 (ja), 英語 (en)
+	// アンケートの作成時にnullの場合は日本語にする。アンケートの編集時にnullの場合は変更しない。
+	Language *Language `json:"language,omitempty"`
+}
+
 // QuestionnaireList defines model for QuestionnaireList.
 type QuestionnaireList struct {
+	// NextCursor 次のページを取得するためのカーソル。次のページが存在しない場合は含まれない。
+	NextCursor *string `json:"next_cursor,omitempty"`
+
 	// PageMax 合計のページ数
 	PageMax        int                    `json:"page_max"`
 	Questionnaires []QuestionnaireSummary `json:"questionnaires"`
 
+	// TagCounts 現在の検索条件に一致するアンケートに付いているタグと、タグごとの件数
+	TagCounts []TagWithCount `json:"tag_counts"`
+
 	// TotalRecords 現在の検索条件に一致するアンケートの総件数
 	TotalRecords int `json:"total_records"`
 }
 
+// QuestionnaireMessageTemplates defines model for QuestionnaireMessageTemplates.
+type QuestionnaireMessageTemplates struct {
+	// AnnouncementTemplate traQに投稿するアンケートの作成のメッセージのテンプレート。Goのtext/templateの形式で、MessageTemplateDataの値を使える。
+	// 空文字列の場合は既定の形式のメッセージを投稿する。アンケートの編集時にnullの場合は変更しない。
+	AnnouncementTemplate *string `json:"announcement_template,omitempty"`
+
+	// ReminderTemplate traQに投稿するリマインダーのメッセージのテンプレート。Goのtext/templateの形式で、MessageTemplateDataの値を使える。
+	// 空文字列の場合は既定の形式のメッセージを投稿する。アンケートの編集時にnullの場合は変更しない。
+	ReminderTemplate *string `json:"reminder_template,omitempty"`
+}
+
 // QuestionnaireModifiedAt defines model for QuestionnaireModifiedAt.
 type QuestionnaireModifiedAt struct {
 	ModifiedAt time.Time `json:"modified_at"`
 }
 
+// QuestionnaireQuiz defines model for QuestionnaireQuiz.
+type QuestionnaireQuiz struct {
+	// IsQuiz クイズにするかどうか。クイズでは質問に正解と配点を設定でき、提出された回答は自動で採点される。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsQuiz *bool `json:"is_quiz,omitempty"`
+
+	// IsQuizAnswerHiddenUntilDue クイズの得点と正解を回答期限まで回答者に見せないかどうか。falseの場合は回答を提出した時点で見せる。回答期限がない場合はアンケートを締め切るまで見せない。
+	// 運営にはいつでも見せる。得点と正解を見られるようになった回答者は、回答を編集したり新しく提出したりできない。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsQuizAnswerHiddenUntilDue *bool `json:"is_quiz_answer_hidden_until_due,omitempty"`
+}
+
 // QuestionnaireResponseDueDateTime defines model for QuestionnaireResponseDueDateTime.
 type QuestionnaireResponseDueDateTime struct {
 	// ResponseDueDateTime 回答期限。この日時を過ぎたら回答できなくなる。nullの場合は回答期限なし。
@@ -499,10 +996,27 @@ type QuestionnaireResponseDueDateTime struct {
 
 // QuestionnaireResponseViewableBy defines model for QuestionnaireResponseViewableBy.
 type QuestionnaireResponseViewableBy struct {
-	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents") 誰でも見られる ("anyone")
+	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents"), 対象者は見られる ("targets"),
+	// response_viewersに指定したユーザー・グループは見られる ("viewers"), 誰でも見られる ("anyone")
+	// 運営はどの場合でも結果を見られる
 	ResponseViewableBy ResShareType `json:"response_viewable_by"`
 }
 
+// QuestionnaireResponseVisibility defines model for QuestionnaireResponseVisibility.
+type QuestionnaireResponseVisibility struct {
+	// IsResponseAggregateOnly 運営以外には個々の回答を見せず、集計結果 (/questionnaires/{questionnaireID}/responses/summary) のみを見せるかどうか。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsResponseAggregateOnly *bool `json:"is_response_aggregate_only,omitempty"`
+
+	// IsResponseHiddenUntilDue 回答期限を過ぎるまで、運営以外には結果を見せないかどうか。回答期限がない場合はアンケートを締め切るまで見せない。
+	// アンケートの編集時にnullの場合は変更しない。
+	IsResponseHiddenUntilDue *bool `json:"is_response_hidden_until_due,omitempty"`
+
+	// ResponseViewers response_viewable_byがviewersの場合に結果を見られるユーザー・グループ。
+	// アンケートの編集時にnullの場合は変更しない。
+	ResponseViewers *UsersAndGroups `json:"response_viewers,omitempty"`
+}
+
 // QuestionnaireSummary defines model for QuestionnaireSummary.
 type QuestionnaireSummary struct {
 	// AllResponded すべての対象者が回答済みの場合 true を返す。それ以外は false を返す。 (対象者が存在しない場合は true を返す)
@@ -520,6 +1034,8 @@ type QuestionnaireSummary struct {
 	IsAdministratedByMe bool `json:"is_administrated_by_me"`
 
 	// IsAnonymous 匿名回答かどうか
+	// 匿名回答の場合、回答者のtraQ IDは保存されず、回答と回答者を結びつけることはできない
+	// 匿名回答のアンケートではスタンプによる回答は使えない
 	IsAnonymous bool `json:"is_anonymous"`
 
 	// IsDuplicateAnswerAllowed 一人が複数回回答できるかどうか
@@ -537,15 +1053,39 @@ type QuestionnaireSummary struct {
 	// ResponseDueDateTime 回答期限。この日時を過ぎたら回答できなくなる。nullの場合は回答期限なし。
 	ResponseDueDateTime *time.Time `json:"response_due_date_time,omitempty"`
 
-	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents") 誰でも見られる ("anyone")
+	// ResponseViewableBy アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents"), 対象者は見られる ("targets"),
+	// response_viewersに指定したユーザー・グループは見られる ("viewers"), 誰でも見られる ("anyone")
+	// 運営はどの場合でも結果を見られる
 	ResponseViewableBy ResShareType `json:"response_viewable_by"`
-	Title              string       `json:"title"`
+
+	// Tags アンケートに付いているタグの一覧
+	Tags  []Tag  `json:"tags"`
+	Title string `json:"title"`
+}
+
+// QuestionnaireTagIDs defines model for QuestionnaireTagIDs.
+type QuestionnaireTagIDs struct {
+	// TagIds アンケートに付けるタグのIDの一覧。編集時にnullの場合はタグを変更しない。
+	TagIds *[]int `json:"tag_ids,omitempty"`
+}
+
+// QuestionnaireTags defines model for QuestionnaireTags.
+type QuestionnaireTags struct {
+	// Tags アンケートに付いているタグの一覧
+	Tags []Tag `json:"tags"`
 }
 
 // QuestionnaireTargetsAndAdmins defines model for QuestionnaireTargetsAndAdmins.
 type QuestionnaireTargetsAndAdmins struct {
-	Admin  UsersAndGroups `json:"admin"`
-	Target UsersAndGroups `json:"target"`
+	// Admin オーナー権限の管理者。アンケートの編集・結果の閲覧に加え、削除や管理者の変更ができる。
+	Admin UsersAndGroups `json:"admin"`
+
+	// Editor 編集権限の管理者。アンケートの編集と結果の閲覧ができる。
+	Editor *UsersAndGroups `json:"editor,omitempty"`
+	Target UsersAndGroups  `json:"target"`
+
+	// Viewer 閲覧権限の管理者。アンケートの結果の閲覧のみができる。
+	Viewer *UsersAndGroups `json:"viewer,omitempty"`
 }
 
 // QuestionnaireTitle defines model for QuestionnaireTitle.
@@ -553,20 +1093,79 @@ type QuestionnaireTitle struct {
 	Title string `json:"title"`
 }
 
-// ResShareType アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents") 誰でも見られる ("anyone")
+// QuestionnaireWebhook defines model for QuestionnaireWebhook.
+type QuestionnaireWebhook struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy traQ ID
+	CreatedBy TraqId         `json:"created_by"`
+	Events    []WebhookEvent `json:"events"`
+
+	// Url 送信先のURL。httpsのみ
+	Url       string `json:"url"`
+	WebhookId int    `json:"webhook_id"`
+}
+
+// QuestionnaireWebhookWithSecret defines model for QuestionnaireWebhookWithSecret.
+type QuestionnaireWebhookWithSecret struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy traQ ID
+	CreatedBy TraqId         `json:"created_by"`
+	Events    []WebhookEvent `json:"events"`
+
+	// Secret 署名の鍵。この値は登録時にのみ返されます
+	Secret string `json:"secret"`
+
+	// Url 送信先のURL。httpsのみ
+	Url       string `json:"url"`
+	WebhookId int    `json:"webhook_id"`
+}
+
+// QuickPoll defines model for QuickPoll.
+type QuickPoll struct {
+	ChannelId openapi_types.UUID `json:"channel_id"`
+	CreatedAt time.Time          `json:"created_at"`
+
+	// CreatedBy traQ ID
+	CreatedBy       TraqId             `json:"created_by"`
+	MessageId       openapi_types.UUID `json:"message_id"`
+	QuestionnaireId int                `json:"questionnaire_id"`
+	Stamps          []QuickPollStamp   `json:"stamps"`
+}
+
+// QuickPollStamp defines model for QuickPollStamp.
+type QuickPollStamp struct {
+	Option  string             `json:"option"`
+	StampId openapi_types.UUID `json:"stamp_id"`
+}
+
+// ResShareType アンケートの結果を, 運営は見られる ("admins"), 回答済みの人は見られる ("respondents"), 対象者は見られる ("targets"),
+// response_viewersに指定したユーザー・グループは見られる ("viewers"), 誰でも見られる ("anyone")
+// 運営はどの場合でも結果を見られる
 type ResShareType string
 
 // Response defines model for Response.
 type Response struct {
-	Body            []ResponseBody `json:"body"`
-	IsAnonymous     bool           `json:"is_anonymous"`
-	IsDraft         bool           `json:"is_draft"`
-	ModifiedAt      time.Time      `json:"modified_at"`
-	QuestionnaireId int            `json:"questionnaire_id"`
+	Body []ResponseBody `json:"body"`
+
+	// EnteredBy 運営が回答者の代わりに入力した場合の、入力した運営のtraQ ID。回答者本人が入力した場合は返しません。
+	EnteredBy       *TraqId   `json:"entered_by,omitempty"`
+	IsAnonymous     bool      `json:"is_anonymous"`
+	IsDraft         bool      `json:"is_draft"`
+	ModifiedAt      time.Time `json:"modified_at"`
+	QuestionnaireId int       `json:"questionnaire_id"`
 
 	// Respondent 回答者のtraQ ID。匿名回答の場合は返しません。
-	Respondent  *TraqId   `json:"respondent,omitempty"`
-	ResponseId  int       `json:"response_id"`
+	Respondent *TraqId `json:"respondent,omitempty"`
+	ResponseId int     `json:"response_id"`
+
+	// Review 回答の審査状況。運営と回答者本人にのみ返します。
+	Review *ResponseReview `json:"review,omitempty"`
+
+	// Score クイズの得点。運営と、得点と正解を見せる時になった回答者本人にのみ返します。
+	// クイズでないアンケートの回答や下書きでは返しません。
+	Score       *int      `json:"score,omitempty"`
 	SubmittedAt time.Time `json:"submitted_at"`
 }
 
@@ -645,9 +1244,45 @@ type ResponseBodyTextLong struct {
 // ResponseBodyTextLongQuestionType defines model for ResponseBodyTextLong.QuestionType.
 type ResponseBodyTextLongQuestionType string
 
+// ResponseReview defines model for ResponseReview.
+type ResponseReview struct {
+	Comment *string `json:"comment,omitempty"`
+
+	// ReviewedAt 最後に審査状況を変更した日時。未審査の場合は返しません。
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+
+	// ReviewedBy 最後に審査状況を変更した運営のtraQ ID。未審査の場合は返しません。
+	ReviewedBy *TraqId `json:"reviewed_by,omitempty"`
+
+	// Status 回答の審査状況。未審査 (pending), 承認 (accepted), 却下 (rejected), 要修正 (needs_changes)
+	Status ReviewStatus `json:"status"`
+}
+
 // ResponseSortType response用のsortの種類
 type ResponseSortType string
 
+// ResponseStreamEvent 回答の変更をServer-Sent Eventsで配信するときのdata
+type ResponseStreamEvent struct {
+	// Event 配信するイベント
+	// - counts: 接続時の回答者数と回答数
+	// - response.submitted: 回答が提出された
+	// - response.edited: 提出済みの回答が編集された
+	// - response.deleted: 提出済みの回答が削除された
+	Event           string    `json:"event"`
+	OccurredAt      time.Time `json:"occurred_at"`
+	QuestionnaireId int       `json:"questionnaire_id"`
+
+	// RespondentCount イベントの発生後の回答者数（ユニークな回答者数）
+	RespondentCount int       `json:"respondent_count"`
+	Response        *Response `json:"response,omitempty"`
+
+	// ResponseCount イベントの発生後の回答（提出）の総数
+	ResponseCount int `json:"response_count"`
+
+	// ResponseId countsイベントでは含まれません
+	ResponseId *int `json:"response_id,omitempty"`
+}
+
 // ResponseWithQuestionnaireInfoItem 同じアンケートの回答情報をまとめて返す。
 type ResponseWithQuestionnaireInfoItem struct {
 	QuestionnaireInfo QuestionnaireInfo `json:"questionnaire_info"`
@@ -657,16 +1292,57 @@ type ResponseWithQuestionnaireInfoItem struct {
 // Responses defines model for Responses.
 type Responses = []Response
 
+// ResponsesSummary defines model for ResponsesSummary.
+type ResponsesSummary struct {
+	QuestionnaireId int               `json:"questionnaire_id"`
+	Questions       []QuestionSummary `json:"questions"`
+
+	// ResponseCount 提出済みの回答の総数
+	ResponseCount int `json:"response_count"`
+}
+
 // ResponsesWithQuestionnaireInfo defines model for ResponsesWithQuestionnaireInfo.
 type ResponsesWithQuestionnaireInfo struct {
+	// NextCursor 次のページを取得するためのカーソル。次のページが存在しない場合は含まれない。
+	NextCursor *string `json:"next_cursor,omitempty"`
+
 	// PageMax 合計のページ数
 	PageMax        int                                 `json:"page_max"`
 	ResponseGroups []ResponseWithQuestionnaireInfoItem `json:"response_groups"`
 }
 
+// ReviewStatus 回答の審査状況。未審査 (pending), 承認 (accepted), 却下 (rejected), 要修正 (needs_changes)
+type ReviewStatus string
+
 // SortType question、questionnaire用のソートの種類
 type SortType string
 
+// SystemAdmin defines model for SystemAdmin.
+type SystemAdmin struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// GrantedBy traQ ID
+	GrantedBy TraqId `json:"granted_by"`
+
+	// TraqId traQ ID
+	TraqId TraqId `json:"traq_id"`
+}
+
+// Tag defines model for Tag.
+type Tag struct {
+	Name  string `json:"name"`
+	TagId int    `json:"tag_id"`
+}
+
+// TagWithCount defines model for TagWithCount.
+type TagWithCount struct {
+	Name string `json:"name"`
+
+	// QuestionnaireCount タグが付いたアンケートの件数
+	QuestionnaireCount int `json:"questionnaire_count"`
+	TagId              int `json:"tag_id"`
+}
+
 // TraqChannel defines model for TraqChannel.
 type TraqChannel struct {
 	Id   openapi_types.UUID `json:"id"`
@@ -733,9 +1409,59 @@ type UsersAndGroups struct {
 	Users Users `json:"users"`
 }
 
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts 送信を試みた回数
+	Attempts   int       `json:"attempts"`
+	CreatedAt  time.Time `json:"created_at"`
+	DeliveryId int       `json:"delivery_id"`
+
+	// Error 最後の送信のエラー。成功した場合は空文字列
+	Error string `json:"error"`
+
+	// Event Webhookで通知するイベント
+	// - response.submitted: 回答が提出された (一時保存は含まない)
+	// - response.edited: 提出済みの回答が編集された
+	// - response.deleted: 提出済みの回答が削除された
+	// - questionnaire.closed: アンケートの回答が締め切られた
+	Event WebhookEvent `json:"event"`
+
+	// Payload 送信したリクエストボディ
+	Payload string `json:"payload"`
+
+	// Status 送信状況
+	// - pending: 送信中 (再送待ちを含む)
+	// - succeeded: 送信成功
+	// - failed: 再送しても送信できなかった
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// StatusCode 最後の送信で返されたHTTPステータスコード。レスポンスが返らなかった場合はnull
+	StatusCode *int      `json:"status_code"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// WebhookDeliveryStatus 送信状況
+// - pending: 送信中 (再送待ちを含む)
+// - succeeded: 送信成功
+// - failed: 再送しても送信できなかった
+type WebhookDeliveryStatus string
+
+// WebhookEvent Webhookで通知するイベント
+// - response.submitted: 回答が提出された (一時保存は含まない)
+// - response.edited: 提出済みの回答が編集された
+// - response.deleted: 提出済みの回答が削除された
+// - questionnaire.closed: アンケートの回答が締め切られた
+type WebhookEvent string
+
+// AccessTokenIDInPath defines model for accessTokenIDInPath.
+type AccessTokenIDInPath = int
+
 // CountOnlyInQuery defines model for countOnlyInQuery.
 type CountOnlyInQuery = bool
 
+// CursorInQuery defines model for cursorInQuery.
+type CursorInQuery = string
+
 // HasMyDraftInQuery defines model for hasMyDraftInQuery.
 type HasMyDraftInQuery = bool
 
@@ -745,6 +1471,12 @@ type HasMyResponseInQuery = bool
 // IsDraftInQuery defines model for isDraftInQuery.
 type IsDraftInQuery = bool
 
+// KeywordInQuery defines model for keywordInQuery.
+type KeywordInQuery = string
+
+// LimitInQuery defines model for limitInQuery.
+type LimitInQuery = int
+
 // NotOverDueInQuery defines model for notOverDueInQuery.
 type NotOverDueInQuery = bool
 
@@ -775,23 +1507,54 @@ type ResponseIDInPath = int
 // ResponseSortInQuery response用のsortの種類
 type ResponseSortInQuery = ResponseSortType
 
+// ResponsesLimitInQuery defines model for responsesLimitInQuery.
+type ResponsesLimitInQuery = int
+
+// ReviewStatusInQuery 回答の審査状況。未審査 (pending), 承認 (accepted), 却下 (rejected), 要修正 (needs_changes)
+type ReviewStatusInQuery = ReviewStatus
+
 // SearchInQuery defines model for searchInQuery.
 type SearchInQuery = string
 
 // SortInQuery question、questionnaire用のソートの種類
 type SortInQuery = SortType
 
+// TagIDInPath defines model for tagIDInPath.
+type TagIDInPath = int
+
+// TagIDsInQuery defines model for tagIDsInQuery.
+type TagIDsInQuery = []int
+
+// TraqIDInPath defines model for traqIDInPath.
+type TraqIDInPath = string
+
+// WebhookIDInPath defines model for webhookIDInPath.
+type WebhookIDInPath = int
+
 // GetQuestionnairesParams defines parameters for GetQuestionnaires.
 type GetQuestionnairesParams struct {
-	// Sort 並び順 (作成日時が新しい "created_at", 作成日時が古い "-created_at", タイトルの昇順 "title", タイトルの降順 "-title", 更新日時が新しい "modified_at", 更新日時が古い "-modified_at" )
+	// Sort 並び順 (作成日時が新しい "created_at", 作成日時が古い "-created_at", タイトルの昇順 "title", タイトルの降順 "-title", 更新日時が新しい "modified_at", 更新日時が古い "-modified_at", keywordとの関連度が高い "relevance" )
 	Sort *SortInQuery `form:"sort,omitempty" json:"sort,omitempty"`
 
 	// Search タイトルの検索
 	Search *SearchInQuery `form:"search,omitempty" json:"search,omitempty"`
 
-	// Page 何ページ目か (未定義の場合は1ページ目)
+	// Keyword タイトル・説明・質問文の全文検索。空白区切りの語をすべて含むアンケートのみ取得する。
+	Keyword *KeywordInQuery `form:"keyword,omitempty" json:"keyword,omitempty"`
+
+	// Tags 指定したすべてのタグが付いたアンケートのみ取得する。複数指定可能。
+	Tags *TagIDsInQuery `form:"tags,omitempty" json:"tags,omitempty"`
+
+	// Page 何ページ目か (未定義の場合は1ページ目)。cursorが指定された場合は無視される。
 	Page *PageInQuery `form:"page,omitempty" json:"page,omitempty"`
 
+	// Cursor 前のページのレスポンスで返されたnext_cursor (またはLinkヘッダーのcursor)。指定した場合はその続きから取得する。
+	// sortは前のページと同じものを指定する必要がある。
+	Cursor *CursorInQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページあたりの件数 (未定義の場合は20件)
+	Limit *LimitInQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
 	// OnlyTargetingMe 自分がターゲットになっているもののみ取得 (true), ターゲットになっているものも含めてすべて取得 (false)。デフォルトはfalse。
 	OnlyTargetingMe *OnlyTargetingMeInQuery `form:"onlyTargetingMe,omitempty" json:"onlyTargetingMe,omitempty"`
 
@@ -830,13 +1593,31 @@ type GetQuestionnaireResponsesParams struct {
 
 	// IsDraft trueの場合、下書きのみを取得する。falseの場合、下書きではないもののみを取得する。存在しない場合はすべて取得する。
 	IsDraft *IsDraftInQuery `form:"isDraft,omitempty" json:"isDraft,omitempty"`
+
+	// ReviewStatus 指定した審査状況の回答のみを取得する。自分の回答のみを取得する場合以外は、運営のみが指定できます。
+	ReviewStatus *ReviewStatusInQuery `form:"review_status,omitempty" json:"review_status,omitempty"`
+
+	// Cursor 前のページのレスポンスで返されたnext_cursor (またはLinkヘッダーのcursor)。指定した場合はその続きから取得する。
+	// sortは前のページと同じものを指定する必要がある。
+	Cursor *CursorInQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページあたりの件数。未定義の場合、cursorも未定義ならすべて、cursorが指定されていれば20件取得する。
+	// 質問の回答による並び替えとは併用できない。
+	Limit *ResponsesLimitInQuery `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // GetMyResponsesParams defines parameters for GetMyResponses.
 type GetMyResponsesParams struct {
-	// Page 何ページ目か (未定義の場合は1ページ目)
+	// Page 何ページ目か (未定義の場合は1ページ目)。cursorが指定された場合は無視される。
 	Page *PageInQuery `form:"page,omitempty" json:"page,omitempty"`
 
+	// Cursor 前のページのレスポンスで返されたnext_cursor (またはLinkヘッダーのcursor)。指定した場合はその続きから取得する。
+	// sortは前のページと同じものを指定する必要がある。
+	Cursor *CursorInQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit 1ページあたりの件数 (未定義の場合は20件)
+	Limit *LimitInQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
 	// QuestionnaireIDs 取得したい情報のアンケートをフィルタリングするためのパラメータ。複数指定可能。
 	QuestionnaireIDs *QuestionnaireIDsInQuery `form:"questionnaireIDs,omitempty" json:"questionnaireIDs,omitempty"`
 
@@ -844,21 +1625,45 @@ type GetMyResponsesParams struct {
 	IsDraft *IsDraftInQuery `form:"isDraft,omitempty" json:"isDraft,omitempty"`
 }
 
+// PostAccessTokenJSONRequestBody defines body for PostAccessToken for application/json ContentType.
+type PostAccessTokenJSONRequestBody = NewAccessToken
+
 // PostQuestionnaireJSONRequestBody defines body for PostQuestionnaire for application/json ContentType.
 type PostQuestionnaireJSONRequestBody = NewQuestionnaire
 
 // EditQuestionnaireJSONRequestBody defines body for EditQuestionnaire for application/json ContentType.
 type EditQuestionnaireJSONRequestBody = EditQuestionnaire
 
+// PreviewQuestionnaireMessageJSONRequestBody defines body for PreviewQuestionnaireMessage for application/json ContentType.
+type PreviewQuestionnaireMessageJSONRequestBody = MessagePreviewRequest
+
 // EditQuestionnaireMyRemindStatusJSONRequestBody defines body for EditQuestionnaireMyRemindStatus for application/json ContentType.
 type EditQuestionnaireMyRemindStatusJSONRequestBody = QuestionnaireIsRemindEnabled
 
+// PostQuestionnaireQuickPollJSONRequestBody defines body for PostQuestionnaireQuickPoll for application/json ContentType.
+type PostQuestionnaireQuickPollJSONRequestBody = NewQuickPoll
+
 // PostQuestionnaireResponseJSONRequestBody defines body for PostQuestionnaireResponse for application/json ContentType.
 type PostQuestionnaireResponseJSONRequestBody = NewResponse
 
+// PostQuestionnaireWebhookJSONRequestBody defines body for PostQuestionnaireWebhook for application/json ContentType.
+type PostQuestionnaireWebhookJSONRequestBody = NewQuestionnaireWebhook
+
 // EditResponseJSONRequestBody defines body for EditResponse for application/json ContentType.
 type EditResponseJSONRequestBody = EditResponse
 
+// EditResponseReviewJSONRequestBody defines body for EditResponseReview for application/json ContentType.
+type EditResponseReviewJSONRequestBody = EditResponseReview
+
+// PostSystemAdminJSONRequestBody defines body for PostSystemAdmin for application/json ContentType.
+type PostSystemAdminJSONRequestBody = NewSystemAdmin
+
+// PostTagJSONRequestBody defines body for PostTag for application/json ContentType.
+type PostTagJSONRequestBody = NewTag
+
+// EditTagJSONRequestBody defines body for EditTag for application/json ContentType.
+type EditTagJSONRequestBody = NewTag
+
 // AsQuestionSettingsText returns the union data inside the NewQuestion as a QuestionSettingsText
 func (t NewQuestion) AsQuestionSettingsText() (QuestionSettingsText, error) {
 	var body QuestionSettingsText
@@ -1038,6 +1843,20 @@ func (t NewQuestion) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'is_required': %w", err)
 	}
 
+	if t.IsResponseHidden != nil {
+		object["is_response_hidden"], err = json.Marshal(t.IsResponseHidden)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'is_response_hidden': %w", err)
+		}
+	}
+
+	if t.Points != nil {
+		object["points"], err = json.Marshal(t.Points)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'points': %w", err)
+		}
+	}
+
 	object["title"], err = json.Marshal(t.Title)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'title': %w", err)
@@ -1072,6 +1891,20 @@ func (t *NewQuestion) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["is_response_hidden"]; found {
+		err = json.Unmarshal(raw, &t.IsResponseHidden)
+		if err != nil {
+			return fmt.Errorf("error reading 'is_response_hidden': %w", err)
+		}
+	}
+
+	if raw, found := object["points"]; found {
+		err = json.Unmarshal(raw, &t.Points)
+		if err != nil {
+			return fmt.Errorf("error reading 'points': %w", err)
+		}
+	}
+
 	if raw, found := object["title"]; found {
 		err = json.Unmarshal(raw, &t.Title)
 		if err != nil {
@@ -1467,6 +2300,20 @@ func (t Question) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'is_required': %w", err)
 	}
 
+	if t.IsResponseHidden != nil {
+		object["is_response_hidden"], err = json.Marshal(t.IsResponseHidden)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'is_response_hidden': %w", err)
+		}
+	}
+
+	if t.Points != nil {
+		object["points"], err = json.Marshal(t.Points)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'points': %w", err)
+		}
+	}
+
 	if t.QuestionId != nil {
 		object["question_id"], err = json.Marshal(t.QuestionId)
 		if err != nil {
@@ -1515,6 +2362,20 @@ func (t *Question) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["is_response_hidden"]; found {
+		err = json.Unmarshal(raw, &t.IsResponseHidden)
+		if err != nil {
+			return fmt.Errorf("error reading 'is_response_hidden': %w", err)
+		}
+	}
+
+	if raw, found := object["points"]; found {
+		err = json.Unmarshal(raw, &t.Points)
+		if err != nil {
+			return fmt.Errorf("error reading 'points': %w", err)
+		}
+	}
+
 	if raw, found := object["question_id"]; found {
 		err = json.Unmarshal(raw, &t.QuestionId)
 		if err != nil {
@@ -0,0 +1,109 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/traPtitech/anke-to/model"
+)
+
+const (
+	databasePollInterval = time.Second
+	databasePollLimit    = 100
+	// databaseRetention 配信済みのイベントを残しておく期間
+	databaseRetention = 10 * time.Minute
+)
+
+// Database データベースを経由して、複数のインスタンスの間でメッセージを配信するBroker
+// 各インスタンスは新しく追加されたイベントを定期的に読み出し、プロセス内の購読者に配信する
+type Database struct {
+	model.IStreamEvent
+	local        *Memory
+	pollInterval time.Duration
+	retention    time.Duration
+}
+
+// NewDatabase Databaseのコンストラクター
+// 起動前に追加されたイベントは配信せず、ctxが終了するまでイベントを読み出し続ける
+func NewDatabase(ctx context.Context, streamEvent model.IStreamEvent) (*Database, error) {
+	d := &Database{
+		IStreamEvent: streamEvent,
+		local:        NewMemory(),
+		pollInterval: databasePollInterval,
+		retention:    databaseRetention,
+	}
+
+	lastID, err := d.GetLastStreamEventID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last stream event id: %w", err)
+	}
+
+	go d.poll(ctx, lastID)
+
+	return d, nil
+}
+
+// Publish イベントをデータベースに追加する
+// 購読者への配信は、自身のインスタンスも含めてpollで行う
+func (d *Database) Publish(ctx context.Context, topic string, message []byte) error {
+	_, err := d.InsertStreamEvent(ctx, topic, string(message))
+	if err != nil {
+		return fmt.Errorf("failed to insert stream event: %w", err)
+	}
+
+	return nil
+}
+
+// Subscribe トピックを購読する
+func (d *Database) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	return d.local.Subscribe(ctx, topic)
+}
+
+func (d *Database) poll(ctx context.Context, lastID int) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	lastCleanedAt := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		lastID = d.deliver(ctx, lastID)
+
+		if time.Since(lastCleanedAt) >= d.retention {
+			err := d.DeleteStreamEventsBefore(ctx, time.Now().Add(-d.retention))
+			if err != nil {
+				log.Printf("failed to delete stream events: %+v", err)
+			}
+			lastCleanedAt = time.Now()
+		}
+	}
+}
+
+// deliver lastIDより後のイベントをプロセス内の購読者に配信し、最後に配信したイベントのIDを返す
+func (d *Database) deliver(ctx context.Context, lastID int) int {
+	for {
+		streamEvents, err := d.GetStreamEventsAfter(ctx, lastID, databasePollLimit)
+		if err != nil {
+			log.Printf("failed to get stream events: %+v", err)
+			return lastID
+		}
+
+		for _, streamEvent := range streamEvents {
+			err = d.local.Publish(ctx, streamEvent.Topic, []byte(streamEvent.Payload))
+			if err != nil {
+				log.Printf("failed to publish stream event: %+v", err)
+			}
+			lastID = streamEvent.ID
+		}
+
+		if len(streamEvents) < databasePollLimit {
+			return lastID
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/model"
+)
+
+// fakeStreamEvent stream_eventsテーブルの代わりにメモリ上にイベントを保存する
+type fakeStreamEvent struct {
+	lock         sync.Mutex
+	streamEvents []model.StreamEvents
+	deletedCount int
+}
+
+func (f *fakeStreamEvent) InsertStreamEvent(_ context.Context, topic string, payload string) (int, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	id := len(f.streamEvents) + f.deletedCount + 1
+	f.streamEvents = append(f.streamEvents, model.StreamEvents{
+		ID:        id,
+		Topic:     topic,
+		Payload:   payload,
+		CreatedAt: time.Now(),
+	})
+
+	return id, nil
+}
+
+func (f *fakeStreamEvent) GetStreamEventsAfter(_ context.Context, lastID int, limit int) ([]model.StreamEvents, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	streamEvents := []model.StreamEvents{}
+	for _, streamEvent := range f.streamEvents {
+		if streamEvent.ID > lastID && len(streamEvents) < limit {
+			streamEvents = append(streamEvents, streamEvent)
+		}
+	}
+
+	return streamEvents, nil
+}
+
+func (f *fakeStreamEvent) GetLastStreamEventID(_ context.Context) (int, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return len(f.streamEvents) + f.deletedCount, nil
+}
+
+func (f *fakeStreamEvent) DeleteStreamEventsBefore(_ context.Context, before time.Time) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	streamEvents := []model.StreamEvents{}
+	for _, streamEvent := range f.streamEvents {
+		if streamEvent.CreatedAt.Before(before) {
+			f.deletedCount++
+			continue
+		}
+		streamEvents = append(streamEvents, streamEvent)
+	}
+	f.streamEvents = streamEvents
+
+	return nil
+}
+
+func TestDatabase(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	streamEvent := &fakeStreamEvent{}
+	// 起動前のイベントは配信しない
+	_, err := streamEvent.InsertStreamEvent(ctx, "questionnaires/1/responses", "before start")
+	if !assertion.NoError(err) {
+		return
+	}
+
+	// 複数のインスタンスが同じテーブルを共有している状態
+	instance1, err := NewDatabase(ctx, streamEvent)
+	if !assertion.NoError(err) {
+		return
+	}
+	instance2, err := NewDatabase(ctx, streamEvent)
+	if !assertion.NoError(err) {
+		return
+	}
+
+	ch1, err := instance1.Subscribe(ctx, "questionnaires/1/responses")
+	if !assertion.NoError(err) {
+		return
+	}
+	ch2, err := instance2.Subscribe(ctx, "questionnaires/1/responses")
+	if !assertion.NoError(err) {
+		return
+	}
+
+	err = instance1.Publish(ctx, "questionnaires/1/responses", []byte("message"))
+	assertion.NoError(err)
+
+	message, ok := receive(t, ch1)
+	assertion.True(ok)
+	assertion.Equal([]byte("message"), message, "same instance")
+	message, ok = receive(t, ch2)
+	assertion.True(ok)
+	assertion.Equal([]byte("message"), message, "other instance")
+}
+
+func TestDatabaseDeliver(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+
+	streamEvent := &fakeStreamEvent{}
+	d := &Database{
+		IStreamEvent: streamEvent,
+		local:        NewMemory(),
+	}
+
+	ch, err := d.Subscribe(ctx, "questionnaires/1/responses")
+	if !assertion.NoError(err) {
+		return
+	}
+
+	// 一度に読み出す件数を超えても、すべてのイベントを配信する
+	for range databasePollLimit + 1 {
+		_, err = streamEvent.InsertStreamEvent(ctx, "questionnaires/2/responses", "other")
+		assertion.NoError(err)
+	}
+	_, err = streamEvent.InsertStreamEvent(ctx, "questionnaires/1/responses", "message")
+	assertion.NoError(err)
+
+	lastID := d.deliver(ctx, 0)
+	assertion.Equal(databasePollLimit+2, lastID)
+
+	message, ok := receive(t, ch)
+	assertion.True(ok)
+	assertion.Equal([]byte("message"), message)
+}
@@ -0,0 +1,85 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriberBufferSize 購読者ごとに溜めておけるメッセージの数
+const subscriberBufferSize = 32
+
+// Memory プロセス内でメッセージを配信するBroker
+type Memory struct {
+	lock        sync.Mutex
+	subscribers map[string]map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	ch chan []byte
+}
+
+// NewMemory Memoryのコンストラクター
+func NewMemory() *Memory {
+	return &Memory{
+		subscribers: map[string]map[*subscriber]struct{}{},
+	}
+}
+
+// Publish トピックを購読しているすべての購読者にメッセージを送る
+func (m *Memory) Publish(_ context.Context, topic string, message []byte) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for s := range m.subscribers[topic] {
+		select {
+		case s.ch <- message:
+		default:
+			// 受信が追いつかない購読者は、メッセージを取りこぼしたまま購読を続けないよう切断する
+			m.remove(topic, s)
+		}
+	}
+
+	return nil
+}
+
+// Subscribe トピックを購読する
+func (m *Memory) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	s := &subscriber{
+		ch: make(chan []byte, subscriberBufferSize),
+	}
+
+	m.lock.Lock()
+	if _, ok := m.subscribers[topic]; !ok {
+		m.subscribers[topic] = map[*subscriber]struct{}{}
+	}
+	m.subscribers[topic][s] = struct{}{}
+	m.lock.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		m.remove(topic, s)
+	}()
+
+	return s.ch, nil
+}
+
+// remove 購読者を削除してチャネルを閉じる
+// m.lockを取得した状態で呼び出す
+func (m *Memory) remove(topic string, s *subscriber) {
+	subscribers, ok := m.subscribers[topic]
+	if !ok {
+		return
+	}
+	if _, ok := subscribers[s]; !ok {
+		return
+	}
+
+	delete(subscribers, s)
+	if len(subscribers) == 0 {
+		delete(m.subscribers, topic)
+	}
+	close(s.ch)
+}
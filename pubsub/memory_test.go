@@ -0,0 +1,93 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func receive(t *testing.T, ch <-chan []byte) ([]byte, bool) {
+	t.Helper()
+
+	select {
+	case message, ok := <-ch:
+		return message, ok
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a message")
+		return nil, false
+	}
+}
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+	memory := NewMemory()
+
+	ctx1, cancel1 := context.WithCancel(ctx)
+	defer cancel1()
+	ch1, err := memory.Subscribe(ctx1, "questionnaires/1/responses")
+	if !assertion.NoError(err) {
+		return
+	}
+	ctx2, cancel2 := context.WithCancel(ctx)
+	defer cancel2()
+	ch2, err := memory.Subscribe(ctx2, "questionnaires/1/responses")
+	if !assertion.NoError(err) {
+		return
+	}
+	otherCh, err := memory.Subscribe(ctx, "questionnaires/2/responses")
+	if !assertion.NoError(err) {
+		return
+	}
+
+	err = memory.Publish(ctx, "questionnaires/1/responses", []byte("message"))
+	assertion.NoError(err)
+
+	message, ok := receive(t, ch1)
+	assertion.True(ok)
+	assertion.Equal([]byte("message"), message)
+	message, ok = receive(t, ch2)
+	assertion.True(ok)
+	assertion.Equal([]byte("message"), message)
+	assertion.Empty(otherCh, "other topic")
+
+	cancel1()
+	_, ok = receive(t, ch1)
+	assertion.False(ok, "closed after cancel")
+
+	err = memory.Publish(ctx, "questionnaires/1/responses", []byte("after cancel"))
+	assertion.NoError(err)
+	message, ok = receive(t, ch2)
+	assertion.True(ok)
+	assertion.Equal([]byte("after cancel"), message)
+}
+
+func TestMemorySlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+	ctx := context.Background()
+	memory := NewMemory()
+
+	ch, err := memory.Subscribe(ctx, "questionnaires/1/responses")
+	if !assertion.NoError(err) {
+		return
+	}
+
+	for range subscriberBufferSize + 1 {
+		err = memory.Publish(ctx, "questionnaires/1/responses", []byte("message"))
+		assertion.NoError(err)
+	}
+
+	// 溜まっていたメッセージを受信した後、チャネルが閉じられる
+	for range subscriberBufferSize {
+		_, ok := receive(t, ch)
+		assertion.True(ok)
+	}
+	_, ok := receive(t, ch)
+	assertion.False(ok, "closed slow subscriber")
+}
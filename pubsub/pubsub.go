@@ -0,0 +1,43 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/traPtitech/anke-to/config"
+	"github.com/traPtitech/anke-to/model"
+)
+
+// Broker トピックごとにメッセージを配信する
+type Broker interface {
+	// Publish トピックを購読しているすべての購読者にメッセージを送る
+	Publish(ctx context.Context, topic string, message []byte) error
+	// Subscribe トピックを購読する
+	// ctxが終了するか、受信が追いつかなくなった時にチャネルが閉じられる
+	Subscribe(ctx context.Context, topic string) (<-chan []byte, error)
+}
+
+const (
+	// BackendMemory プロセス内でのみメッセージを配信する
+	BackendMemory = config.PubSubBackendMemory
+	// BackendDatabase データベースを経由して、複数のインスタンス間でメッセージを配信する
+	BackendDatabase = config.PubSubBackendDatabase
+)
+
+// NewBroker 設定に応じたBrokerを作成する
+// ctxが終了するとバックエンドのメッセージの受信を止める
+func NewBroker(ctx context.Context, cfg config.PubSub) (Broker, error) {
+	backend := cfg.Backend
+	if backend == "" {
+		backend = BackendMemory
+	}
+
+	switch backend {
+	case BackendMemory:
+		return NewMemory(), nil
+	case BackendDatabase:
+		return NewDatabase(ctx, model.NewStreamEvent())
+	default:
+		return nil, fmt.Errorf("unknown pubsub.backend: %s", backend)
+	}
+}
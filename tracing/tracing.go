@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/traPtitech/anke-to/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServiceName トレースに記録するサービス名
+const ServiceName = "anke-to"
+
+// instrumentationName anke-toのスパンを作るTracerの名前
+const instrumentationName = "github.com/traPtitech/anke-to"
+
+// Setup 設定に応じてトレースの送信を始め、終了時に残りのトレースを送る関数を返す
+// 送信先が設定されていない場合は、トレースの伝播のみ行い、トレースは記録しない
+func Setup(ctx context.Context, cfg config.Tracing) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if cfg.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	tp, err := NewTracerProvider(sdktrace.WithBatcher(exporter), cfg.SampleRatio)
+	if err != nil {
+		return nil, err
+	}
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// NewTracerProvider spanProcessorにスパンを渡すTracerProviderを作る
+// テストではインメモリのExporterと組み合わせて使う
+func NewTracerProvider(spanProcessor sdktrace.TracerProviderOption, sampleRatio float64) (*sdktrace.TracerProvider, error) {
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	return sdktrace.NewTracerProvider(
+		spanProcessor,
+		sdktrace.WithResource(res),
+		// 親のスパンが記録されている場合は、割合に関わらず子のスパンも記録する
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	), nil
+}
+
+// Start スパンを開始する
+// 呼び出し時点のTracerProviderを使うため、テストで差し替えたTracerProviderにも記録される
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// RecordError スパンにエラーを記録する
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestSetup(t *testing.T) {
+	assertion := assert.New(t)
+
+	shutdown, err := Setup(context.Background(), config.Tracing{SampleRatio: 1})
+	if !assertion.NoError(err) {
+		return
+	}
+	assertion.NoError(shutdown(context.Background()), "no-op shutdown")
+	assertion.ElementsMatch([]string{"traceparent", "tracestate", "baggage"}, otel.GetTextMapPropagator().Fields(), "propagator")
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	assertion := assert.New(t)
+
+	type test struct {
+		description string
+		sampleRatio float64
+		expectSpans int
+	}
+	testCases := []test{
+		{
+			description: "割合が1なのですべて記録する",
+			sampleRatio: 1,
+			expectSpans: 2,
+		},
+		{
+			description: "割合が0なので記録しない",
+			sampleRatio: 0,
+			expectSpans: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		exporter := tracetest.NewInMemoryExporter()
+		tp, err := NewTracerProvider(sdktrace.WithSyncer(exporter), testCase.sampleRatio)
+		if !assertion.NoError(err, testCase.description) {
+			continue
+		}
+		otel.SetTracerProvider(tp)
+
+		ctx, parent := Start(context.Background(), "parent")
+		_, child := Start(ctx, "child")
+		child.End()
+		parent.End()
+
+		spans := exporter.GetSpans()
+		assertion.Len(spans, testCase.expectSpans, testCase.description)
+		if len(spans) == 2 {
+			assertion.Equal("child", spans[0].Name, testCase.description)
+			assertion.Equal(spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID(), testCase.description, "parent")
+			assertion.Contains(spans[0].Resource.Attributes(), semconv.ServiceName(ServiceName), testCase.description, "resource")
+		}
+
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		assertion.NoError(tp.Shutdown(context.Background()), testCase.description)
+	}
+}
+
+func TestRecordError(t *testing.T) {
+	assertion := assert.New(t)
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp, err := NewTracerProvider(sdktrace.WithSyncer(exporter), 1)
+	if !assertion.NoError(err) {
+		return
+	}
+	otel.SetTracerProvider(tp)
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	_, span := Start(context.Background(), "ok")
+	RecordError(span, nil)
+	span.End()
+
+	_, span = Start(context.Background(), "error")
+	RecordError(span, errors.New("failed"))
+	span.End()
+
+	spans := exporter.GetSpans()
+	if !assertion.Len(spans, 2) {
+		return
+	}
+	assertion.Equal(codes.Unset, spans[0].Status.Code, "no error")
+	assertion.Empty(spans[0].Events, "no error")
+	assertion.Equal(codes.Error, spans[1].Status.Code, "error")
+	assertion.Equal("failed", spans[1].Status.Description, "error")
+	assertion.Len(spans[1].Events, 1, "error event")
+}
@@ -0,0 +1,46 @@
+package tracingtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/traPtitech/anke-to/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Setup テストの間、スパンをインメモリに記録するTracerProviderと、W3C Trace Contextでトレースを伝播するPropagatorを使う
+// TracerProviderはプロセス全体で共有されるため、並行して実行するテストでは使わない
+func Setup(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp, err := tracing.NewTracerProvider(sdktrace.WithSyncer(exporter), 1)
+	if err != nil {
+		t.Fatalf("failed to create tracer provider: %v", err)
+	}
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		_ = tp.Shutdown(context.Background())
+	})
+
+	return exporter
+}
+
+// SpansByName 記録されたスパンのうち、名前がnameのものを返す
+func SpansByName(exporter *tracetest.InMemoryExporter, name string) tracetest.SpanStubs {
+	var spans tracetest.SpanStubs
+	for _, span := range exporter.GetSpans() {
+		if span.Name == name {
+			spans = append(spans, span)
+		}
+	}
+
+	return spans
+}
@@ -0,0 +1,18 @@
+//go:generate go tool mockgen -source=$GOFILE -destination=mock_$GOPACKAGE/mock_$GOFILE
+
+package traq
+
+import (
+	"context"
+
+	traq "github.com/traPtitech/go-traq"
+)
+
+// IBot traQのBOTのinterface
+type IBot interface {
+	PostChannelMessage(ctx context.Context, channelID string, content string) (string, error)
+	AddMessageStamp(ctx context.Context, messageID string, stampID string) error
+	GetStamps(ctx context.Context) ([]traq.StampWithThumbnail, error)
+	GetUserTraqID(ctx context.Context, userUUID string) (string, error)
+	PostDirectMessage(ctx context.Context, userTraqID string, content string) error
+}
@@ -0,0 +1,74 @@
+package traq
+
+import "time"
+
+// traQのBOTのイベントのリクエストヘッダー
+// ref: https://bot-console.trap.jp/docs/bot/events
+const (
+	BotEventHeader     = "X-TRAQ-BOT-EVENT"
+	BotTokenHeader     = "X-TRAQ-BOT-TOKEN"
+	BotRequestIDHeader = "X-TRAQ-BOT-REQUEST-ID"
+)
+
+// BotEventType traQのBOTのイベントの種類
+type BotEventType string
+
+const (
+	BotEventPing                 BotEventType = "PING"
+	BotEventJoined               BotEventType = "JOINED"
+	BotEventLeft                 BotEventType = "LEFT"
+	BotEventMessageCreated       BotEventType = "MESSAGE_CREATED"
+	BotEventDirectMessageCreated BotEventType = "DIRECT_MESSAGE_CREATED"
+	BotEventMessageStampsUpdated BotEventType = "BOT_MESSAGE_STAMPS_UPDATED"
+)
+
+// BotUser BOTのイベントに含まれるユーザー
+type BotUser struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+	IconID      string `json:"iconId"`
+	Bot         bool   `json:"bot"`
+}
+
+// BotEmbedded BOTのイベントのメッセージに含まれる埋め込み
+type BotEmbedded struct {
+	Raw  string `json:"raw"`
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// BotMessage BOTのイベントに含まれるメッセージ
+type BotMessage struct {
+	ID        string        `json:"id"`
+	User      BotUser       `json:"user"`
+	ChannelID string        `json:"channelId"`
+	Text      string        `json:"text"`
+	PlainText string        `json:"plainText"`
+	Embedded  []BotEmbedded `json:"embedded"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// BotMessageCreatedEvent MESSAGE_CREATED, DIRECT_MESSAGE_CREATEDイベントのペイロード
+type BotMessageCreatedEvent struct {
+	EventTime time.Time  `json:"eventTime"`
+	Message   BotMessage `json:"message"`
+}
+
+// BotMessageStamp BOT_MESSAGE_STAMPS_UPDATEDイベントに含まれるスタンプ
+type BotMessageStamp struct {
+	StampID   string    `json:"stampId"`
+	UserID    string    `json:"userId"`
+	Count     int       `json:"count"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// BotMessageStampsUpdatedEvent BOT_MESSAGE_STAMPS_UPDATEDイベントのペイロード
+// BOTが投稿したメッセージに押されているすべてのスタンプが含まれる
+type BotMessageStampsUpdatedEvent struct {
+	EventTime time.Time         `json:"eventTime"`
+	MessageID string            `json:"messageId"`
+	Stamps    []BotMessageStamp `json:"stamps"`
+}
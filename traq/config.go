@@ -0,0 +1,18 @@
+package traq
+
+import "github.com/traPtitech/anke-to/config"
+
+// traqConfig traQのURLやトークンの設定
+// 起動時にConfigureで設定し、それ以降は変更しない
+var traqConfig config.TraQ
+
+// Configure traQの設定を反映する
+// APIクライアントやWebhookを使う前に呼ぶ
+func Configure(cfg config.TraQ) {
+	traqConfig = cfg
+}
+
+// apiBaseURL traQのAPIのURL
+func apiBaseURL() string {
+	return traqConfig.BaseURL + "/api/v3"
+}
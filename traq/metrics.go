@@ -0,0 +1,102 @@
+package traq
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// metricsNamespace anke-toのメトリクスの名前の接頭辞
+const metricsNamespace = "anke_to"
+
+// traQへのリクエストの種類
+const (
+	metricsClientAPI     = "api"
+	metricsClientWebhook = "webhook"
+)
+
+// ETagのキャッシュの結果
+const (
+	// etagCacheHit 304が返り、キャッシュを返した
+	etagCacheHit = "hit"
+	// etagCacheMiss キャッシュがないか、更新されていたため、traQのレスポンスを返した
+	etagCacheMiss = "miss"
+	// etagCacheFallback traQへのリクエストに失敗したため、キャッシュを返した
+	etagCacheFallback = "fallback"
+)
+
+// metricsStatusError レスポンスを受け取れなかったリクエストのstatusラベル
+const metricsStatusError = "error"
+
+var (
+	traqRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "traq",
+		Name:      "requests_total",
+		Help:      "Number of requests to traQ",
+	}, []string{"client", "method", "path", "status"})
+
+	traqRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "traq",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests to traQ",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"client", "method", "path"})
+
+	traqETagCacheTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "traq",
+		Name:      "etag_cache_total",
+		Help:      "Number of requests to traQ that can be cached with ETag",
+	}, []string{"result"})
+)
+
+// uuidPattern パスに含まれるtraQのリソースのID
+var uuidPattern = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+
+// metricsPath パスのIDを置き換えて、pathラベルの種類が増えすぎないようにする
+func metricsPath(path string) string {
+	return uuidPattern.ReplaceAllString(path, ":id")
+}
+
+// metricsTransport traQへのリクエストの回数と処理時間を記録するRoundTripper
+type metricsTransport struct {
+	base   http.RoundTripper
+	client string
+}
+
+func newMetricsTransport(client string, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &metricsTransport{
+		base:   base,
+		client: client,
+	}
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := metricsPath(req.URL.Path)
+	start := time.Now()
+
+	resp, err := t.base.RoundTrip(req)
+
+	traqRequestDuration.
+		WithLabelValues(t.client, req.Method, path).
+		Observe(time.Since(start).Seconds())
+
+	status := metricsStatusError
+	if err == nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+	traqRequestsTotal.
+		WithLabelValues(t.client, req.Method, path, status).
+		Inc()
+
+	return resp, err
+}
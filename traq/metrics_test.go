@@ -0,0 +1,106 @@
+package traq
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/config"
+)
+
+func TestMetricsPath(t *testing.T) {
+	assertion := assert.New(t)
+
+	type test struct {
+		description string
+		path        string
+		expect      string
+	}
+	testCases := []test{
+		{
+			description: "IDを含まないパスはそのまま",
+			path:        "/api/v3/users",
+			expect:      "/api/v3/users",
+		},
+		{
+			description: "IDを置き換える",
+			path:        "/api/v3/users/0fa5d740-0841-4b88-b7c8-34a68774c784",
+			expect:      "/api/v3/users/:id",
+		},
+		{
+			description: "複数のIDを置き換える",
+			path:        "/api/v3/messages/0fa5d740-0841-4b88-b7c8-34a68774c784/stamps/b77fad4e-b63f-42a2-916c-5cfe5af3d8b9",
+			expect:      "/api/v3/messages/:id/stamps/:id",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assertion.Equal(testCase.expect, metricsPath(testCase.path), testCase.description)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	assertion := assert.New(t)
+
+	const (
+		webhookID = "5a5d1ec0-3f4a-4d0f-8b9a-1f1f7b0c6f4e"
+		etag      = `"users"`
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/users":
+			if r.Header.Get("If-None-Match") == etag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", etag)
+			_, _ = w.Write([]byte("[]"))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	defaultConfig := traqConfig
+	defer func() {
+		traqConfig = defaultConfig
+	}()
+	Configure(config.TraQ{BaseURL: server.URL, WebhookID: webhookID})
+
+	usersOK := traqRequestsTotal.WithLabelValues(metricsClientAPI, http.MethodGet, "/api/v3/users", "200")
+	usersNotModified := traqRequestsTotal.WithLabelValues(metricsClientAPI, http.MethodGet, "/api/v3/users", "304")
+	webhook := traqRequestsTotal.WithLabelValues(metricsClientWebhook, http.MethodPost, "/api/v3/webhooks/:id", "204")
+	cacheHit := traqETagCacheTotal.WithLabelValues(etagCacheHit)
+	cacheMiss := traqETagCacheTotal.WithLabelValues(etagCacheMiss)
+
+	usersOKBefore := testutil.ToFloat64(usersOK)
+	usersNotModifiedBefore := testutil.ToFloat64(usersNotModified)
+	webhookBefore := testutil.ToFloat64(webhook)
+	cacheHitBefore := testutil.ToFloat64(cacheHit)
+	cacheMissBefore := testutil.ToFloat64(cacheMiss)
+
+	client := NewTraqAPIClient()
+	for range 2 {
+		res, err := client.httpClient.Get(server.URL + "/api/v3/users")
+		if !assertion.NoError(err, "get users") {
+			return
+		}
+		assertion.Equal(http.StatusOK, res.StatusCode, "get users")
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+	}
+
+	err := NewWebhook().PostMessage(context.Background(), "message")
+	assertion.NoError(err, "webhook")
+
+	assertion.Equal(usersOKBefore+1, testutil.ToFloat64(usersOK), "users 200")
+	assertion.Equal(usersNotModifiedBefore+1, testutil.ToFloat64(usersNotModified), "users 304")
+	assertion.Equal(webhookBefore+1, testutil.ToFloat64(webhook), "webhook")
+	assertion.Equal(cacheHitBefore+1, testutil.ToFloat64(cacheHit), "cache hit")
+	assertion.Equal(cacheMissBefore+1, testutil.ToFloat64(cacheMiss), "cache miss")
+}
@@ -0,0 +1,54 @@
+package traq
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/config"
+	"github.com/traPtitech/anke-to/tracing"
+	"github.com/traPtitech/anke-to/tracing/tracingtest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	assertion := assert.New(t)
+
+	exporter := tracingtest.Setup(t)
+
+	traceparents := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents[r.URL.Path] = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	defaultConfig := traqConfig
+	defer func() {
+		traqConfig = defaultConfig
+	}()
+	Configure(config.TraQ{BaseURL: server.URL, WebhookID: "webhook"})
+
+	ctx, parent := tracing.Start(context.Background(), "parent")
+	err := NewTraqAPIClient().Ping(ctx)
+	assertion.NoError(err, "ping")
+	err = NewWebhook().PostMessage(ctx, "message")
+	assertion.NoError(err, "webhook")
+	parent.End()
+
+	traceID := parent.SpanContext().TraceID().String()
+	for _, path := range []string{"/api/v3/version", "/api/v3/webhooks/webhook"} {
+		assertion.True(strings.Contains(traceparents[path], traceID), path, "traceparent propagated")
+	}
+
+	clientSpans := 0
+	for _, span := range exporter.GetSpans() {
+		if span.Parent.SpanID() == parent.SpanContext().SpanID() && span.SpanKind == trace.SpanKindClient {
+			clientSpans++
+		}
+	}
+	assertion.Equal(2, clientSpans, "client spans")
+}
@@ -3,32 +3,60 @@ package traq
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"strings"
 	"sync"
 
 	traq "github.com/traPtitech/go-traq"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
-const tokenEnvKey = "TRAQ_BOT_TOKEN"
-
 type APIClient struct {
-	client *traq.APIClient
-	token  string
+	client     *traq.APIClient
+	httpClient *http.Client
+	token      string
 }
 
 func NewTraqAPIClient() *APIClient {
-	cfg := traq.NewConfiguration()
-	cfg.HTTPClient = &http.Client{
-		Transport: newETagCacheTransport(http.DefaultTransport),
+	httpClient := &http.Client{
+		// キャッシュから返したレスポンスもスパンに記録する
+		// メトリクスにはtraQに実際に送ったリクエストのみ記録し、キャッシュの結果は別に数える
+		Transport: otelhttp.NewTransport(newETagCacheTransport(newMetricsTransport(metricsClientAPI, http.DefaultTransport))),
 	}
 
+	cfg := traq.NewConfiguration()
+	cfg.Servers = []traq.ServerConfiguration{{URL: apiBaseURL()}}
+	cfg.HTTPClient = httpClient
+
 	return &APIClient{
-		client: traq.NewAPIClient(cfg),
-		token:  os.Getenv(tokenEnvKey),
+		client:     traq.NewAPIClient(cfg),
+		httpClient: httpClient,
+		token:      traqConfig.BotToken,
+	}
+}
+
+// Ping traQのAPIに到達できるか確認する
+// 認証のいらないバージョン情報のAPIを使う
+func (t *APIClient) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiBaseURL()+"/version", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
 	}
+
+	res, err := t.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request traQ: %w", err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from traQ: %d", res.StatusCode)
+	}
+
+	return nil
 }
 
 func (t *APIClient) authContext(ctx context.Context) context.Context {
@@ -102,6 +130,51 @@ func (t *APIClient) GetChannels(ctx context.Context) (*traq.ChannelList, error)
 	return v, nil
 }
 
+// PostChannelMessage BOTとしてチャンネルにメッセージを投稿し、投稿したメッセージのIDを返す
+func (t *APIClient) PostChannelMessage(ctx context.Context, channelID string, content string) (string, error) {
+	embed := true
+	v, _, err := t.client.MessageApi.PostMessage(t.authContext(ctx), channelID).PostMessageRequest(traq.PostMessageRequest{
+		Content: content,
+		Embed:   &embed,
+	}).Execute()
+	if err != nil {
+		return "", err
+	}
+	return v.Id, nil
+}
+
+// PostDirectMessage BOTとしてユーザーにDMを送る
+func (t *APIClient) PostDirectMessage(ctx context.Context, userTraqID string, content string) error {
+	users, err := t.GetUsersByName(ctx, userTraqID)
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		return fmt.Errorf("user not found: %s", userTraqID)
+	}
+
+	embed := true
+	_, _, err = t.client.MessageApi.PostDirectMessage(t.authContext(ctx), users[0].Id).PostMessageRequest(traq.PostMessageRequest{
+		Content: content,
+		Embed:   &embed,
+	}).Execute()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddMessageStamp BOTとしてメッセージにスタンプを押す
+func (t *APIClient) AddMessageStamp(ctx context.Context, messageID string, stampID string) error {
+	_, err := t.client.MessageApi.AddMessageStamp(t.authContext(ctx), messageID, stampID).PostMessageStampRequest(traq.PostMessageStampRequest{
+		Count: 1,
+	}).Execute()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 type cacheEntry struct {
 	etag   string
 	body   []byte
@@ -141,30 +214,37 @@ func (t *etagCacheTransport) RoundTrip(req *http.Request) (*http.Response, error
 	if err != nil {
 		// 通信失敗時はキャッシュがあればフォールバックする。
 		if cachedResp, ok := t.buildCachedResponse(req, cacheKey); ok {
+			traqETagCacheTotal.WithLabelValues(etagCacheFallback).Inc()
 			return cachedResp, nil
 		}
+		traqETagCacheTotal.WithLabelValues(etagCacheMiss).Inc()
 		return nil, err
 	}
 
 	if resp.StatusCode == http.StatusNotModified {
 		// 304のときは保存済みボディを200として返す。
 		if cachedResp, ok := t.buildCachedResponse(req, cacheKey); ok {
+			traqETagCacheTotal.WithLabelValues(etagCacheHit).Inc()
 			resp.Body.Close()
 			return cachedResp, nil
 		}
 		// キャッシュがない場合はそのまま返す（異常系）
+		traqETagCacheTotal.WithLabelValues(etagCacheMiss).Inc()
 		return resp, nil
 	}
 
 	if resp.StatusCode >= http.StatusInternalServerError {
 		if cachedResp, ok := t.buildCachedResponse(req, cacheKey); ok {
+			traqETagCacheTotal.WithLabelValues(etagCacheFallback).Inc()
 			resp.Body.Close()
 			return cachedResp, nil
 		}
 		// キャッシュがない場合はそのまま返す
+		traqETagCacheTotal.WithLabelValues(etagCacheMiss).Inc()
 		return resp, nil
 	}
 
+	traqETagCacheTotal.WithLabelValues(etagCacheMiss).Inc()
 	if resp.StatusCode != http.StatusOK {
 		return resp, nil
 	}
@@ -0,0 +1,67 @@
+package traq
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/traPtitech/anke-to/config"
+)
+
+func TestPing(t *testing.T) {
+	assertion := assert.New(t)
+
+	type test struct {
+		description string
+		statusCode  int
+		isErr       bool
+	}
+	testCases := []test{
+		{
+			description: "traQに到達できるのでエラーなし",
+			statusCode:  http.StatusOK,
+		},
+		{
+			description: "traQがエラーを返すのでエラー",
+			statusCode:  http.StatusServiceUnavailable,
+			isErr:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assertion.Equal("/api/v3/version", r.URL.Path, testCase.description, "path")
+			w.WriteHeader(testCase.statusCode)
+		}))
+
+		defaultConfig := traqConfig
+		Configure(config.TraQ{BaseURL: server.URL})
+
+		err := NewTraqAPIClient().Ping(context.Background())
+
+		traqConfig = defaultConfig
+		server.Close()
+
+		if testCase.isErr {
+			assertion.Error(err, testCase.description, "error")
+		} else {
+			assertion.NoError(err, testCase.description, "no error")
+		}
+	}
+
+	t.Run("traQに到達できないのでエラー", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		defaultConfig := traqConfig
+		defer func() {
+			traqConfig = defaultConfig
+		}()
+		Configure(config.TraQ{BaseURL: server.URL})
+
+		err := NewTraqAPIClient().Ping(context.Background())
+		assert.Error(t, err)
+	})
+}
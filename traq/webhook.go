@@ -2,7 +2,9 @@
 
 package traq
 
+import "context"
+
 // IWebhook traQのWebhookのinterface
 type IWebhook interface {
-	PostMessage(message string) error
+	PostMessage(ctx context.Context, message string) error
 }
@@ -1,6 +1,7 @@
 package traq
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/hex"
@@ -8,18 +9,24 @@ import (
 	"io"
 	"net/http"
 	netUrl "net/url"
-	"os"
 	"strings"
 
 	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 // Webhook Webhookの構造体
-type Webhook struct{}
+type Webhook struct {
+	client *http.Client
+}
 
 // NewWebhook Webhookのコンストラクター
 func NewWebhook() *Webhook {
-	return new(Webhook)
+	return &Webhook{
+		client: &http.Client{
+			Transport: otelhttp.NewTransport(newMetricsTransport(metricsClientWebhook, http.DefaultTransport)),
+		},
+	}
 }
 
 // MessageLimit traQのメッセージの最大文字数
@@ -27,9 +34,9 @@ func NewWebhook() *Webhook {
 const MessageLimit = 10000
 
 // PostMessage Webhookでのメッセージの投稿
-func (*Webhook) PostMessage(message string) error {
-	url := "https://q.trap.jp/api/v3/webhooks/" + os.Getenv("TRAQ_WEBHOOK_ID")
-	req, err := http.NewRequest("POST",
+func (w *Webhook) PostMessage(ctx context.Context, message string) error {
+	url := apiBaseURL() + "/webhooks/" + traqConfig.WebhookID
+	req, err := http.NewRequestWithContext(ctx, "POST",
 		url,
 		strings.NewReader(message))
 	if err != nil {
@@ -47,8 +54,7 @@ func (*Webhook) PostMessage(message string) error {
 	query.Add("embed", "1")
 	req.URL.RawQuery = query.Encode()
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := w.client.Do(req)
 	if err != nil {
 		return err
 	}
@@ -66,7 +72,7 @@ func (*Webhook) PostMessage(message string) error {
 }
 
 func calcHMACSHA1(message string) (string, error) {
-	mac := hmac.New(sha1.New, []byte(os.Getenv("TRAQ_WEBHOOK_SECRET")))
+	mac := hmac.New(sha1.New, []byte(traqConfig.WebhookSecret))
 	_, err := mac.Write([]byte(message))
 	if err != nil {
 		return "", fmt.Errorf("failed to write message to mac: %w", err)
@@ -5,37 +5,56 @@ package main
 
 import (
 	"github.com/google/wire"
+	"github.com/traPtitech/anke-to/auth"
 	"github.com/traPtitech/anke-to/controller"
 	"github.com/traPtitech/anke-to/handler"
 	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/pubsub"
 	"github.com/traPtitech/anke-to/traq"
 )
 
 var (
-	administratorBind      = wire.Bind(new(model.IAdministrator), new(*model.Administrator))
-	administratorGroupBind = wire.Bind(new(model.IAdministratorGroup), new(*model.AdministratorGroup))
-	administratorUserBind  = wire.Bind(new(model.IAdministratorUser), new(*model.AdministratorUser))
-	optionBind             = wire.Bind(new(model.IOption), new(*model.Option))
-	questionnaireBind      = wire.Bind(new(model.IQuestionnaire), new(*model.Questionnaire))
-	questionBind           = wire.Bind(new(model.IQuestion), new(*model.Question))
-	respondentBind         = wire.Bind(new(model.IRespondent), new(*model.Respondent))
-	responseBind           = wire.Bind(new(model.IResponse), new(*model.Response))
-	scaleLabelBind         = wire.Bind(new(model.IScaleLabel), new(*model.ScaleLabel))
-	targetBind             = wire.Bind(new(model.ITarget), new(*model.Target))
-	targetGroupBind        = wire.Bind(new(model.ITargetGroup), new(*model.TargetGroup))
-	targetUserBind         = wire.Bind(new(model.ITargetUser), new(*model.TargetUser))
-	validationBind         = wire.Bind(new(model.IValidation), new(*model.Validation))
-	transactionBind        = wire.Bind(new(model.ITransaction), new(*model.Transaction))
-	webhookBind            = wire.Bind(new(traq.IWebhook), new(*traq.Webhook))
+	administratorBind        = wire.Bind(new(model.IAdministrator), new(*model.Administrator))
+	administratorGroupBind   = wire.Bind(new(model.IAdministratorGroup), new(*model.AdministratorGroup))
+	administratorUserBind    = wire.Bind(new(model.IAdministratorUser), new(*model.AdministratorUser))
+	optionBind               = wire.Bind(new(model.IOption), new(*model.Option))
+	questionnaireBind        = wire.Bind(new(model.IQuestionnaire), new(*model.Questionnaire))
+	questionBind             = wire.Bind(new(model.IQuestion), new(*model.Question))
+	respondentBind           = wire.Bind(new(model.IRespondent), new(*model.Respondent))
+	responseBind             = wire.Bind(new(model.IResponse), new(*model.Response))
+	scaleLabelBind           = wire.Bind(new(model.IScaleLabel), new(*model.ScaleLabel))
+	targetBind               = wire.Bind(new(model.ITarget), new(*model.Target))
+	targetGroupBind          = wire.Bind(new(model.ITargetGroup), new(*model.TargetGroup))
+	targetUserBind           = wire.Bind(new(model.ITargetUser), new(*model.TargetUser))
+	validationBind           = wire.Bind(new(model.IValidation), new(*model.Validation))
+	transactionBind          = wire.Bind(new(model.ITransaction), new(*model.Transaction))
+	searchIndexBind          = wire.Bind(new(model.ISearchIndex), new(*model.SearchIndex))
+	tagBind                  = wire.Bind(new(model.ITag), new(*model.Tag))
+	systemAdminBind          = wire.Bind(new(model.ISystemAdmin), new(*model.SystemAdmin))
+	accessTokenBind          = wire.Bind(new(model.IAccessToken), new(*model.AccessToken))
+	questionnaireWebhookBind = wire.Bind(new(model.IQuestionnaireWebhook), new(*model.QuestionnaireWebhook))
+	quickPollBind            = wire.Bind(new(model.IQuickPoll), new(*model.QuickPoll))
+	responseViewerBind       = wire.Bind(new(model.IResponseViewer), new(*model.ResponseViewer))
+	webhookBind              = wire.Bind(new(traq.IWebhook), new(*traq.Webhook))
+	botBind                  = wire.Bind(new(traq.IBot), new(*traq.APIClient))
 )
 
-func InjectAPIServer() *handler.Handler {
+func InjectAPIServer(authenticator auth.Authenticator, broker pubsub.Broker) *handler.Handler {
 	wire.Build(
 		handler.NewHandler,
 		controller.NewResponse,
 		controller.NewQuestionnaire,
 		controller.NewReminder,
+		controller.NewTag,
+		controller.NewSystemAdmin,
+		controller.NewAccessToken,
+		controller.NewOutgoingWebhook,
+		controller.NewBot,
+		controller.NewQuickPoll,
+		controller.NewResponseStream,
+		controller.NewResponseReview,
 		controller.NewMiddleware,
+		controller.NewHealth,
 		model.NewAdministrator,
 		model.NewAdministratorGroup,
 		model.NewAdministratorUser,
@@ -50,6 +69,13 @@ func InjectAPIServer() *handler.Handler {
 		model.NewTargetUser,
 		model.NewValidation,
 		model.NewTransaction,
+		model.NewSearchIndex,
+		model.NewTag,
+		model.NewSystemAdmin,
+		model.NewAccessToken,
+		model.NewQuestionnaireWebhook,
+		model.NewQuickPoll,
+		model.NewResponseViewer,
 		traq.NewTraqAPIClient,
 		traq.NewWebhook,
 		administratorBind,
@@ -66,7 +92,15 @@ func InjectAPIServer() *handler.Handler {
 		targetUserBind,
 		validationBind,
 		transactionBind,
+		searchIndexBind,
+		tagBind,
+		systemAdminBind,
+		accessTokenBind,
+		questionnaireWebhookBind,
+		quickPollBind,
+		responseViewerBind,
 		webhookBind,
+		botBind,
 	)
 	return &handler.Handler{}
 }
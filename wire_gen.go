@@ -8,9 +8,11 @@ package main
 
 import (
 	"github.com/google/wire"
+	"github.com/traPtitech/anke-to/auth"
 	"github.com/traPtitech/anke-to/controller"
 	"github.com/traPtitech/anke-to/handler"
 	"github.com/traPtitech/anke-to/model"
+	"github.com/traPtitech/anke-to/pubsub"
 	"github.com/traPtitech/anke-to/traq"
 )
 
@@ -21,7 +23,7 @@ import (
 
 // Injectors from wire.go:
 
-func InjectAPIServer() *handler.Handler {
+func InjectAPIServer(authenticator auth.Authenticator, broker pubsub.Broker) *handler.Handler {
 	questionnaire := model.NewQuestionnaire()
 	target := model.NewTarget()
 	targetGroup := model.NewTargetGroup()
@@ -35,33 +37,57 @@ func InjectAPIServer() *handler.Handler {
 	validation := model.NewValidation()
 	transaction := model.NewTransaction()
 	respondent := model.NewRespondent()
+	searchIndex := model.NewSearchIndex()
+	tag := model.NewTag()
+	systemAdmin := model.NewSystemAdmin()
+	responseViewer := model.NewResponseViewer()
 	webhook := traq.NewWebhook()
 	response := model.NewResponse()
-	controllerResponse := controller.NewResponse(questionnaire, respondent, response, target, question, option, validation, scaleLabel, transaction)
+	questionnaireWebhook := model.NewQuestionnaireWebhook()
+	outgoingWebhook := controller.NewOutgoingWebhook(questionnaireWebhook)
+	responseStream := controller.NewResponseStream(broker, questionnaire, respondent)
+	controllerResponse := controller.NewResponse(questionnaire, respondent, response, target, question, option, validation, scaleLabel, transaction, outgoingWebhook, responseStream)
 	reminder := controller.NewReminder()
-	controllerQuestionnaire := controller.NewQuestionnaire(questionnaire, target, targetGroup, targetUser, administrator, administratorGroup, administratorUser, question, option, scaleLabel, validation, transaction, respondent, webhook, controllerResponse, reminder)
-	middleware := controller.NewMiddleware(administrator, respondent, question, questionnaire)
+	controllerQuestionnaire := controller.NewQuestionnaire(questionnaire, target, targetGroup, targetUser, administrator, administratorGroup, administratorUser, question, option, scaleLabel, validation, transaction, respondent, searchIndex, tag, systemAdmin, responseViewer, webhook, controllerResponse, reminder)
+	controllerTag := controller.NewTag(tag, systemAdmin)
+	controllerSystemAdmin := controller.NewSystemAdmin(systemAdmin)
+	accessToken := model.NewAccessToken()
+	controllerAccessToken := controller.NewAccessToken(accessToken)
+	quickPoll := model.NewQuickPoll()
 	apiClient := traq.NewTraqAPIClient()
-	handlerHandler := handler.NewHandler(controllerQuestionnaire, controllerResponse, reminder, middleware, apiClient)
+	controllerQuickPoll := controller.NewQuickPoll(quickPoll, questionnaire, question, option, respondent, response, transaction, controllerResponse, apiClient)
+	bot := controller.NewBot(controllerQuestionnaire, controllerQuickPoll, apiClient)
+	responseReview := controller.NewResponseReview(respondent, questionnaire, apiClient)
+	middleware := controller.NewMiddleware(administrator, respondent, question, questionnaire, systemAdmin, accessToken, authenticator)
+	health := controller.NewHealth(reminder, apiClient)
+	handlerHandler := handler.NewHandler(controllerQuestionnaire, controllerResponse, reminder, controllerTag, controllerSystemAdmin, controllerAccessToken, outgoingWebhook, bot, controllerQuickPoll, responseStream, responseReview, middleware, health, apiClient)
 	return handlerHandler
 }
 
 // wire.go:
 
 var (
-	administratorBind      = wire.Bind(new(model.IAdministrator), new(*model.Administrator))
-	administratorGroupBind = wire.Bind(new(model.IAdministratorGroup), new(*model.AdministratorGroup))
-	administratorUserBind  = wire.Bind(new(model.IAdministratorUser), new(*model.AdministratorUser))
-	optionBind             = wire.Bind(new(model.IOption), new(*model.Option))
-	questionnaireBind      = wire.Bind(new(model.IQuestionnaire), new(*model.Questionnaire))
-	questionBind           = wire.Bind(new(model.IQuestion), new(*model.Question))
-	respondentBind         = wire.Bind(new(model.IRespondent), new(*model.Respondent))
-	responseBind           = wire.Bind(new(model.IResponse), new(*model.Response))
-	scaleLabelBind         = wire.Bind(new(model.IScaleLabel), new(*model.ScaleLabel))
-	targetBind             = wire.Bind(new(model.ITarget), new(*model.Target))
-	targetGroupBind        = wire.Bind(new(model.ITargetGroup), new(*model.TargetGroup))
-	targetUserBind         = wire.Bind(new(model.ITargetUser), new(*model.TargetUser))
-	validationBind         = wire.Bind(new(model.IValidation), new(*model.Validation))
-	transactionBind        = wire.Bind(new(model.ITransaction), new(*model.Transaction))
-	webhookBind            = wire.Bind(new(traq.IWebhook), new(*traq.Webhook))
+	administratorBind        = wire.Bind(new(model.IAdministrator), new(*model.Administrator))
+	administratorGroupBind   = wire.Bind(new(model.IAdministratorGroup), new(*model.AdministratorGroup))
+	administratorUserBind    = wire.Bind(new(model.IAdministratorUser), new(*model.AdministratorUser))
+	optionBind               = wire.Bind(new(model.IOption), new(*model.Option))
+	questionnaireBind        = wire.Bind(new(model.IQuestionnaire), new(*model.Questionnaire))
+	questionBind             = wire.Bind(new(model.IQuestion), new(*model.Question))
+	respondentBind           = wire.Bind(new(model.IRespondent), new(*model.Respondent))
+	responseBind             = wire.Bind(new(model.IResponse), new(*model.Response))
+	scaleLabelBind           = wire.Bind(new(model.IScaleLabel), new(*model.ScaleLabel))
+	targetBind               = wire.Bind(new(model.ITarget), new(*model.Target))
+	targetGroupBind          = wire.Bind(new(model.ITargetGroup), new(*model.TargetGroup))
+	targetUserBind           = wire.Bind(new(model.ITargetUser), new(*model.TargetUser))
+	validationBind           = wire.Bind(new(model.IValidation), new(*model.Validation))
+	transactionBind          = wire.Bind(new(model.ITransaction), new(*model.Transaction))
+	searchIndexBind          = wire.Bind(new(model.ISearchIndex), new(*model.SearchIndex))
+	tagBind                  = wire.Bind(new(model.ITag), new(*model.Tag))
+	systemAdminBind          = wire.Bind(new(model.ISystemAdmin), new(*model.SystemAdmin))
+	accessTokenBind          = wire.Bind(new(model.IAccessToken), new(*model.AccessToken))
+	questionnaireWebhookBind = wire.Bind(new(model.IQuestionnaireWebhook), new(*model.QuestionnaireWebhook))
+	quickPollBind            = wire.Bind(new(model.IQuickPoll), new(*model.QuickPoll))
+	responseViewerBind       = wire.Bind(new(model.IResponseViewer), new(*model.ResponseViewer))
+	webhookBind              = wire.Bind(new(traq.IWebhook), new(*traq.Webhook))
+	botBind                  = wire.Bind(new(traq.IBot), new(*traq.APIClient))
 )